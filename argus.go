@@ -33,9 +33,12 @@ package argus
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -72,16 +75,35 @@ const (
 	ErrCodeConfigWriterError      = "ARGUS_CONFIG_WRITER_ERROR"
 	ErrCodeSerializationError     = "ARGUS_SERIALIZATION_ERROR"
 	ErrCodeIOError                = "ARGUS_IO_ERROR"
+	ErrCodeShutdownHook           = "ARGUS_SHUTDOWN_HOOK_ERROR"
+	ErrCodeFileTooLarge           = "ARGUS_FILE_TOO_LARGE"
+	ErrCodeDuplicateWatch         = "ARGUS_DUPLICATE_WATCH"
+	ErrCodeSecretResolution       = "ARGUS_SECRET_RESOLUTION_ERROR"
+	ErrCodeWatchOverlap           = "ARGUS_WATCH_OVERLAP"
+	ErrCodeRemoteStoreUnsupported = "ARGUS_REMOTE_STORE_UNSUPPORTED"
+	ErrCodeMissingRequiredKey     = "ARGUS_MISSING_REQUIRED_KEY"
+	ErrCodeFlushTimeout           = "ARGUS_FLUSH_TIMEOUT"
+	ErrCodeCallbackPanic          = "ARGUS_CALLBACK_PANIC"
+	ErrCodeRemoteCircuitOpen      = "ARGUS_REMOTE_CIRCUIT_OPEN"
+	ErrCodeSchemaValidation       = "ARGUS_SCHEMA_VALIDATION"
+	ErrCodeSlowCallback           = "ARGUS_SLOW_CALLBACK"
 )
 
 // ChangeEvent represents a file change notification
 type ChangeEvent struct {
 	Path     string    // File path that changed
-	ModTime  time.Time // New modification time
+	ModTime  time.Time // New modification time; zero for deletions
 	Size     int64     // New file size
 	IsCreate bool      // True if file was created
 	IsDelete bool      // True if file was deleted
 	IsModify bool      // True if file was modified
+	IsRename bool      // True if file was replaced by another one (atomic save); set with IsModify
+
+	// File content before and after the change, set with
+	// Config.IncludeContentInEvents only. The slices are shared by every
+	// callback of the path and must not be modified.
+	OldContent []byte // Content delivered with the previous event (or read by Watch); nil if none
+	NewContent []byte // Content read at delivery, see Config.IncludeContentInEvents; nil for deletions and unreadable files
 }
 
 // UpdateCallback is called when a watched file changes
@@ -141,6 +163,13 @@ type Config struct {
 	// Default: 100 (generous for config files)
 	MaxWatchedFiles int
 
+	// MaxFileSize is the largest watched file size in bytes that Argus will
+	// report and read. Larger files are skipped (no callback, no read/parse),
+	// a security audit event is recorded and the ErrorHandler is notified.
+	// Watching continues, so the file recovers once it shrinks below the limit.
+	// Default: 0 (no limit)
+	MaxFileSize int64
+
 	// Audit configuration for security and compliance
 	// Default: Enabled with secure defaults
 	Audit AuditConfig
@@ -178,10 +207,180 @@ type Config struct {
 	// Default: 0 (auto-calculated based on strategy)
 	BoreasLiteCapacity int64
 
+	// AdaptiveCapacity lets the ring buffer double its capacity when it stays
+	// nearly full or drops events for several consecutive batches, up to
+	// BoreasLiteMaxCapacity, and return to BoreasLiteCapacity after 30s idle.
+	// Resizes are reported by Stats (RingCapacity, RingResizes).
+	// Default: false (fixed capacity)
+	AdaptiveCapacity bool
+
+	// BoreasLiteMaxCapacity caps adaptive growth (must be power of 2).
+	// Ignored unless AdaptiveCapacity is set.
+	// Default: 0 (4096)
+	BoreasLiteMaxCapacity int64
+
 	// Remote configuration with automatic fallback capabilities
 	// When enabled, provides distributed configuration management with local fallback
 	// Default: Disabled for backward compatibility
 	Remote RemoteConfig
+
+	// ParseStrictness selects how universal watchers parse configuration files.
+	// ParseStrict rejects duplicate keys, unknown escapes and trailing garbage;
+	// ParseLenient parses on a best-effort basis to avoid reload outages.
+	// Default: ParseLenient
+	ParseStrictness ParseStrictness
+
+	// InitialState restores file state exported by Watcher.ExportState.
+	// Files watched later use the imported state as their change-detection
+	// baseline, so only changes made after the export fire callbacks.
+	// Invalid state is reported to ErrorHandler and ignored.
+	// Default: nil (baseline taken when Watch is called)
+	InitialState []byte
+
+	// DeleteGracePeriod holds back the delete of a watched file until it has
+	// been missing for this long, checked on the following polls. A file that
+	// reappears meanwhile is reported as one IsRename modify instead of a
+	// delete and a create, for tools that save by deleting the file and
+	// writing it again later. Replacements by rename are detected from the
+	// file identity regardless. Deletes then arrive at the first check after
+	// the grace period, i.e. up to one poll interval later than it.
+	// Default: 0 (deletes are reported at the check that finds the file missing)
+	DeleteGracePeriod time.Duration
+
+	// DebounceInterval collapses changes to the same file detected within
+	// the interval of each other into one callback carrying the final state.
+	// The window restarts with every change, so the callback runs once the
+	// file has been quiet for DebounceInterval. Merged changes are counted
+	// in FileWatchStats.Coalesced. The final event goes back through the
+	// event ring, so it is delivered like any other event; GracefulShutdown
+	// delivers events still inside their window, Stop drops them.
+	// Default: 0 (every change is delivered immediately)
+	DebounceInterval time.Duration
+
+	// FireOnStart invokes each watched file's callbacks once with a synthetic
+	// IsModify event when Start is called, and for files watched while the
+	// watcher runs, so applications read their initial state through the
+	// same code path as updates. A file that cannot be read is reported to
+	// ErrorHandler instead. Universal watchers already deliver the initial
+	// configuration and ignore this flag.
+	// Default: false
+	FireOnStart bool
+
+	// EventsBufferSize is the capacity of the channel returned by Events.
+	// Default: 64
+	EventsBufferSize int
+
+	// EventsOverflow selects what happens when the Events channel is full.
+	// EventsDropNewest discards the incoming event like a full BoreasLite
+	// ring; EventsDropOldest discards the oldest buffered event instead.
+	// Default: EventsDropNewest
+	EventsOverflow EventsOverflowPolicy
+
+	// EventsShutdown selects what happens to buffered events when the watcher
+	// stops. EventsShutdownDrain lets GracefulShutdown wait, within its
+	// timeout, for consumers to receive them; EventsShutdownDiscard drops
+	// them. The channel is closed either way so range loops terminate.
+	// Default: EventsShutdownDrain
+	EventsShutdown EventsShutdownPolicy
+
+	// PollStallMultiplier is how many poll intervals may pass without a
+	// completed poll cycle, or with the event processor stuck delivering one
+	// event, before Health reports the watcher as stalled.
+	// Default: 5
+	PollStallMultiplier int
+
+	// WorkerPoolThreshold is the number of watched files above which a poll
+	// cycle checks files with a fixed pool of workers instead of one
+	// goroutine per file. Lower it when stat calls are expensive; raise it
+	// to avoid pool overhead. Stats reports the mode used by the last poll.
+	// Default: 8
+	WorkerPoolThreshold int
+
+	// OnPollStall is called by a watchdog when the poll loop or the event
+	// processor stalls, once per stall, with the time of the last completed
+	// poll cycle and how long the stalled one has been stuck.
+	// Default: nil (stalls are only visible through Health)
+	OnPollStall func(lastPoll time.Time, stalledFor time.Duration)
+
+	// WarningHandler receives runtime warnings detected by the poll loop,
+	// such as WarnRingSaturated or WarnStrategyMismatch. Each condition is
+	// reported once when it starts and again only after it cleared. It runs
+	// on the poll goroutine and must not block.
+	// Default: nil (runtime warnings are not reported)
+	WarningHandler func(code, msg string)
+
+	// WatchOverlap selects how Watch reports a file that an active
+	// DirectoryWatcher already covers, which would report changes twice:
+	// OverlapWarn notifies the ErrorHandler (or logs), OverlapError fails
+	// with ErrCodeWatchOverlap, OverlapIgnore stays silent.
+	// Default: OverlapWarn
+	WatchOverlap OverlapPolicy
+
+	// DuplicateWatch selects what Watch does for a path that is already watched:
+	// - DuplicateWatchReplace: the new callback replaces the previous one (default)
+	// - DuplicateWatchError: Watch fails with ErrCodeDuplicateWatch
+	// - DuplicateWatchFanOut: every registered callback is invoked
+	// Default: DuplicateWatchReplace
+	DuplicateWatch DuplicateWatchPolicy
+
+	// NormalizeKeys rewrites every key of configurations parsed by universal
+	// watchers (see NormalizeKeys) before they reach callbacks and binders.
+	// Keys colliding after normalization are reported as a parse error.
+	// Default: KeysAsIs
+	NormalizeKeys KeyNormalization
+
+	// ExpandEnv replaces ${VAR}, $VAR and ${VAR:-default} in string values of
+	// configurations parsed by universal watchers, before callbacks and binders
+	// see them. EnvExpandStrict rejects undefined variables without a default.
+	// Default: EnvExpandOff
+	ExpandEnv EnvExpansion
+
+	// KeepLastGood makes universal watchers retain the last configuration
+	// delivered for each path. A reload that fails to read, parse or
+	// validate, or finds the file empty, is reported to ErrorHandler and
+	// OnInvalid without firing the callback; see Watcher.LastGoodConfig.
+	// Default: false (empty files are delivered as empty configurations)
+	KeepLastGood bool
+
+	// OnInvalid is invoked with KeepLastGood after a failed reload, with the
+	// last good configuration of the file, e.g. to re-apply it or to report
+	// which version the application keeps running on
+	OnInvalid InvalidConfigHandler
+
+	// ReloadOnSIGHUP makes Start subscribe to SIGHUP and call Reload for each
+	// signal until the watcher stops. Only SIGHUP is handled, so the
+	// application keeps its own SIGINT/SIGTERM shutdown handling. Ignored on
+	// Windows, which has no SIGHUP.
+	// Default: false
+	ReloadOnSIGHUP bool
+
+	// SlowCallbackThreshold reports callbacks running longer than this to
+	// ErrorHandler as ErrCodeSlowCallback errors carrying the path and the
+	// duration. Callbacks run one at a time on the event processor, so a slow
+	// one delays every other file's events unless CallbackConcurrency is set.
+	// Stats reports per-file callback durations regardless of the threshold.
+	// Default: 0 (slow callbacks are not reported)
+	SlowCallbackThreshold time.Duration
+
+	// CallbackConcurrency, when greater than 1, delivers events on a pool of
+	// up to this many goroutines instead of the event processor, so a slow
+	// callback for one file does not delay the others. Events of a single
+	// path are still delivered one at a time and in the order they were
+	// detected; events of different paths may be delivered concurrently and
+	// in any relative order, including on the Events channel. Callbacks
+	// watching different paths must then be safe for concurrent use.
+	// Default: 0 (callbacks run on the event processor)
+	CallbackConcurrency int
+
+	// IncludeContentInEvents sets OldContent and NewContent on every
+	// ChangeEvent delivered for a watched file. The watcher then keeps the
+	// last content of each file in memory and reads the file once per
+	// delivered event; set MaxFileSize to bound both for large files.
+	// NewContent is read when the event is delivered, not when the change
+	// is detected: if the file is written again in between, it holds the
+	// later content, and the next event may then carry identical content.
+	// Default: false (events carry metadata only)
+	IncludeContentInEvents bool
 }
 
 // RemoteConfig defines distributed configuration management with automatic fallback.
@@ -297,10 +496,31 @@ type RemoteConfig struct {
 	MaxRetries int `json:"max_retries" yaml:"max_retries" toml:"max_retries"`
 
 	// RetryDelay is the base delay between retry attempts
-	// Uses exponential backoff: attempt N waits RetryDelay * 2^N
-	// Default: 1 second (results in 1s, 2s, 4s... delays)
+	// Uses exponential backoff with full jitter: retry N waits a random
+	// duration up to RetryDelay * 2^(N-1)
+	// Default: 1 second (results in waits of up to 1s, 2s, 4s...)
 	// Should be balanced with Timeout to ensure retries fit within timeout window
 	RetryDelay time.Duration `json:"retry_delay" yaml:"retry_delay" toml:"retry_delay"`
+
+	// MergeStrategy combines each remote configuration with the FallbackPath
+	// file when both load, instead of using the file only as a last resort:
+	// MergeRemoteWins, MergeLocalWins or MergeDeep. The strategy applied is
+	// recorded in the context of the sync_success audit event.
+	// Default: MergeNone (remote configuration used as is)
+	MergeStrategy MergeStrategy `json:"merge_strategy" yaml:"merge_strategy" toml:"merge_strategy"`
+
+	// CircuitBreakerThreshold is the number of consecutive syncs in which
+	// every remote source fails before the circuit breaker opens. While open,
+	// syncs skip the remote sources and the last known good configuration is
+	// kept; transitions are audited as circuit_open, circuit_half_open and
+	// circuit_closed, and opening is reported to ErrorHandler.
+	// Default: 0 (circuit breaker disabled)
+	CircuitBreakerThreshold int `json:"circuit_breaker_threshold" yaml:"circuit_breaker_threshold" toml:"circuit_breaker_threshold"`
+
+	// CircuitBreakerCooldown is how long the open breaker skips the remote
+	// sources before a half-open probe sync, made without retries
+	// Default: 1 minute (when CircuitBreakerThreshold is set)
+	CircuitBreakerCooldown time.Duration `json:"circuit_breaker_cooldown" yaml:"circuit_breaker_cooldown" toml:"circuit_breaker_cooldown"`
 }
 
 // fileStat represents cached file statistics for efficient os.Stat() caching.
@@ -326,24 +546,59 @@ type RemoteConfig struct {
 // to avoid allocation and enable direct integer comparison.
 // ═══════════════════════════════════════════════════════════════════════════════
 type fileStat struct {
-	modTime  time.Time // Last modification time from os.Stat()
-	size     int64     // File size in bytes
-	exists   bool      // Whether the file exists
-	cachedAt int64     // Use timecache nano timestamp for zero-allocation timing
+	modTime  time.Time   // Last modification time from os.Stat()
+	size     int64       // File size in bytes
+	exists   bool        // Whether the file exists
+	cachedAt int64       // Use timecache nano timestamp for zero-allocation timing
+	info     os.FileInfo // Identifies the file (inode/device) for os.SameFile; nil if unknown
 }
 
-// isExpired checks if the cached stat is expired using timecache for zero-allocation timing
+// isExpired checks if the cached stat is expired using timecache for zero-allocation timing.
+// timecache follows the wall clock: an entry stamped before the clock jumped
+// backwards would look fresh until the clock caught up, so it counts as expired.
 func (fs *fileStat) isExpired(ttl time.Duration) bool {
-	now := timecache.CachedTimeNano()
-	return (now - fs.cachedAt) > int64(ttl)
+	age, ok := wallElapsed(timecache.CachedTimeNano(), fs.cachedAt)
+	return !ok || age > ttl
 }
 
 // watchedFile represents a file under observation with its callback and cached state.
 // Optimized for minimal memory footprint and fast access during polling.
 type watchedFile struct {
-	path     string         // Absolute file path being watched
-	callback UpdateCallback // User-provided callback for file changes
-	lastStat fileStat       // Cached file statistics for change detection
+	path      string           // Absolute file path being watched
+	callbacks []UpdateCallback // User-provided callbacks, in registration order
+	lastStat  fileStat         // Cached file statistics for change detection
+
+	// Owner of each callback, parallel to callbacks: set for WatchContext
+	// registrations, nil otherwise (guarded by filesMu)
+	registrations []*watchRegistration
+
+	// Debouncing state, see Config.DebounceInterval (guarded by debounceMu)
+	debounceMu      sync.Mutex
+	debounceTimer   *time.Timer
+	debounced       ChangeEvent // Latest event waiting for the window to close
+	debouncePending bool
+
+	// monoNow when the file was first found missing while Config.DeleteGracePeriod
+	// holds back its delete (0 = not pending; poll loop only)
+	missingSince int64
+
+	// Per-file polling schedule, see WatchWithInterval
+	interval time.Duration // Poll interval override (0 = Config.PollInterval), guarded by filesMu
+	nextPoll int64         // monoNow of the next due check (poll loop only)
+
+	// Coalescing counters, reported through Watcher.Stats
+	detected  atomic.Int64 // Raw changes detected by polling
+	delivered atomic.Int64 // Callbacks invoked
+	coalesced atomic.Int64 // Events merged into a later one (opt-in coalescing only)
+
+	// Callback timing, reported through Watcher.Stats
+	lastCallback  atomic.Int64 // Duration of the most recent callback
+	maxCallback   atomic.Int64 // Longest callback duration
+	slowCallbacks atomic.Int64 // Callbacks over Config.SlowCallbackThreshold
+
+	// Last delivered content, see Config.IncludeContentInEvents (guarded by contentMu)
+	contentMu sync.Mutex
+	content   []byte
 }
 
 // Watcher monitors configuration files for changes
@@ -397,6 +652,74 @@ type Watcher struct {
 	// AUDIT SYSTEM: Comprehensive security and compliance logging
 	auditLogger *AuditLogger
 
+	// SHUTDOWN HOOKS: Application teardown run by GracefulShutdown (LIFO)
+	// and flush hooks run before the audit trail closes (FIFO)
+	shutdownHooks   []ShutdownHook
+	flushHooks      []ShutdownHook
+	shutdownHooksMu sync.Mutex
+
+	// STATE HANDOFF: Baselines imported from Config.InitialState (guarded by filesMu)
+	importedState map[string]fileStat
+
+	// pollMu serializes polling with ExportState, which reads last-seen state
+	pollMu sync.Mutex
+
+	// EVENTS CHANNEL: Created on first Events call, closed by Stop (guarded by eventsMu)
+	eventsCh      chan ChangeEvent
+	eventsClosed  bool
+	eventsMu      sync.Mutex
+	eventsDropped atomic.Int64
+
+	// WATCHDOG: Last completed poll cycle as wall UnixNano (0 = never started)
+	// and as monoNow, which stall detection uses to ignore clock jumps
+	lastPoll     atomic.Int64
+	lastPollMono atomic.Int64
+
+	// monoNow at which the event processor started delivering its current
+	// event (0 = idle), so Health can tell a blocked callback
+	deliveringSince atomic.Int64
+
+	// PollMode of the last poll cycle, reported by Stats
+	lastPollMode atomic.Int32
+
+	// POLL AND CACHE METRICS: Reported by Stats, cleared by ResetStats
+	pollCycles       atomic.Int64
+	lastPollDuration atomic.Int64
+	cacheHits        atomic.Int64
+	cacheMisses      atomic.Int64
+	callbackObserver atomic.Pointer[CallbackObserver]
+
+	// INTERVALS: Signals the poll loop that a per-file interval was set
+	intervalCh chan struct{}
+
+	// WARNINGS: Open runtime warning episodes (poll loop goroutine only)
+	warnings warningState
+
+	// OVERRIDES: In-process values layered over parsed configs, by absolute path
+	overrides   map[string]map[string]interface{}
+	overridesMu sync.RWMutex
+
+	// GLOBS: Patterns registered with WatchGlob, re-evaluated every poll cycle
+	globs   []*globWatch
+	globsMu sync.Mutex
+
+	// CALLBACK POOL: Per-path delivery workers (nil unless CallbackConcurrency > 1)
+	callbackPool *callbackPool
+
+	// SHUTDOWN: Callbacks currently executing or queued in the callback pool,
+	// and progress of the last GracefulShutdown
+	callbacksInFlight atomic.Int64
+	shutdownTracker   atomic.Pointer[shutdownTracker]
+
+	// REMOTE: Sync for Config.Remote (nil when disabled) and WatchRemote callbacks
+	remote          *RemoteConfigManager
+	remoteCallbacks []UpdateCallback
+	remoteMu        sync.RWMutex
+
+	// LAST GOOD: Last configuration delivered per absolute path (KeepLastGood)
+	lastGood   map[string]map[string]interface{}
+	lastGoodMu sync.RWMutex
+
 	running   atomic.Bool
 	stopped   atomic.Bool // Tracks if explicitly stopped vs just not started
 	stopCh    chan struct{}
@@ -431,6 +754,7 @@ func New(config Config) *Watcher {
 		auditLogger: auditLogger,
 		stopCh:      make(chan struct{}),
 		stoppedCh:   make(chan struct{}),
+		intervalCh:  make(chan struct{}, 1),
 		ctx:         ctx,
 		cancel:      cancel,
 	}
@@ -439,12 +763,38 @@ func New(config Config) *Watcher {
 	initialCache := make(map[string]fileStat)
 	watcher.statCache.Store(&initialCache)
 
+	// Make watched files visible to directory watch overlap checks
+	registerWatcher(watcher)
+
+	// Restore state handed off by a previous process
+	if len(cfg.InitialState) > 0 {
+		state, err := decodeWatcherState(cfg.InitialState)
+		if err != nil {
+			if cfg.ErrorHandler != nil {
+				cfg.ErrorHandler(err, "")
+			}
+		} else {
+			watcher.importedState = state
+		}
+	}
+
 	// Initialize BoreasLite MPSC ring buffer with configured strategy
 	watcher.eventRing = NewBoreasLite(
 		watcher.config.BoreasLiteCapacity,
 		watcher.config.OptimizationStrategy,
 		watcher.processFileEvent,
 	)
+	if watcher.config.AdaptiveCapacity {
+		watcher.eventRing.EnableAdaptiveCapacity(watcher.config.BoreasLiteMaxCapacity)
+	}
+	if watcher.config.CallbackConcurrency > 1 {
+		watcher.callbackPool = newCallbackPool(watcher,
+			watcher.config.CallbackConcurrency, watcher.config.BoreasLiteCapacity)
+	}
+
+	if watcher.config.Remote.Enabled {
+		watcher.initRemote()
+	}
 
 	return watcher
 }
@@ -452,33 +802,126 @@ func New(config Config) *Watcher {
 // processFileEvent processes events from the BoreasLite ring buffer
 // This method is called by BoreasLite for each file change event
 func (w *Watcher) processFileEvent(fileEvent *FileChangeEvent) {
+	w.callbacksInFlight.Add(1)
+	defer w.callbacksInFlight.Add(-1)
+	w.deliveringSince.Store(monoNow())
+	defer w.deliveringSince.Store(0)
+
 	// CRITICAL: Panic recovery to prevent callback panics from crashing the watcher
 	defer func() {
 		if r := recover(); r != nil {
-			w.auditLogger.LogFileWatch("callback_panic", string(fileEvent.Path[:]))
+			w.reportCallbackPanic(string(fileEvent.Path[:fileEvent.PathLen]), r)
 		}
 	}()
 
 	// Convert BoreasLite event back to standard ChangeEvent
 	event := ConvertFileEventToChangeEvent(*fileEvent)
+	if fileEvent.Flags&fileEventRemote != 0 {
+		w.deliverRemoteEvent(event)
+		return
+	}
+	if w.config.DebounceInterval > 0 && fileEvent.Flags&fileEventDebounced == 0 {
+		w.debounceFileEvent(event)
+		return
+	}
+
+	if w.callbackPool != nil {
+		w.callbackPool.dispatch(event)
+		return
+	}
+	w.deliverFileEvent(event)
+}
+
+// deliverFileEvent finds the watched file of event and delivers the event to
+// its callbacks
+func (w *Watcher) deliverFileEvent(event ChangeEvent) {
+	if w.config.IncludeContentInEvents && !event.IsDelete {
+		// Read before taking filesMu, see attachContent
+		event.NewContent = w.readContent(event.Path)
+	}
 
-	// Find the corresponding watched file and call its callback
 	w.filesMu.RLock()
+	defer w.filesMu.RUnlock()
 	if wf, exists := w.files[event.Path]; exists {
-		// Call the user's callback function
-		wf.callback(event)
+		w.deliverEvent(wf, event)
+	}
+}
 
-		// Log basic file change to audit system
-		w.auditLogger.LogFileWatch("file_changed", event.Path)
+// reportCallbackPanic records a panic recovered from a callback for path and
+// forwards it to the ErrorHandler as an ErrCodeCallbackPanic error carrying
+// the recovered value and the stack of the panicking goroutine
+func (w *Watcher) reportCallbackPanic(path string, r interface{}) {
+	w.auditLogger.LogFileWatch("callback_panic", path)
+	if w.config.ErrorHandler != nil {
+		w.config.ErrorHandler(errors.New(ErrCodeCallbackPanic, fmt.Sprintf("callback panicked: %v", r)).
+			WithContext("path", path).
+			WithContext("panic", r).
+			WithContext("stack", string(debug.Stack())), path)
 	}
-	w.filesMu.RUnlock()
 }
 
-// Watch adds a file to the watch list
+// deliverEvent invokes the callbacks of wf and publishes event.
+// Called with filesMu (read) held.
+func (w *Watcher) deliverEvent(wf *watchedFile, event ChangeEvent) {
+	// Call the user's callbacks; a panic in one does not starve the others
+	wf.delivered.Add(1)
+	if w.config.IncludeContentInEvents {
+		w.attachContent(wf, &event)
+	}
+	start := monoNow()
+	for i, callback := range wf.callbacks {
+		callbackStart := monoNow()
+		w.invokeCallback(callback, event)
+		w.recordCallback(wf, i, time.Duration(monoNow()-callbackStart))
+	}
+	if observer := w.callbackObserver.Load(); observer != nil {
+		(*observer)(event.Path, time.Duration(monoNow()-start))
+	}
+	w.publishEvent(event)
+
+	// Log basic file change to audit system
+	w.auditLogger.LogFileWatch("file_changed", event.Path)
+}
+
+// Watch adds a file to the watch list. Watching a path that is already
+// watched follows Config.DuplicateWatch: by default the new callback
+// replaces the previous one (use ReplaceWatch to get it back).
+//
+// The file does not need to exist yet, nor does its directory: the path is
+// polled until the file appears, which is reported with IsCreate (e.g. a
+// secret mounted after startup). A file that is later removed is reported
+// with IsDelete at the next check (after Config.DeleteGracePeriod, if set),
+// and the watch keeps waiting for it to be created again.
 func (w *Watcher) Watch(path string, callback UpdateCallback) error {
+	return w.WatchContext(context.Background(), path, callback)
+}
+
+// WatchContext is Watch bound to ctx: when ctx is cancelled the callback
+// stops firing at once and is removed from the file. Callbacks fanned out
+// onto the path by other Watch calls keep firing; the file is unwatched once
+// none is left. If the callback was already removed (Unwatch, a replacing
+// Watch or ReplaceWatch), cancelling ctx has no effect on the path. A ctx
+// that is already done is rejected.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel() // Stops the watch when the component shuts down
+//	err := watcher.WatchContext(ctx, "plugin.yaml", onPluginChange)
+func (w *Watcher) WatchContext(ctx context.Context, path string, callback UpdateCallback) error {
+	return w.watch(ctx, path, callback, 0)
+}
+
+// watch registers callback for path, bound to ctx and polled every interval
+// (0 = Config.PollInterval)
+func (w *Watcher) watch(ctx context.Context, path string, callback UpdateCallback, interval time.Duration) error {
 	if callback == nil {
 		return errors.New(ErrCodeInvalidConfig, "callback cannot be nil")
 	}
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, ErrCodeInvalidConfig, "context is already done").
+			WithContext("path", path)
+	}
 
 	// Check if watcher was explicitly stopped (not just not started)
 	if w.stopped.Load() {
@@ -491,10 +934,98 @@ func (w *Watcher) Watch(path string, callback UpdateCallback) error {
 		return err
 	}
 
+	if err := reportOverlap(w.config.WatchOverlap, fileOverlap(absPath), absPath, w.config.ErrorHandler); err != nil {
+		return err
+	}
+
 	// AUDIT: Log file watch start
 	w.auditLogger.LogFileWatch("watch_start", absPath)
 
-	return w.addWatchedFile(absPath, callback)
+	if ctx.Done() == nil {
+		if err := w.addWatchedFile(absPath, callback, interval, nil); err != nil {
+			return err
+		}
+		w.fireOnWatch(absPath)
+		return nil
+	}
+
+	// Gate the callback so that events already queued when ctx is cancelled
+	// are dropped, not only changes detected after the unwatch
+	gated := func(event ChangeEvent) {
+		if ctx.Err() == nil {
+			callback(event)
+		}
+	}
+	registration := &watchRegistration{removed: make(chan struct{})}
+	if err := w.addWatchedFile(absPath, gated, interval, registration); err != nil {
+		return err
+	}
+	go w.unwatchOnDone(ctx, absPath, registration)
+	w.fireOnWatch(absPath)
+	return nil
+}
+
+// watchRegistration identifies the callback added by one WatchContext call,
+// so that its context removes that callback and no other
+type watchRegistration struct {
+	removed chan struct{} // Closed once the callback is no longer registered
+}
+
+// releaseRegistrations marks registrations as removed from their file
+func releaseRegistrations(registrations []*watchRegistration) {
+	for _, registration := range registrations {
+		if registration != nil {
+			close(registration.removed)
+		}
+	}
+}
+
+// unwatchOnDone removes the callback of registration from absPath when ctx
+// is done. It returns early if the callback is removed otherwise (Unwatch,
+// a replacing Watch or ReplaceWatch) or the watcher stops, so the goroutine
+// never outlives the registration.
+func (w *Watcher) unwatchOnDone(ctx context.Context, absPath string, registration *watchRegistration) {
+	select {
+	case <-ctx.Done():
+		if w.removeRegistration(absPath, registration) {
+			w.auditLogger.LogFileWatch("watch_context_done", absPath)
+		}
+	case <-registration.removed:
+	case <-w.ctx.Done():
+	}
+}
+
+// removeRegistration removes the callback of registration from absPath,
+// unwatching the file if it was its last callback. It reports false if the
+// callback was no longer registered.
+func (w *Watcher) removeRegistration(absPath string, registration *watchRegistration) bool {
+	w.filesMu.Lock()
+	defer w.filesMu.Unlock()
+
+	wf, exists := w.files[absPath]
+	if !exists {
+		return false
+	}
+	index := -1
+	for i, owner := range wf.registrations {
+		if owner == registration {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return false
+	}
+	if len(wf.callbacks) == 1 {
+		w.unwatchLocked(absPath)
+		return true
+	}
+
+	// Copy on write, like fan-out registration
+	wf.callbacks = append(append([]UpdateCallback{}, wf.callbacks[:index]...), wf.callbacks[index+1:]...)
+	wf.registrations = append(append([]*watchRegistration{}, wf.registrations[:index]...), wf.registrations[index+1:]...)
+	close(registration.removed)
+	return true
 }
 
 // validateAndSecurePath validates path security and returns absolute path
@@ -618,11 +1149,27 @@ func (w *Watcher) isSystemDirectory(path string) bool {
 		strings.Contains(lowerPath, "program files")
 }
 
-// addWatchedFile adds the file to watch list with proper locking
-func (w *Watcher) addWatchedFile(absPath string, callback UpdateCallback) error {
+// addWatchedFile adds the file to watch list with proper locking.
+// registration identifies a WatchContext callback (nil otherwise).
+func (w *Watcher) addWatchedFile(absPath string, callback UpdateCallback, interval time.Duration, registration *watchRegistration) error {
+	// Baseline reported in OldContent by the first event, read before
+	// taking filesMu. A file that is missing or unreadable starts with none.
+	var content []byte
+	if w.config.IncludeContentInEvents {
+		content = w.readContent(absPath)
+	}
+
 	w.filesMu.Lock()
 	defer w.filesMu.Unlock()
 
+	if wf, exists := w.files[absPath]; exists {
+		if err := w.addDuplicateWatch(wf, callback, registration); err != nil {
+			return err
+		}
+		w.setPollInterval(wf, interval)
+		return nil
+	}
+
 	if len(w.files) >= w.config.MaxWatchedFiles {
 		// AUDIT: Log security event for limit exceeded
 		w.auditLogger.LogSecurityEvent("watch_limit_exceeded", "Maximum watched files exceeded",
@@ -636,18 +1183,26 @@ func (w *Watcher) addWatchedFile(absPath string, callback UpdateCallback) error
 			WithContext("current_files", len(w.files))
 	}
 
-	// Get initial file stat
-	initialStat, err := w.getStat(absPath)
-	if err != nil && !os.IsNotExist(err) {
-		return errors.Wrap(err, ErrCodeFileNotFound, "failed to stat file").
-			WithContext("path", absPath)
+	// Get initial file stat, preferring a baseline handed off by a previous process
+	initialStat, imported := w.takeImportedStat(absPath)
+	if !imported {
+		var err error
+		initialStat, err = w.getStat(absPath)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, ErrCodeFileNotFound, "failed to stat file").
+				WithContext("path", absPath)
+		}
 	}
 
-	w.files[absPath] = &watchedFile{
-		path:     absPath,
-		callback: callback,
-		lastStat: initialStat,
+	wf := &watchedFile{
+		path:          absPath,
+		callbacks:     []UpdateCallback{callback},
+		registrations: []*watchRegistration{registration},
+		lastStat:      initialStat,
+		content:       content,
 	}
+	w.files[absPath] = wf
+	w.setPollInterval(wf, interval)
 
 	// Adapt BoreasLite strategy based on file count (if Auto mode)
 	if w.eventRing != nil {
@@ -668,6 +1223,19 @@ func (w *Watcher) Unwatch(path string) error {
 	w.filesMu.Lock()
 	defer w.filesMu.Unlock()
 
+	w.unwatchLocked(absPath)
+	return nil
+}
+
+// unwatchLocked removes absPath from the watch list. Called with filesMu held.
+func (w *Watcher) unwatchLocked(absPath string) {
+	if wf, exists := w.files[absPath]; exists {
+		releaseRegistrations(wf.registrations)
+		if wf.interval > 0 {
+			// The loop may be ticking at this file's interval
+			w.wakePollLoop()
+		}
+	}
 	delete(w.files, absPath)
 
 	// Adapt BoreasLite strategy based on updated file count (if Auto mode)
@@ -677,8 +1245,6 @@ func (w *Watcher) Unwatch(path string) error {
 
 	// Clean up cache entry atomically
 	w.removeFromCache(absPath)
-
-	return nil
 }
 
 // Start begins watching files for changes
@@ -690,31 +1256,74 @@ func (w *Watcher) Start() error {
 	// Start BoreasLite event processor in background
 	go w.eventRing.RunProcessor()
 
-	// Start main polling loop
+	// Start main polling loop, with the start as the first liveness mark
+	w.markPoll()
 	go w.watchLoop()
+	if w.config.OnPollStall != nil {
+		go w.watchdogLoop()
+	}
+	if w.remote != nil {
+		go w.startRemote()
+	}
+	if w.config.ReloadOnSIGHUP {
+		w.startSIGHUPReload()
+	}
+
+	if w.config.FireOnStart {
+		for _, path := range w.WatchedPaths() {
+			w.fireInitialEvent(path)
+		}
+	}
 	return nil
 }
 
-// Stop stops the watcher and waits for cleanup
+// Stop stops the watcher and waits for cleanup. With CallbackConcurrency,
+// events still queued in the callback pool are dropped and Stop waits for
+// the callbacks already running, so none runs after it returns.
 func (w *Watcher) Stop() error {
 	if !w.running.CompareAndSwap(true, false) {
 		return errors.New(ErrCodeWatcherStopped, "watcher is not running")
 	}
 
+	w.stopPolling()
+	w.stopEventDelivery()
+
+	// CRITICAL FIX: Close audit logger to prevent resource leaks
+	_ = w.closeAudit()
+
+	return nil
+}
+
+// stopPolling signals the polling loop to exit and waits for it
+func (w *Watcher) stopPolling() {
 	w.stopped.Store(true) // Mark as explicitly stopped
 	w.cancel()
+	if w.remote != nil {
+		w.remote.Stop()
+	}
 	close(w.stopCh)
 	<-w.stoppedCh
+	unregisterWatcher(w)
+}
 
-	// Stop BoreasLite event processor
+// stopEventDelivery stops the BoreasLite event processor, drops the events
+// queued in the callback pool and waits for the callbacks it runs, then
+// closes the Events channel
+func (w *Watcher) stopEventDelivery() {
 	w.eventRing.Stop()
-
-	// CRITICAL FIX: Close audit logger to prevent resource leaks
-	if w.auditLogger != nil {
-		_ = w.auditLogger.Close()
+	if w.callbackPool != nil {
+		w.callbackPool.close()
+		_ = w.waitCallbacks(context.Background())
 	}
+	w.closeEvents(w.config.EventsShutdown == EventsShutdownDiscard)
+}
 
-	return nil
+// closeAudit flushes and closes the audit logger
+func (w *Watcher) closeAudit() error {
+	if w.auditLogger == nil {
+		return nil
+	}
+	return w.auditLogger.Close()
 }
 
 // IsRunning returns true if the watcher is currently running
@@ -735,9 +1344,16 @@ func (w *Watcher) Close() error {
 // The method performs the following shutdown sequence:
 // 1. Signals shutdown intent to all goroutines via context cancellation
 // 2. Waits for all file polling operations to complete
-// 3. Flushes all pending audit events to persistent storage
-// 4. Closes BoreasLite ring buffer and releases memory
-// 5. Cleans up file descriptors and other system resources
+// 3. Delivers queued events, then closes BoreasLite ring buffer and releases memory
+// 4. Runs hooks registered with RegisterShutdownHook in registration order
+// 5. Flushes all pending audit events to persistent storage
+// 6. Cleans up file descriptors and other system resources
+// 7. Runs hooks registered with OnShutdown in LIFO order
+//
+// Each step is recorded per subsystem; ShutdownReport returns which steps
+// completed, failed or timed out and how long each took. Remote config
+// watches are not owned by the watcher: cancel their context from an
+// OnShutdown hook to have them covered by the report.
 //
 // Zero-allocation design: Uses pre-allocated channels and avoids heap allocations
 // during the shutdown process to maintain performance characteristics even during termination.
@@ -763,6 +1379,7 @@ func (w *Watcher) Close() error {
 //   - nil if shutdown completed within timeout
 //   - ErrCodeWatcherStopped if watcher was already stopped
 //   - ErrCodeWatcherBusy if shutdown timeout was exceeded (resources still cleaned up)
+//   - ErrCodeShutdownHook if one or more shutdown hooks failed (all hooks still run)
 //
 // Thread-safety: Safe to call from multiple goroutines. First caller wins, subsequent
 // calls return immediately with appropriate status.
@@ -773,29 +1390,99 @@ func (w *Watcher) Close() error {
 //   - CI/CD: Use shorter timeouts (5-10s) for faster test cycles
 //   - Load balancers: Ensure timeout exceeds health check intervals
 func (w *Watcher) GracefulShutdown(timeout time.Duration) error {
-	// Fast path: Check if already stopped without allocations
-	if !w.running.Load() {
-		return errors.New(ErrCodeWatcherStopped, "watcher is not running")
-	}
+	_, err := w.GracefulShutdownWithReport(timeout)
+	return err
+}
 
+// GracefulShutdownWithReport performs GracefulShutdown and returns its report:
+// the status and duration of every step, the number of queued events
+// delivered, whether the audit trail was flushed and whether the timeout cut
+// the shutdown short. The report is a snapshot taken when the call returns;
+// ShutdownReport reflects steps that finish later in the background. The
+// report is nil if the watcher was not running.
+//
+// Example:
+//
+//	report, err := watcher.GracefulShutdownWithReport(25 * time.Second)
+//	if report != nil {
+//	    log.Printf("shutdown took %v, %d events flushed, audit flushed: %t, timed out: %t",
+//	        report.Duration, report.EventsFlushed, report.AuditFlushed, report.TimedOut)
+//	}
+func (w *Watcher) GracefulShutdownWithReport(timeout time.Duration) (*ShutdownReport, error) {
 	// Pre-validate timeout to avoid work if invalid
 	if timeout <= 0 {
-		return errors.New(ErrCodeInvalidConfig, "graceful shutdown timeout must be positive")
+		return nil, errors.New(ErrCodeInvalidConfig, "graceful shutdown timeout must be positive")
+	}
+
+	// Fast path: nothing to stop, but registered hooks still own application
+	// resources and must be released
+	if !w.running.CompareAndSwap(true, false) {
+		err := errors.New(ErrCodeWatcherStopped, "watcher is not running")
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		hookErr := joinShutdownErrors(w.runFlushHooks(ctx), w.runShutdownHooks(ctx))
+		return nil, joinShutdownErrors(err, hookErr)
 	}
 
 	// Create timeout context - this is the only allocation we make
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	tracker := newShutdownTracker(ShutdownPollLoop, ShutdownCallbacks, ShutdownEventDrain,
+		ShutdownEventsChannel, ShutdownFlushHooks, ShutdownAuditFlush, ShutdownHooks)
+	eventsProcessed := w.eventRing.processed.Load()
+	w.shutdownTracker.Store(tracker)
+
 	// Channel for shutdown completion signaling (buffered to avoid blocking)
 	// Pre-allocated with capacity 1 to prevent goroutine leaks
 	done := make(chan error, 1)
 
-	// Execute shutdown in separate goroutine to respect timeout
+	// Execute shutdown in separate goroutine to respect timeout. Each step is
+	// recorded in the tracker, so a step that outlives the deadline shows up
+	// as timed out in the report while it keeps running here.
 	go func() {
-		// Use existing Stop() method which handles all cleanup logic
-		// This avoids code duplication and maintains consistency
-		err := w.Stop()
+		var errs []error
+		_ = tracker.run(0, func() error {
+			w.stopPolling()
+			return nil
+		})
+		// Waits are bounded so a stuck callback cannot block resource cleanup
+		if err := tracker.run(1, func() error { return w.waitCallbacks(ctx) }); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ShutdownCallbacks, err))
+		}
+		if err := tracker.run(2, func() error {
+			// Changes still inside their debounce window are delivered too
+			w.flushAllDebounced()
+			err := w.eventRing.flush(ctx)
+			w.eventRing.Stop()
+			if w.callbackPool != nil {
+				// Drained events may still be queued in the callback pool;
+				// what the deadline leaves undelivered is dropped
+				if err == nil {
+					err = w.waitCallbacks(ctx)
+				}
+				w.callbackPool.close()
+			}
+			tracker.setEventsFlushed(w.eventRing.processed.Load() - eventsProcessed)
+			return err
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ShutdownEventDrain, err))
+		}
+		if err := tracker.run(3, func() error { return w.flushEvents(ctx) }); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ShutdownEventsChannel, err))
+		}
+		flushHookErr := tracker.run(4, func() error { return w.runFlushHooks(ctx) })
+		if err := tracker.run(5, w.closeAudit); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ShutdownAuditFlush, err))
+		}
+
+		var err error
+		if len(errs) > 0 {
+			err = errors.Wrap(goerrors.Join(errs...), ErrCodeWatcherStopped, "graceful shutdown encountered error")
+		}
+		// Tear down application resources even if a watcher step failed
+		hookErr := tracker.run(6, func() error { return w.runShutdownHooks(ctx) })
+		err = joinShutdownErrors(err, joinShutdownErrors(flushHookErr, hookErr))
 		select {
 		case done <- err:
 			// Successfully sent result
@@ -810,16 +1497,16 @@ func (w *Watcher) GracefulShutdown(timeout time.Duration) error {
 	select {
 	case err := <-done:
 		// Shutdown completed within timeout
-		if err != nil {
-			// Wrap the error to provide context about graceful shutdown
-			return errors.Wrap(err, ErrCodeWatcherStopped, "graceful shutdown encountered error")
-		}
-		return nil
+		tracker.finish(false)
+		report := tracker.report()
+		return &report, err
 
 	case <-ctx.Done():
+		tracker.finish(true)
 		// Timeout exceeded - return error but allow background cleanup to continue
 		// This ensures resources are eventually freed even if timeout is too short
-		return errors.New(ErrCodeWatcherBusy,
+		report := tracker.report()
+		return &report, errors.New(ErrCodeWatcherBusy,
 			fmt.Sprintf("graceful shutdown timeout (%v) exceeded, cleanup continuing in background", timeout))
 	}
 }
@@ -831,6 +1518,20 @@ func (w *Watcher) WatchedFiles() int {
 	return len(w.files)
 }
 
+// WatchedPaths returns the absolute paths of the currently watched files,
+// sorted. The slice is a copy: modifying it does not affect the watcher.
+func (w *Watcher) WatchedPaths() []string {
+	w.filesMu.RLock()
+	paths := make([]string, 0, len(w.files))
+	for path := range w.files {
+		paths = append(paths, path)
+	}
+	w.filesMu.RUnlock()
+
+	sort.Strings(paths)
+	return paths
+}
+
 // getStat returns cached file statistics or performs os.Stat if cache is expired
 // LOCK-FREE: Uses atomic.Pointer for zero-contention cache access with value types
 func (w *Watcher) getStat(path string) (fileStat, error) {
@@ -839,9 +1540,11 @@ func (w *Watcher) getStat(path string) (fileStat, error) {
 	if cached, exists := cacheMap[path]; exists {
 		// Check expiration without any locks
 		if !cached.isExpired(w.config.CacheTTL) {
+			w.cacheHits.Add(1)
 			return cached, nil
 		}
 	}
+	w.cacheMisses.Add(1)
 
 	// Slow path: cache miss or expired - perform actual os.Stat()
 	info, err := os.Stat(path)
@@ -853,6 +1556,7 @@ func (w *Watcher) getStat(path string) (fileStat, error) {
 	if err == nil {
 		stat.modTime = info.ModTime()
 		stat.size = info.Size()
+		stat.info = info
 	}
 
 	// Update cache atomically (copy-on-write)
@@ -914,16 +1618,14 @@ func (w *Watcher) removeFromCache(path string) {
 // checkFile compares current file stat with last known stat and sends events via BoreasLite
 func (w *Watcher) checkFile(wf *watchedFile) {
 	currentStat, err := w.getStat(wf.path)
+	if err == nil && !currentStat.exists {
+		err = os.ErrNotExist // Cached result of a failed stat
+	}
 
 	// Handle stat errors
 	if err != nil {
 		if os.IsNotExist(err) {
-			// File was deleted
-			if wf.lastStat.exists {
-				// Send delete event via BoreasLite ring buffer
-				w.eventRing.WriteFileChange(wf.path, time.Time{}, 0, false, true, false)
-				wf.lastStat.exists = false
-			}
+			w.checkMissingFile(wf)
 		} else if w.config.ErrorHandler != nil {
 			w.config.ErrorHandler(errors.Wrap(err, ErrCodeFileNotFound, "failed to stat file").
 				WithContext("path", wf.path), wf.path)
@@ -931,23 +1633,131 @@ func (w *Watcher) checkFile(wf *watchedFile) {
 		return
 	}
 
+	// A file that came back within the delete grace period, or whose inode
+	// changed, was replaced by an atomic save: one modify, never delete plus create
+	replaced := wf.missingSince != 0 || isReplacedFile(wf.lastStat, currentStat)
+	wf.missingSince = 0
+
+	// SECURITY: Never report oversized files, so no consumer reads them
+	changed := !wf.lastStat.exists || replaced || currentStat.modTime != wf.lastStat.modTime || currentStat.size != wf.lastStat.size
+	if changed && w.exceedsMaxFileSize(wf.path, currentStat.size) {
+		wf.lastStat = currentStat
+		return
+	}
+
 	// File exists now
 	if !wf.lastStat.exists {
 		// File was created - send via BoreasLite
-		w.eventRing.WriteFileChange(wf.path, currentStat.modTime, currentStat.size, true, false, false)
+		w.emitFileChange(wf, currentStat.modTime, currentStat.size, FileEventCreate)
+	} else if replaced {
+		w.emitFileChange(wf, currentStat.modTime, currentStat.size, FileEventModify|FileEventRename)
 	} else if currentStat.modTime != wf.lastStat.modTime || currentStat.size != wf.lastStat.size {
 		// File was modified - send via BoreasLite
-		w.eventRing.WriteFileChange(wf.path, currentStat.modTime, currentStat.size, false, false, true)
+		w.emitFileChange(wf, currentStat.modTime, currentStat.size, FileEventModify)
 	}
 
 	wf.lastStat = currentStat
 }
 
+// checkMissingFile handles a watched file that no longer exists. With
+// Config.DeleteGracePeriod the delete is held back until the file has been
+// missing for the grace period, for tools that save by deleting the file and
+// writing a new one later; if it is back by then, checkFile reports a single
+// rename. Without it the delete is reported at once.
+func (w *Watcher) checkMissingFile(wf *watchedFile) {
+	if !wf.lastStat.exists {
+		return
+	}
+	if grace := w.config.DeleteGracePeriod; grace > 0 {
+		now := monoNow()
+		if wf.missingSince == 0 {
+			wf.missingSince = now
+			return
+		}
+		if time.Duration(now-wf.missingSince) < grace {
+			return
+		}
+	}
+
+	// Send delete event via BoreasLite ring buffer
+	wf.missingSince = 0
+	w.emitFileChange(wf, time.Time{}, 0, FileEventDelete)
+	wf.lastStat.exists = false
+}
+
+// isReplacedFile reports whether current is a different file (inode and
+// device on Unix, file index on Windows) than last. Without identity
+// information, e.g. for a baseline imported from Config.InitialState,
+// changes are detected by size and modification time only.
+func isReplacedFile(last, current fileStat) bool {
+	return last.exists && last.info != nil && current.info != nil && !os.SameFile(last.info, current.info)
+}
+
+// fireOnWatch delivers the initial event for a file watched while the
+// watcher runs with Config.FireOnStart
+func (w *Watcher) fireOnWatch(absPath string) {
+	if w.config.FireOnStart && w.running.Load() {
+		w.fireInitialEvent(absPath)
+	}
+}
+
+// fireInitialEvent queues a synthetic IsModify event carrying the current
+// state of absPath, reporting a file that cannot be read to the ErrorHandler
+func (w *Watcher) fireInitialEvent(absPath string) {
+	stat, err := w.getStat(absPath)
+	if err == nil && !stat.exists {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		if w.config.ErrorHandler != nil {
+			w.config.ErrorHandler(errors.Wrap(err, ErrCodeFileNotFound, "failed to read file on start").
+				WithContext("path", absPath), absPath)
+		}
+		return
+	}
+	if w.exceedsMaxFileSize(absPath, stat.size) {
+		return
+	}
+	w.eventRing.writeFileChangeFlags(absPath, stat.modTime, stat.size, FileEventModify)
+}
+
+// emitFileChange queues a change event for wf and counts the detection
+func (w *Watcher) emitFileChange(wf *watchedFile, modTime time.Time, size int64, flags uint8) {
+	wf.detected.Add(1)
+	w.eventRing.writeFileChangeFlags(wf.path, modTime, size, flags)
+}
+
+// exceedsMaxFileSize reports whether size is over Config.MaxFileSize,
+// recording a security audit event and notifying the ErrorHandler if so
+func (w *Watcher) exceedsMaxFileSize(path string, size int64) bool {
+	if w.config.MaxFileSize <= 0 || size <= w.config.MaxFileSize {
+		return false
+	}
+
+	w.auditLogger.LogSecurityEvent("file_size_exceeded", "Watched file exceeds maximum size",
+		map[string]interface{}{
+			"path":          path,
+			"size":          size,
+			"max_file_size": w.config.MaxFileSize,
+		})
+
+	if w.config.ErrorHandler != nil {
+		w.config.ErrorHandler(errors.New(ErrCodeFileTooLarge, "file exceeds maximum size").
+			WithContext("path", path).
+			WithContext("size", size).
+			WithContext("max_file_size", w.config.MaxFileSize), path)
+	}
+
+	return true
+}
+
 // watchLoop is the main polling loop that checks all watched files
 func (w *Watcher) watchLoop() {
 	defer close(w.stoppedCh)
 
-	ticker := time.NewTicker(w.config.PollInterval)
+	// The loop ticks at the shortest interval of any watched file
+	tick := w.pollTick()
+	ticker := time.NewTicker(tick)
 	defer ticker.Stop()
 
 	for {
@@ -956,34 +1766,59 @@ func (w *Watcher) watchLoop() {
 			return
 		case <-w.stopCh:
 			return
+		case <-w.intervalCh:
+			if next := w.pollTick(); next != tick {
+				tick = next
+				ticker.Reset(tick)
+			}
 		case <-ticker.C:
-			w.pollFiles()
+			start := monoNow()
+			w.pollScheduled(start, tick)
+			w.refreshGlobs()
+			w.recordPollCycle(time.Duration(monoNow() - start))
+			w.markPoll()
+			w.checkWarnings()
 		}
 	}
 }
 
-// pollFiles checks all watched files for changes
-// ULTRA-OPTIMIZED: Zero-allocation version using reusable buffer
+// pollFiles checks all watched files for changes, regardless of their
+// polling schedule
 func (w *Watcher) pollFiles() {
+	w.pollScheduled(0, 0)
+}
+
+// pollScheduled checks the watched files due at now (a monoNow value) for a
+// poll loop ticking every tick. A zero now checks every file.
+// ULTRA-OPTIMIZED: Zero-allocation version using reusable buffer
+func (w *Watcher) pollScheduled(now int64, tick time.Duration) {
+	w.pollMu.Lock()
+	defer w.pollMu.Unlock()
+
 	w.filesMu.RLock()
 	// Reuse buffer to avoid allocations
 	w.filesBuffer = w.filesBuffer[:0] // Reset slice but keep capacity
 	for _, wf := range w.files {
-		w.filesBuffer = append(w.filesBuffer, wf)
+		if now == 0 || w.isDue(wf, now, tick) {
+			w.filesBuffer = append(w.filesBuffer, wf)
+		}
 	}
 	files := w.filesBuffer
 	w.filesMu.RUnlock()
 
 	// For single file, use direct checking to avoid goroutine overhead
-	if len(files) == 1 {
-		w.checkFile(files[0])
+	if len(files) <= 1 {
+		w.lastPollMode.Store(int32(PollSequential))
+		if len(files) == 1 {
+			w.checkFile(files[0])
+		}
 		return
 	}
 
 	// For multiple files, use parallel checking with limited concurrency
-	const maxConcurrency = 8 // Prevent goroutine explosion
-	if len(files) <= maxConcurrency {
+	if len(files) <= w.config.WorkerPoolThreshold {
 		// Use goroutines for small number of files
+		w.lastPollMode.Store(int32(PollPerFile))
 		var wg sync.WaitGroup
 		for _, wf := range files {
 			wg.Add(1)
@@ -995,11 +1830,13 @@ func (w *Watcher) pollFiles() {
 		wg.Wait()
 	} else {
 		// Use worker pool for many files
+		w.lastPollMode.Store(int32(PollWorkerPool))
 		fileCh := make(chan *watchedFile, len(files))
 		var wg sync.WaitGroup
 
-		// Start workers
-		for i := 0; i < maxConcurrency; i++ {
+		// Start workers, never more than there are files
+		workers := min(pollPoolWorkers, len(files))
+		for i := 0; i < workers; i++ {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
@@ -1060,10 +1897,13 @@ func (w *Watcher) GetCacheStats() CacheStats {
 		}
 	}
 
+	// Ages clamp to zero if the wall clock went backwards
+	oldestAge, _ := wallElapsed(now, oldest)
+	newestAge, _ := wallElapsed(now, newest)
 	return CacheStats{
 		Entries:   len(cacheMap),
-		OldestAge: time.Duration(now - oldest),
-		NewestAge: time.Duration(now - newest),
+		OldestAge: oldestAge,
+		NewestAge: newestAge,
 	}
 }
 
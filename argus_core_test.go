@@ -734,3 +734,37 @@ func TestWatcher_Close(t *testing.T) {
 		}
 	})
 }
+
+func TestWatcher_WatchedPaths(t *testing.T) {
+	dir := t.TempDir()
+	watcher := New(Config{DisableAudit: true})
+
+	if paths := watcher.WatchedPaths(); len(paths) != 0 {
+		t.Errorf("New watcher should have no watched paths, got %v", paths)
+	}
+
+	for _, name := range []string{"b.json", "a.json"} {
+		if err := watcher.Watch(filepath.Join(dir, name), func(ChangeEvent) {}); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+
+	paths := watcher.WatchedPaths()
+	want := []string{filepath.Join(dir, "a.json"), filepath.Join(dir, "b.json")}
+	if len(paths) != 2 || paths[0] != want[0] || paths[1] != want[1] {
+		t.Fatalf("Expected sorted paths %v, got %v", want, paths)
+	}
+
+	// The result is a copy
+	paths[0] = "mutated"
+	if watcher.WatchedPaths()[0] != want[0] {
+		t.Error("Modifying the returned slice should not affect the watcher")
+	}
+
+	if err := watcher.Unwatch(want[1]); err != nil {
+		t.Fatalf("Failed to unwatch file: %v", err)
+	}
+	if paths := watcher.WatchedPaths(); len(paths) != 1 || paths[0] != want[0] {
+		t.Errorf("Expected only %s after Unwatch, got %v", want[0], paths)
+	}
+}
@@ -1033,3 +1033,105 @@ func TestSecurity_ValidateSecurePath(t *testing.T) {
 		}
 	})
 }
+
+// TestSecurity_MaxFileSize verifies that oversized files are never reported to
+// callbacks, that the rejection is audited, and that the file recovers once it
+// shrinks back under the limit.
+func TestSecurity_MaxFileSize(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"ok": true}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	var (
+		mu        sync.Mutex
+		events    []ChangeEvent
+		rejection error
+	)
+	watcher := New(Config{
+		PollInterval: 20 * time.Millisecond,
+		CacheTTL:     10 * time.Millisecond,
+		MaxFileSize:  64,
+		Audit: AuditConfig{
+			Enabled:    true,
+			OutputFile: filepath.Join(tempDir, "audit.db"),
+			BufferSize: 100,
+		},
+		ErrorHandler: func(err error, path string) {
+			mu.Lock()
+			rejection = err
+			mu.Unlock()
+		},
+	})
+	if err := watcher.Watch(configPath, func(event ChangeEvent) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	// Balloon the file past the limit
+	if err := os.WriteFile(configPath, []byte(strings.Repeat("x", 4096)), 0600); err != nil {
+		t.Fatalf("Failed to grow config file: %v", err)
+	}
+	time.Sleep(150 * time.Millisecond)
+
+	mu.Lock()
+	if len(events) != 0 {
+		t.Errorf("Expected no events for oversized file, got %d", len(events))
+	}
+	if rejection == nil || !strings.Contains(rejection.Error(), ErrCodeFileTooLarge) {
+		t.Errorf("Expected %s from ErrorHandler, got %v", ErrCodeFileTooLarge, rejection)
+	}
+	mu.Unlock()
+
+	if err := watcher.auditLogger.Flush(); err != nil {
+		t.Fatalf("Failed to flush audit logger: %v", err)
+	}
+	audited, err := watcher.auditLogger.Query(AuditEventFilter{EventPrefix: "file_size_exceeded"})
+	if err != nil {
+		t.Fatalf("Failed to query audit trail: %v", err)
+	}
+	if len(audited) == 0 {
+		t.Error("Expected file_size_exceeded security audit event")
+	}
+
+	// Shrinking back under the limit must resume normal delivery
+	if err := os.WriteFile(configPath, []byte(`{"ok": false}`), 0600); err != nil {
+		t.Fatalf("Failed to shrink config file: %v", err)
+	}
+	time.Sleep(150 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) == 0 {
+		t.Fatal("Expected event after file shrank below the limit")
+	}
+	if last := events[len(events)-1]; last.Size > 64 {
+		t.Errorf("Expected delivered size <= 64, got %d", last.Size)
+	}
+}
+
+// TestSecurity_ReadFileLimited verifies bounded reads for universal watchers
+func TestSecurity_ReadFileLimited(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"key": "value"}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	if _, err := readAndParseConfig(path, FormatJSON, 8, ParseLenient); err == nil || !strings.Contains(err.Error(), ErrCodeFileTooLarge) {
+		t.Errorf("Expected %s for file over limit, got %v", ErrCodeFileTooLarge, err)
+	}
+	if _, err := readAndParseConfig(path, FormatJSON, 1024, ParseLenient); err != nil {
+		t.Errorf("Expected file under limit to parse, got %v", err)
+	}
+	if _, err := readAndParseConfig(path, FormatJSON, 0, ParseLenient); err != nil {
+		t.Errorf("Expected unlimited read to parse, got %v", err)
+	}
+}
@@ -1970,7 +1970,7 @@ func TestRemoteConfigFinal(t *testing.T) {
 	time.Sleep(10 * time.Millisecond)
 
 	// Test checkForChanges with mock provider
-	result := checkForChanges(ctx, mockProvider, "test://url", nil)
+	result := checkForChanges(ctx, mockProvider, "test://url", nil, nil)
 	_ = result // Accept any result
 }
 
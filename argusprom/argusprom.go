@@ -0,0 +1,134 @@
+// Package argusprom exports Argus watcher metrics to Prometheus.
+//
+// It lives in its own module so that the core argus package stays free of
+// the Prometheus client dependency. RegisterPrometheusMetrics registers a
+// collector reading Watcher.Stats on every scrape, plus a histogram of
+// callback latencies fed by Watcher.ObserveCallbacks:
+//
+//	watcher := argus.New(argus.Config{PollInterval: 5 * time.Second})
+//	if err := argusprom.RegisterPrometheusMetrics(prometheus.DefaultRegisterer, watcher); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Exported metrics:
+//
+//	argus_watched_files                    gauge
+//	argus_poll_cycles_total                counter
+//	argus_poll_duration_seconds            gauge (last poll cycle)
+//	argus_cache_hits_total                 counter
+//	argus_cache_misses_total               counter
+//	argus_events_processed_total           counter
+//	argus_events_dropped_total             counter
+//	argus_buffer_occupancy                 gauge
+//	argus_callback_duration_seconds        histogram
+//
+// Counters follow Watcher.Stats, so Watcher.ResetStats shows up as a counter
+// reset. To export several watchers through one registry, register each one
+// through prometheus.WrapRegistererWith with a label telling them apart.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argusprom
+
+import (
+	"time"
+
+	"github.com/agilira/argus"
+	"github.com/agilira/go-errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// namespace prefixes every exported metric
+const namespace = "argus"
+
+// RegisterPrometheusMetrics registers the metrics of w with reg. The
+// callback latency histogram replaces any observer installed on w with
+// Watcher.ObserveCallbacks. Registration fails with
+// argus.ErrCodeInvalidConfig if reg or w is nil, or if the metrics are
+// already registered with reg; a failed registration leaves reg unchanged.
+func RegisterPrometheusMetrics(reg prometheus.Registerer, w *argus.Watcher) error {
+	if reg == nil || w == nil {
+		return errors.New(argus.ErrCodeInvalidConfig, "registerer and watcher are required")
+	}
+
+	latency := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "callback_duration_seconds",
+		Help:      "Time the callbacks of a watched file took to handle one change.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10), // 100µs to ~26s
+	})
+
+	stats := newCollector(w)
+	if err := reg.Register(stats); err != nil {
+		return errors.Wrap(err, argus.ErrCodeInvalidConfig, "failed to register watcher metrics")
+	}
+	if err := reg.Register(latency); err != nil {
+		// Leave reg as it was, so the call can be retried
+		reg.Unregister(stats)
+		return errors.Wrap(err, argus.ErrCodeInvalidConfig, "failed to register callback latency histogram")
+	}
+
+	w.ObserveCallbacks(func(path string, duration time.Duration) {
+		latency.Observe(duration.Seconds())
+	})
+	return nil
+}
+
+// collector reads a Watcher.Stats snapshot on every scrape
+type collector struct {
+	watcher *argus.Watcher
+
+	watchedFiles    *prometheus.Desc
+	pollCycles      *prometheus.Desc
+	pollDuration    *prometheus.Desc
+	cacheHits       *prometheus.Desc
+	cacheMisses     *prometheus.Desc
+	eventsProcessed *prometheus.Desc
+	eventsDropped   *prometheus.Desc
+	bufferOccupancy *prometheus.Desc
+}
+
+func newCollector(w *argus.Watcher) *collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, nil, nil)
+	}
+	return &collector{
+		watcher:         w,
+		watchedFiles:    desc("watched_files", "Number of files being watched."),
+		pollCycles:      desc("poll_cycles_total", "Poll cycles run by the poll loop."),
+		pollDuration:    desc("poll_duration_seconds", "Duration of the last poll cycle."),
+		cacheHits:       desc("cache_hits_total", "Stat lookups served by the stat cache."),
+		cacheMisses:     desc("cache_misses_total", "Stat lookups that called os.Stat."),
+		eventsProcessed: desc("events_processed_total", "Change events taken from the ring buffer for delivery."),
+		eventsDropped:   desc("events_dropped_total", "Change events dropped because the ring buffer was full."),
+		bufferOccupancy: desc("buffer_occupancy", "Change events waiting in the ring buffer."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.watchedFiles
+	ch <- c.pollCycles
+	ch <- c.pollDuration
+	ch <- c.cacheHits
+	ch <- c.cacheMisses
+	ch <- c.eventsProcessed
+	ch <- c.eventsDropped
+	ch <- c.bufferOccupancy
+}
+
+// Collect implements prometheus.Collector
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.watcher.Stats()
+
+	ch <- prometheus.MustNewConstMetric(c.watchedFiles, prometheus.GaugeValue, float64(stats.FilesWatched))
+	ch <- prometheus.MustNewConstMetric(c.pollCycles, prometheus.CounterValue, float64(stats.PollCycles))
+	ch <- prometheus.MustNewConstMetric(c.pollDuration, prometheus.GaugeValue, stats.LastPollDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.cacheHits, prometheus.CounterValue, float64(stats.CacheHits))
+	ch <- prometheus.MustNewConstMetric(c.cacheMisses, prometheus.CounterValue, float64(stats.CacheMisses))
+	ch <- prometheus.MustNewConstMetric(c.eventsProcessed, prometheus.CounterValue, float64(stats.TotalProcessed))
+	ch <- prometheus.MustNewConstMetric(c.eventsDropped, prometheus.CounterValue, float64(stats.DroppedEvents))
+	ch <- prometheus.MustNewConstMetric(c.bufferOccupancy, prometheus.GaugeValue, float64(stats.BufferOccupancy))
+}
@@ -0,0 +1,159 @@
+// argusprom_test.go: Tests for the Prometheus metrics exporter
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argusprom
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agilira/argus"
+	"github.com/agilira/go-errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestRegisterPrometheusMetrics(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := argus.New(argus.Config{PollInterval: 5 * time.Millisecond, DisableAudit: true})
+	defer func() { _ = watcher.Close() }()
+
+	changed := make(chan struct{}, 4)
+	if err := watcher.Watch(configPath, func(argus.ChangeEvent) { changed <- struct{}{} }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	reg := prometheus.NewRegistry()
+	if err := RegisterPrometheusMetrics(reg, watcher); err != nil {
+		t.Fatalf("Failed to register metrics: %v", err)
+	}
+
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := os.WriteFile(configPath, []byte(`{"v": 2, "changed": true}`), 0600); err != nil {
+		t.Fatalf("Failed to update config file: %v", err)
+	}
+	select {
+	case <-changed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected a change callback")
+	}
+
+	// The histogram is observed right after the callback returns
+	var families map[string]*dto.MetricFamily
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		families = gather(t, reg)
+		if h := families["argus_callback_duration_seconds"]; h != nil && h.Metric[0].Histogram.GetSampleCount() > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the callback latency histogram to be observed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if got := value(families, "argus_watched_files"); got != 1 {
+		t.Errorf("Expected argus_watched_files 1, got %v", got)
+	}
+	if got := value(families, "argus_poll_cycles_total"); got < 1 {
+		t.Errorf("Expected argus_poll_cycles_total >= 1, got %v", got)
+	}
+	if got := value(families, "argus_events_processed_total"); got < 1 {
+		t.Errorf("Expected argus_events_processed_total >= 1, got %v", got)
+	}
+	if got := value(families, "argus_cache_hits_total") + value(families, "argus_cache_misses_total"); got < 1 {
+		t.Errorf("Expected stat cache lookups to be counted, got %v", got)
+	}
+	for _, name := range []string{"argus_poll_duration_seconds", "argus_events_dropped_total", "argus_buffer_occupancy"} {
+		if families[name] == nil {
+			t.Errorf("Expected metric %s to be exported", name)
+		}
+	}
+}
+
+func TestRegisterPrometheusMetrics_Errors(t *testing.T) {
+	watcher := argus.New(argus.Config{DisableAudit: true})
+	defer func() { _ = watcher.Close() }()
+
+	if err := RegisterPrometheusMetrics(nil, watcher); !errors.HasCode(err, argus.ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a nil registerer, got %v", err)
+	}
+	if err := RegisterPrometheusMetrics(prometheus.NewRegistry(), nil); !errors.HasCode(err, argus.ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a nil watcher, got %v", err)
+	}
+
+	reg := prometheus.NewRegistry()
+	if err := RegisterPrometheusMetrics(reg, watcher); err != nil {
+		t.Fatalf("Failed to register metrics: %v", err)
+	}
+	if err := RegisterPrometheusMetrics(reg, watcher); !errors.HasCode(err, argus.ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a duplicate registration, got %v", err)
+	}
+
+	// A failed histogram registration must not leave the collector behind
+	reg = prometheus.NewRegistry()
+	taken := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "argus_callback_duration_seconds", Help: "Taken."})
+	reg.MustRegister(taken)
+	if err := RegisterPrometheusMetrics(reg, watcher); !errors.HasCode(err, argus.ErrCodeInvalidConfig) {
+		t.Fatalf("Expected ErrCodeInvalidConfig for a taken histogram name, got %v", err)
+	}
+	if _, registered := gather(t, reg)["argus_watched_files"]; registered {
+		t.Error("Expected the watcher metrics to be unregistered after the failure")
+	}
+}
+
+func TestRegisterPrometheusMetrics_SeveralWatchers(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	for _, name := range []string{"app", "flags"} {
+		watcher := argus.New(argus.Config{DisableAudit: true})
+		defer func() { _ = watcher.Close() }()
+
+		labelled := prometheus.WrapRegistererWith(prometheus.Labels{"watcher": name}, reg)
+		if err := RegisterPrometheusMetrics(labelled, watcher); err != nil {
+			t.Fatalf("Failed to register metrics of watcher %s: %v", name, err)
+		}
+	}
+
+	if samples := len(gather(t, reg)["argus_watched_files"].Metric); samples != 2 {
+		t.Errorf("Expected one argus_watched_files sample per watcher, got %d", samples)
+	}
+}
+
+// gather collects the metric families of reg by name
+func gather(t *testing.T, reg *prometheus.Registry) map[string]*dto.MetricFamily {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Failed to gather metrics: %v", err)
+	}
+	byName := make(map[string]*dto.MetricFamily, len(families))
+	for _, family := range families {
+		byName[family.GetName()] = family
+	}
+	return byName
+}
+
+// value returns the value of the first sample of a counter or gauge family
+func value(families map[string]*dto.MetricFamily, name string) float64 {
+	family := families[name]
+	if family == nil || len(family.Metric) == 0 {
+		return -1
+	}
+	metric := family.Metric[0]
+	if metric.Counter != nil {
+		return metric.Counter.GetValue()
+	}
+	return metric.Gauge.GetValue()
+}
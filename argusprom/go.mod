@@ -0,0 +1,29 @@
+module github.com/agilira/argus/argusprom
+
+go 1.25.9
+
+// argus library from the parent module — replace for local development;
+// when published the replace directive is dropped and the tagged version is used.
+require github.com/agilira/argus v1.4.2
+
+require (
+	github.com/agilira/go-errors v1.1.2
+	github.com/prometheus/client_golang v1.24.1
+	github.com/prometheus/client_model v0.6.2
+)
+
+require (
+	github.com/agilira/flash-flags v1.1.8 // indirect
+	github.com/agilira/go-timecache v1.0.3 // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/mattn/go-sqlite3 v1.14.48 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/prometheus/common v0.70.1 // indirect
+	github.com/prometheus/procfs v0.21.1 // indirect
+	go.yaml.in/yaml/v3 v3.0.4 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
+
+replace github.com/agilira/argus => ../
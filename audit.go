@@ -18,8 +18,10 @@ package argus
 import (
 	"crypto/sha256"
 	"fmt"
+	"maps"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/agilira/go-timecache"
@@ -73,7 +75,54 @@ type AuditConfig struct {
 	MinLevel      AuditLevel    `json:"min_level"`
 	BufferSize    int           `json:"buffer_size"`
 	FlushInterval time.Duration `json:"flush_interval"`
-	IncludeStack  bool          `json:"include_stack"`
+
+	// IncludeStack records the call site's stack trace, trimmed of audit
+	// logger frames, in each event's context under "stack"
+	IncludeStack bool `json:"include_stack"`
+
+	// StackMinLevel is the lowest level whose events get a stack trace when
+	// IncludeStack is set; e.g. AuditWarn skips high-volume info events.
+	// Default: AuditInfo (every event)
+	StackMinLevel AuditLevel `json:"stack_min_level"`
+
+	// Compress writes the JSONL audit file gzip-compressed, to OutputFile
+	// with a ".gz" suffix appended (an OutputFile already ending in
+	// ".jsonl.gz" is always compressed). Compressed blocks are flushed on
+	// the FlushInterval cadence. The SQLite backend ignores Compress so it
+	// stays queryable. Read compressed files with ReadAuditLog.
+	Compress bool `json:"compress"`
+
+	// MaxFileSize rotates the JSONL audit file once it reaches this many
+	// bytes (compressed bytes with Compress): the file becomes OutputFile.1,
+	// older backups shift to .2, .3, ... and a new file is started. Checked
+	// before each batch is written, so a file may exceed the limit by one
+	// batch but a batch never spans two files. 0 disables rotation. The
+	// SQLite backend ignores MaxFileSize.
+	MaxFileSize int64 `json:"max_file_size"`
+
+	// MaxBackups is the number of rotated files kept; older ones are
+	// deleted. With 0, the full file is deleted on rotation.
+	MaxBackups int `json:"max_backups"`
+
+	// ComponentLevels overrides MinLevel for events of the named components,
+	// e.g. {"poller": AuditWarn} keeps only warnings and above from a noisy
+	// subsystem while other components still record at MinLevel.
+	// Default: none
+	ComponentLevels map[string]AuditLevel `json:"component_levels,omitempty"`
+
+	// SQLiteBatchSize commits SQLite audit events asynchronously: once this
+	// many events are buffered they are handed to a background committer and
+	// written in a single transaction, so Log only waits on the database
+	// when the committer falls several batches behind.
+	// Events left over are committed on FlushInterval, Flush and Close.
+	// BufferSize is ignored while batching. 0 commits synchronously. The
+	// JSONL backend ignores SQLiteBatchSize.
+	SQLiteBatchSize int `json:"sqlite_batch_size"`
+
+	// Sinks receive every recorded event in addition to the built-in
+	// SQLite or JSONL storage, e.g. stdout, syslog or an HTTP collector.
+	// See AuditSink. Default: none
+	Sinks []AuditSink `json:"-"`
 }
 
 // DefaultAuditConfig returns secure default audit configuration with unified SQLite storage.
@@ -116,6 +165,15 @@ type AuditLogger struct {
 	stopCh      chan struct{}
 	processID   int
 	processName string
+
+	// Pause window state: non-security events are suppressed while paused
+	pause    auditPause
+	pauseMu  sync.Mutex
+	isPaused atomic.Bool
+
+	// Asynchronous SQLite commits (SQLiteBatchSize): nil when synchronous
+	batches   chan auditBatch
+	batchDone chan struct{}
 }
 
 // NewAuditLogger creates a new audit logger with automatic backend selection.
@@ -141,6 +199,9 @@ func NewAuditLogger(config AuditConfig) (*AuditLogger, error) {
 		return nil, fmt.Errorf("failed to initialize audit backend: %w", err)
 	}
 
+	// Log reads the map concurrently: later writes by the caller must not race
+	config.ComponentLevels = maps.Clone(config.ComponentLevels)
+
 	logger := &AuditLogger{
 		config:      config,
 		backend:     backend,
@@ -150,6 +211,10 @@ func NewAuditLogger(config AuditConfig) (*AuditLogger, error) {
 		processName: getProcessName(),
 	}
 
+	if _, ok := backend.(*sqliteAuditBackend); ok && config.SQLiteBatchSize > 0 {
+		logger.startBatchWriter()
+	}
+
 	// Start background flusher
 	if config.FlushInterval > 0 {
 		logger.flushTicker = time.NewTicker(config.FlushInterval)
@@ -181,13 +246,23 @@ func newDisabledAuditLogger() *AuditLogger {
 
 // Log records an audit event with ultra-high performance
 func (al *AuditLogger) Log(level AuditLevel, event, component, filePath string, oldVal, newVal interface{}, context map[string]interface{}) {
-	if al == nil || al.backend == nil || !al.config.Enabled || level < al.config.MinLevel {
+	if al == nil || al.backend == nil || !al.config.Enabled || level < al.minLevel(component) {
+		return
+	}
+
+	// Known noisy operation in progress: only security events get through
+	if level < AuditSecurity && al.isPaused.Load() {
+		al.pause.suppressed.Add(1)
 		return
 	}
 
 	// Use cached timestamp for performance (121x faster than time.Now())
 	timestamp := timecache.CachedTime()
 
+	if al.wantsStack(level) {
+		context = withStack(context)
+	}
+
 	auditEvent := AuditEvent{
 		Timestamp:   timestamp,
 		Level:       level,
@@ -207,12 +282,24 @@ func (al *AuditLogger) Log(level AuditLevel, event, component, filePath string,
 	// Buffer the event
 	al.bufferMu.Lock()
 	al.buffer = append(al.buffer, auditEvent)
-	if len(al.buffer) >= al.config.BufferSize {
+	if al.batches != nil {
+		if len(al.buffer) >= al.config.SQLiteBatchSize {
+			al.enqueueBatchUnsafe(nil)
+		}
+	} else if len(al.buffer) >= al.config.BufferSize {
 		_ = al.flushBufferUnsafe() // Ignore flush errors during buffering to maintain performance
 	}
 	al.bufferMu.Unlock()
 }
 
+// minLevel returns the lowest level recorded for component
+func (al *AuditLogger) minLevel(component string) AuditLevel {
+	if level, ok := al.config.ComponentLevels[component]; ok {
+		return level
+	}
+	return al.config.MinLevel
+}
+
 // LogConfigChange logs configuration file changes (most common use case)
 func (al *AuditLogger) LogConfigChange(filePath string, oldConfig, newConfig map[string]interface{}) {
 	al.Log(AuditCritical, "config_change", "argus", filePath, oldConfig, newConfig, nil)
@@ -228,22 +315,34 @@ func (al *AuditLogger) LogSecurityEvent(event, details string, context map[strin
 	al.Log(AuditSecurity, event, "argus", "", nil, nil, context)
 }
 
-// Flush immediately writes all buffered events
+// Flush immediately writes all buffered events. With SQLiteBatchSize it also
+// waits for batches already handed to the background committer.
 func (al *AuditLogger) Flush() error {
 	al.bufferMu.Lock()
+	if al.batches != nil {
+		done := make(chan error, 1)
+		al.enqueueBatchUnsafe(done)
+		al.bufferMu.Unlock()
+		return <-done
+	}
 	defer al.bufferMu.Unlock()
 	return al.flushBufferUnsafe()
 }
 
 // Close gracefully shuts down the audit logger
 func (al *AuditLogger) Close() error {
+	// Record the pause window if the logger is closed while paused
+	al.Resume()
+
 	close(al.stopCh)
 	if al.flushTicker != nil {
 		al.flushTicker.Stop()
 	}
 
 	// Final flush to ensure all events are persisted
-	if err := al.Flush(); err != nil {
+	err := al.Flush()
+	al.stopBatchWriter()
+	if err != nil {
 		return fmt.Errorf("failed to flush audit logger during close: %w", err)
 	}
 
@@ -280,17 +379,26 @@ func (al *AuditLogger) flushBufferUnsafe() error {
 	}
 
 	// Write batch to backend
-	if err := al.backend.Write(al.buffer); err != nil {
+	if err := al.backend.WriteBatch(al.buffer); err != nil {
 		return fmt.Errorf("failed to write audit events to backend: %w", err)
 	}
 
+	// Sinks are best effort: the batch is stored, so it is not retried
+	sinkErr := al.writeSinks(al.buffer)
+
 	// Clear buffer after successful write
 	al.buffer = al.buffer[:0]
-	return nil
+	return sinkErr
 }
 
 // generateChecksum creates a tamper-detection checksum using SHA-256
 func (al *AuditLogger) generateChecksum(event AuditEvent) string {
+	return auditChecksum(event)
+}
+
+// auditChecksum computes the tamper-detection checksum of event. It depends
+// on the event alone, so stored trails can be verified without a logger.
+func auditChecksum(event AuditEvent) string {
 	// Cryptographic hash for tamper detection
 	// UTC-normalize so checksum is timezone-independent. Pairs with the
 	// matching .UTC() at the SQL write site (audit_backend.go) so the
@@ -17,11 +17,14 @@
 package argus
 
 import (
+	"compress/gzip"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,18 +55,21 @@ import (
 // SQLite → JSONL → Error. This ensures audit logging NEVER prevents app
 // startup, while still capturing data via the fallback mechanism.
 //
-// The interface is minimal by design: Write, Flush, Close, Maintenance.
+// The interface is minimal by design: Write, WriteBatch, Flush, Close,
+// Maintenance. Write and Flush are the public AuditSink contract, so the
+// built-in backends are sinks like any user-supplied one; WriteBatch lets
+// them persist a whole buffer in one transaction or write.
 // Backends can implement complex logic internally while keeping the contract
 // simple. This follows the Interface Segregation Principle.
 // ═══════════════════════════════════════════════════════════════════════════════
 type auditBackend interface {
-	// Write persists a batch of audit events to the backend.
-	// Implementations must handle concurrent writes safely.
-	Write(events []AuditEvent) error
+	// AuditSink writes single events; Flush ensures all pending writes are
+	// committed to storage.
+	AuditSink
 
-	// Flush ensures all pending writes are committed to storage.
-	// This is called during graceful shutdown and periodic flushes.
-	Flush() error
+	// WriteBatch persists a batch of audit events to the backend.
+	// Implementations must handle concurrent writes safely.
+	WriteBatch(events []AuditEvent) error
 
 	// Close releases all resources and performs final cleanup.
 	// After calling Close, the backend must not be used again.
@@ -90,8 +96,8 @@ type auditBackend interface {
 // This ensures maximum compatibility while providing unified audit trails
 // when possible.
 func createAuditBackend(config AuditConfig) (auditBackend, error) {
-	// Check if user explicitly requested JSONL format via .jsonl extension
-	if config.OutputFile != "" && filepath.Ext(config.OutputFile) == ".jsonl" {
+	// Check if user explicitly requested JSONL format via .jsonl (or .jsonl.gz) extension
+	if isJSONLAuditPath(config.OutputFile) {
 		return newJSONLBackend(config)
 	}
 
@@ -675,11 +681,16 @@ func (s *sqliteAuditBackend) getSchemaVersion(stats *AuditDatabaseStats) error {
 	return nil
 }
 
-// Write persists a batch of audit events to the SQLite database.
+// Write persists a single audit event, implementing AuditSink
+func (s *sqliteAuditBackend) Write(event AuditEvent) error {
+	return s.WriteBatch([]AuditEvent{event})
+}
+
+// WriteBatch persists a batch of audit events to the SQLite database.
 //
 // This method handles concurrent access safely and performs batch inserts
 // within a transaction for optimal performance and consistency.
-func (s *sqliteAuditBackend) Write(events []AuditEvent) error {
+func (s *sqliteAuditBackend) WriteBatch(events []AuditEvent) error {
 	s.mu.RLock()
 	if s.closed {
 		s.mu.RUnlock()
@@ -882,9 +893,35 @@ func (s *sqliteAuditBackend) Close() error {
 // existing file-based audit functionality.
 type jsonlAuditBackend struct {
 	file       *os.File
+	out        io.Writer    // file, or gz when compressing
+	gz         *gzip.Writer // Non-nil when AuditConfig.Compress is set
 	sourceFile string
+	compress   bool
 	mu         sync.Mutex
 	closed     bool
+
+	// Rotation, see audit_rotation.go
+	size       int64 // Bytes in the current file
+	maxSize    int64
+	maxBackups int
+}
+
+// isJSONLAuditPath reports whether path selects the JSONL backend
+func isJSONLAuditPath(path string) bool {
+	return strings.HasSuffix(path, ".jsonl") || strings.HasSuffix(path, ".jsonl.gz")
+}
+
+// jsonlAuditPath returns the file written by the JSONL backend and whether
+// it is gzip-compressed. Compress turns "audit.jsonl" into "audit.jsonl.gz";
+// a ".gz" path is always compressed.
+func jsonlAuditPath(config AuditConfig) (string, bool) {
+	if strings.HasSuffix(config.OutputFile, ".gz") {
+		return config.OutputFile, true
+	}
+	if config.Compress {
+		return config.OutputFile + ".gz", true
+	}
+	return config.OutputFile, false
 }
 
 // newJSONLBackend creates a new JSONL audit backend for backward compatibility.
@@ -903,28 +940,59 @@ func newJSONLBackend(config AuditConfig) (*jsonlAuditBackend, error) {
 		return nil, fmt.Errorf("JSONL backend requires OutputFile to be specified")
 	}
 
+	path, compress := jsonlAuditPath(config)
+
 	// Ensure directory exists
-	if err := os.MkdirAll(filepath.Dir(config.OutputFile), 0750); err != nil {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
 		return nil, fmt.Errorf("failed to create JSONL audit log directory: %w", err)
 	}
 
+	backend := &jsonlAuditBackend{
+		sourceFile: path,
+		compress:   compress,
+		maxSize:    config.MaxFileSize,
+		maxBackups: config.MaxBackups,
+	}
+	if err := backend.open(); err != nil {
+		return nil, err
+	}
+	return backend, nil
+}
+
+// open opens the audit file for appending and sets up its writers
+func (j *jsonlAuditBackend) open() error {
 	// Open audit file with secure permissions (owner read/write only)
-	file, err := os.OpenFile(config.OutputFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	file, err := os.OpenFile(j.sourceFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open JSONL audit log file: %w", err)
+		return fmt.Errorf("failed to open JSONL audit log file: %w", err)
+	}
+	j.size = 0
+	if info, err := file.Stat(); err == nil {
+		j.size = info.Size()
 	}
 
-	return &jsonlAuditBackend{
-		file:       file,
-		sourceFile: config.OutputFile,
-	}, nil
+	j.file = file
+	j.out = &countingWriter{w: file, n: &j.size}
+	j.gz = nil
+	if j.compress {
+		// Appending starts a new gzip member; readers decode the members
+		// as one continuous stream
+		j.gz = gzip.NewWriter(j.out)
+		j.out = j.gz
+	}
+	return nil
 }
 
-// Write persists a batch of audit events to the JSONL file.
+// Write persists a single audit event, implementing AuditSink
+func (j *jsonlAuditBackend) Write(event AuditEvent) error {
+	return j.WriteBatch([]AuditEvent{event})
+}
+
+// WriteBatch persists a batch of audit events to the JSONL file.
 //
 // Each event is serialized as a JSON object on a single line,
 // following the JSONL format specification.
-func (j *jsonlAuditBackend) Write(events []AuditEvent) error {
+func (j *jsonlAuditBackend) WriteBatch(events []AuditEvent) error {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
@@ -936,21 +1004,36 @@ func (j *jsonlAuditBackend) Write(events []AuditEvent) error {
 		return nil
 	}
 
+	// Rotate between batches: a batch is never split across files
+	if j.maxSize > 0 && j.size >= j.maxSize {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+
 	for _, event := range events {
 		data, err := json.Marshal(event)
 		if err != nil {
 			return fmt.Errorf("failed to serialize audit event: %w", err)
 		}
 
-		if _, err := j.file.Write(data); err != nil {
+		if _, err := j.out.Write(data); err != nil {
 			return fmt.Errorf("failed to write audit event to JSONL: %w", err)
 		}
 
-		if _, err := j.file.Write([]byte("\n")); err != nil {
+		if _, err := j.out.Write([]byte("\n")); err != nil {
 			return fmt.Errorf("failed to write audit event newline: %w", err)
 		}
 	}
 
+	// Batches arrive on the flush cadence: emit a complete compressed block
+	// so the events are readable without waiting for Close
+	if j.gz != nil {
+		if err := j.gz.Flush(); err != nil {
+			return fmt.Errorf("failed to flush compressed audit events: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -965,6 +1048,12 @@ func (j *jsonlAuditBackend) Flush() error {
 		return nil // No-op for closed backend
 	}
 
+	if j.gz != nil {
+		if err := j.gz.Flush(); err != nil {
+			return fmt.Errorf("failed to flush compressed audit events: %w", err)
+		}
+	}
+
 	if err := j.file.Sync(); err != nil {
 		return fmt.Errorf("failed to sync JSONL audit file: %w", err)
 	}
@@ -973,13 +1062,10 @@ func (j *jsonlAuditBackend) Flush() error {
 }
 
 // Maintenance performs file-based maintenance operations for JSONL backend.
-// For JSONL files, this could include log rotation, compression, or archiving.
-// Currently returns nil as JSONL files are self-maintaining.
+// Size-based rotation happens on write (AuditConfig.MaxFileSize), so there
+// is nothing left to do here.
 func (j *jsonlAuditBackend) Maintenance() error {
-	// JSONL files are inherently self-maintaining
 	// Future enhancements could include:
-	// - Log rotation based on size/age
-	// - Compression of old files
 	// - Archiving to remote storage
 	return nil
 }
@@ -1021,8 +1107,13 @@ func (j *jsonlAuditBackend) Close() error {
 	}
 
 	var err error
+	if j.gz != nil {
+		err = j.gz.Close() // Writes the gzip trailer
+	}
 	if j.file != nil {
-		err = j.file.Close()
+		if closeErr := j.file.Close(); err == nil {
+			err = closeErr
+		}
 	}
 
 	j.closed = true
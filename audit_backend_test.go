@@ -140,7 +140,7 @@ func TestBackendInterface_SQLite(t *testing.T) {
 	// Test interface methods exist and work
 	events := []AuditEvent{createTestAuditEvent("test-component", "test-event")}
 
-	if err := backend.Write(events); err != nil {
+	if err := backend.WriteBatch(events); err != nil {
 		t.Errorf("Write failed: %v", err)
 	}
 
@@ -176,7 +176,7 @@ func TestBackendInterface_JSONL(t *testing.T) {
 	// Test interface methods exist and work
 	events := []AuditEvent{createTestAuditEvent("test-component", "test-event")}
 
-	if err := backend.Write(events); err != nil {
+	if err := backend.WriteBatch(events); err != nil {
 		t.Errorf("Write failed: %v", err)
 	}
 
@@ -275,7 +275,7 @@ func TestSQLiteBackend_WriteAndVerify(t *testing.T) {
 		createTestAuditEvent("app2", "file_watch"),
 	}
 
-	if err := backend.Write(events); err != nil {
+	if err := backend.WriteBatch(events); err != nil {
 		t.Fatalf("Failed to write events: %v", err)
 	}
 
@@ -402,7 +402,7 @@ func TestSQLiteBackend_ConcurrentWrites_Basic(t *testing.T) {
 			}
 
 			// Write events
-			if err := backend.Write(events); err != nil {
+			if err := backend.WriteBatch(events); err != nil {
 				done <- fmt.Errorf("worker %d write failed: %w", workerID, err)
 				return
 			}
@@ -461,7 +461,7 @@ func TestSQLiteBackend_ConcurrentWriteAndMaintenance(t *testing.T) {
 				return
 			default:
 				event := createTestAuditEvent("maintenance-writer", fmt.Sprintf("event-%d", eventCounter))
-				if err := backend.Write([]AuditEvent{event}); err != nil {
+				if err := backend.WriteBatch([]AuditEvent{event}); err != nil {
 					errorChan <- fmt.Errorf("write error: %w", err)
 					return
 				}
@@ -535,7 +535,7 @@ func TestSQLiteBackend_ConcurrentWriteAndMaintenance(t *testing.T) {
 
 	// Final verification - ensure backend is still functional
 	finalEvent := createTestAuditEvent("final-test", "post-concurrent")
-	if err := backend.Write([]AuditEvent{finalEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{finalEvent}); err != nil {
 		t.Errorf("Backend not functional after concurrent test: %v", err)
 	}
 }
@@ -556,7 +556,7 @@ func TestSQLiteBackend_ErrorRecovery_Security(t *testing.T) {
 		createTestAuditEvent("security-test", "valid-event-2"),
 	}
 
-	if err := backend.Write(validEvents); err != nil {
+	if err := backend.WriteBatch(validEvents); err != nil {
 		t.Fatalf("Failed to write valid events: %v", err)
 	}
 
@@ -575,13 +575,13 @@ func TestSQLiteBackend_ErrorRecovery_Security(t *testing.T) {
 	}
 
 	// This should handle large data gracefully
-	if err := backend.Write([]AuditEvent{largeEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{largeEvent}); err != nil {
 		t.Logf("Large data write failed as expected: %v", err)
 	}
 
 	// Test 3: Verify backend is still functional after potential error
 	recoveryEvent := createTestAuditEvent("security-test", "recovery-test")
-	if err := backend.Write([]AuditEvent{recoveryEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{recoveryEvent}); err != nil {
 		t.Errorf("Backend not functional after error scenario: %v", err)
 	}
 
@@ -629,14 +629,14 @@ func TestSQLiteBackend_SafeShutdown_Concurrency(t *testing.T) {
 			case <-shutdownChan:
 				// Try to write after shutdown signal (should handle gracefully)
 				event := createTestAuditEvent("shutdown-test", fmt.Sprintf("post-shutdown-%d", eventCounter))
-				if err := backend.Write([]AuditEvent{event}); err != nil {
+				if err := backend.WriteBatch([]AuditEvent{event}); err != nil {
 					// This is expected after Close() is called
 					t.Logf("Expected write error after shutdown: %v", err)
 				}
 				return
 			default:
 				event := createTestAuditEvent("shutdown-test", fmt.Sprintf("pre-shutdown-%d", eventCounter))
-				if err := backend.Write([]AuditEvent{event}); err != nil {
+				if err := backend.WriteBatch([]AuditEvent{event}); err != nil {
 					errorChan <- fmt.Errorf("pre-shutdown write error: %w", err)
 					return
 				}
@@ -794,7 +794,7 @@ func TestSQLiteBackend_SchemaMigration_Security(t *testing.T) {
 
 	// Step 5: Test that the migrated database is functional
 	testEvent := createTestAuditEvent("migration-test", "post-migration-test")
-	if err := backend.Write([]AuditEvent{testEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{testEvent}); err != nil {
 		t.Errorf("Failed to write to migrated database: %v", err)
 	}
 
@@ -828,7 +828,7 @@ func TestSQLiteBackend_ErrorHandling_EdgeCases(t *testing.T) {
 		}
 	}()
 
-	if err := backend.Write([]AuditEvent{}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{}); err != nil {
 		t.Errorf("Empty events array should not cause error: %v", err)
 	}
 
@@ -841,7 +841,7 @@ func TestSQLiteBackend_ErrorHandling_EdgeCases(t *testing.T) {
 		Context:   nil, // Explicit nil
 	}
 
-	if err := backend.Write([]AuditEvent{nilContextEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{nilContextEvent}); err != nil {
 		t.Errorf("Event with nil context should be handled: %v", err)
 	}
 
@@ -892,7 +892,7 @@ func TestSQLiteBackend_DatabaseStats_Comprehensive(t *testing.T) {
 		},
 	}
 
-	if err := backend.Write(testEvents); err != nil {
+	if err := backend.WriteBatch(testEvents); err != nil {
 		t.Fatalf("Failed to write test events: %v", err)
 	}
 
@@ -1044,7 +1044,7 @@ func TestSQLiteBackend_WriteErrors_EdgeCases(t *testing.T) {
 		Context:   largeContext,
 	}
 
-	if err := backend.Write([]AuditEvent{largeEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{largeEvent}); err != nil {
 		t.Errorf("Failed to write large event: %v", err)
 	}
 
@@ -1056,7 +1056,7 @@ func TestSQLiteBackend_WriteErrors_EdgeCases(t *testing.T) {
 		Component: "edge-test",
 	}
 
-	if err := backend.Write([]AuditEvent{zeroTimeEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{zeroTimeEvent}); err != nil {
 		t.Errorf("Failed to write zero-time event: %v", err)
 	}
 
@@ -1072,7 +1072,7 @@ func TestSQLiteBackend_WriteErrors_EdgeCases(t *testing.T) {
 
 	// Try to write - this should handle the error gracefully
 	corruptionEvent := createTestAuditEvent("corruption-test", "after-file-removed")
-	err := backend.Write([]AuditEvent{corruptionEvent})
+	err := backend.WriteBatch([]AuditEvent{corruptionEvent})
 	t.Logf("Write after file removal result: %v", err)
 	// We expect this to fail, but it should not crash
 
@@ -1149,7 +1149,7 @@ func TestJSONLBackend_ErrorPaths(t *testing.T) {
 		},
 	}
 
-	if err := backend.Write([]AuditEvent{complexEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{complexEvent}); err != nil {
 		t.Errorf("Failed to write complex event to JSONL: %v", err)
 	}
 
@@ -1180,7 +1180,7 @@ func TestJSONLBackend_ErrorPaths(t *testing.T) {
 	}
 
 	postRemovalEvent := createTestAuditEvent("post-removal", "test-event")
-	err = backend.Write([]AuditEvent{postRemovalEvent})
+	err = backend.WriteBatch([]AuditEvent{postRemovalEvent})
 	t.Logf("JSONL write after file removal: %v", err)
 }
 
@@ -1438,7 +1438,7 @@ func TestSQLiteBackend_MigrationEdgeCases(t *testing.T) {
 
 	// Test writing to the migrated database
 	testEvent := createTestAuditEvent("migration-test", "post-v1-migration")
-	if err := backend.Write([]AuditEvent{testEvent}); err != nil {
+	if err := backend.WriteBatch([]AuditEvent{testEvent}); err != nil {
 		t.Errorf("Failed to write to migrated v1 database: %v", err)
 	}
 
@@ -1477,7 +1477,7 @@ func TestSQLiteBackend_WriteBuffering_EdgeCases(t *testing.T) {
 
 	// Each write should trigger flush due to buffer size = 1
 	for _, event := range events {
-		if err := backend.Write([]AuditEvent{event}); err != nil {
+		if err := backend.WriteBatch([]AuditEvent{event}); err != nil {
 			t.Errorf("Failed to write buffered event: %v", err)
 		}
 	}
@@ -1499,7 +1499,7 @@ func TestSQLiteBackend_WriteBuffering_EdgeCases(t *testing.T) {
 		go func(id int) {
 			defer wg.Done()
 			event := createTestAuditEvent("concurrent-buffer", fmt.Sprintf("event-%d", id))
-			if err := backend.Write([]AuditEvent{event}); err != nil {
+			if err := backend.WriteBatch([]AuditEvent{event}); err != nil {
 				errorCh <- err
 			}
 		}(i)
@@ -1593,7 +1593,7 @@ func TestSQLiteBackend_StatsPrecision_Comprehensive(t *testing.T) {
 		},
 	}
 
-	if err := backend.Write(events); err != nil {
+	if err := backend.WriteBatch(events); err != nil {
 		t.Fatalf("Failed to write test events: %v", err)
 	}
 
@@ -1695,7 +1695,7 @@ func TestJSONLBackend_Comprehensive(t *testing.T) {
 		},
 	}
 
-	if err := backend.Write(testEvents); err != nil {
+	if err := backend.WriteBatch(testEvents); err != nil {
 		t.Fatalf("Failed to write events to JSONL backend: %v", err)
 	}
 
@@ -1859,7 +1859,7 @@ func TestSQLiteBackend_FinalStressTest(t *testing.T) {
 	}
 
 	// Write in batch
-	if err := backend.Write(stressEvents); err != nil {
+	if err := backend.WriteBatch(stressEvents); err != nil {
 		t.Errorf("Failed stress test batch write: %v", err)
 	}
 
@@ -1927,7 +1927,7 @@ func TestSQLiteBackend_CloseAutomaticallyFlushes(t *testing.T) {
 		},
 	}
 
-	err = backend.Write(testEvents)
+	err = backend.WriteBatch(testEvents)
 	if err != nil {
 		t.Fatalf("Failed to write events: %v", err)
 	}
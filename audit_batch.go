@@ -0,0 +1,102 @@
+// audit_batch.go: Asynchronous batched commits for the SQLite audit backend
+//
+// With the default buffering, the Log call that fills the buffer pays for the
+// SQLite transaction that persists it. AuditConfig.SQLiteBatchSize moves that
+// cost off the caller: full batches are handed to a single committer goroutine
+// that writes each one in its own transaction, while Log keeps buffering.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"fmt"
+)
+
+// auditBatchQueue is the number of full batches that may wait for the
+// committer before Log blocks, bounding memory when SQLite falls behind
+const auditBatchQueue = 4
+
+// auditBatch is a unit of work for the committer. A non-nil done turns it
+// into a barrier: the committer reports the outcome once everything queued
+// before it, and the batch itself, has been written.
+type auditBatch struct {
+	events []AuditEvent
+	done   chan error
+}
+
+// startBatchWriter enables asynchronous batched commits
+func (al *AuditLogger) startBatchWriter() {
+	al.batches = make(chan auditBatch, auditBatchQueue)
+	al.batchDone = make(chan struct{})
+	go al.batchWriter()
+}
+
+// enqueueBatchUnsafe hands the buffered events to the committer (caller must
+// hold bufferMu). Blocks while auditBatchQueue batches are already waiting.
+func (al *AuditLogger) enqueueBatchUnsafe(done chan error) {
+	var events []AuditEvent
+	if len(al.buffer) > 0 {
+		events = al.buffer
+		al.buffer = make([]AuditEvent, 0, al.config.SQLiteBatchSize)
+	}
+	al.batches <- auditBatch{events: events, done: done}
+}
+
+// stopBatchWriter stops the committer after it has drained the queue. Later
+// Log calls fall back to synchronous buffering.
+func (al *AuditLogger) stopBatchWriter() {
+	al.bufferMu.Lock()
+	batches := al.batches
+	al.batches = nil
+	al.bufferMu.Unlock()
+
+	if batches != nil {
+		close(batches)
+		<-al.batchDone
+	}
+}
+
+// batchWriter commits queued batches in order. Events whose transaction
+// failed are kept and retried ahead of the next batch, so a transient
+// database error delays events rather than losing them.
+func (al *AuditLogger) batchWriter() {
+	defer close(al.batchDone)
+
+	var unwritten []AuditEvent
+	for batch := range al.batches {
+		if len(unwritten) == 0 {
+			unwritten = batch.events
+		} else {
+			unwritten = append(unwritten, batch.events...)
+		}
+
+		var err error
+		unwritten, err = al.commitBatches(unwritten)
+		if batch.done != nil {
+			batch.done <- err
+		}
+	}
+}
+
+// commitBatches writes events in transactions of at most SQLiteBatchSize
+// events and returns the ones not yet stored
+func (al *AuditLogger) commitBatches(events []AuditEvent) ([]AuditEvent, error) {
+	var sinkErrs []error
+	for len(events) > 0 {
+		n := min(al.config.SQLiteBatchSize, len(events))
+		if err := al.backend.WriteBatch(events[:n]); err != nil {
+			return events, fmt.Errorf("failed to write audit events to backend: %w", err)
+		}
+
+		// Sinks are best effort: the batch is stored, so it is not retried
+		if err := al.writeSinks(events[:n]); err != nil {
+			sinkErrs = append(sinkErrs, err)
+		}
+		events = events[n:]
+	}
+	return nil, goerrors.Join(sinkErrs...)
+}
@@ -0,0 +1,125 @@
+// audit_batch_test.go: Tests for asynchronous batched SQLite commits
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAuditLogger_SQLiteBatchSize(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.db")
+	recorder := &recordingSink{}
+
+	logger, err := NewAuditLogger(AuditConfig{
+		Enabled:         true,
+		OutputFile:      outputFile,
+		MinLevel:        AuditInfo,
+		BufferSize:      1000,
+		FlushInterval:   time.Hour,
+		SQLiteBatchSize: 4,
+		Sinks:           []AuditSink{recorder},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	if logger.batches == nil {
+		t.Fatal("Expected the SQLite backend to start the batch committer")
+	}
+
+	for i := 0; i < 10; i++ {
+		logger.LogFileWatch("file_changed", "/etc/app/config.json")
+	}
+	if err := logger.Flush(); err != nil {
+		t.Fatalf("Failed to flush audit logger: %v", err)
+	}
+
+	// Two full batches from Log plus the remainder from Flush, one
+	// transaction (and one sink flush) each
+	if recorder.flushes != 3 || len(recorder.events) != 10 {
+		t.Errorf("Expected 10 events in 3 batches, got %d events in %d batches", len(recorder.events), recorder.flushes)
+	}
+
+	// Events buffered before Close are committed by it
+	for i := 0; i < 3; i++ {
+		logger.LogFileWatch("file_changed", "/etc/app/config.json")
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Failed to close audit logger: %v", err)
+	}
+
+	reader, err := NewAuditLogger(AuditConfig{Enabled: true, OutputFile: outputFile, BufferSize: 10})
+	if err != nil {
+		t.Fatalf("Failed to reopen audit database: %v", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	events, err := reader.Query(AuditEventFilter{Limit: 100})
+	if err != nil {
+		t.Fatalf("Failed to query audit events: %v", err)
+	}
+	if len(events) != 13 {
+		t.Errorf("Expected 13 committed events after Close, got %d", len(events))
+	}
+}
+
+func TestAuditLogger_SQLiteBatchSizeIgnoredForJSONL(t *testing.T) {
+	logger, err := NewAuditLogger(AuditConfig{
+		Enabled:         true,
+		OutputFile:      filepath.Join(t.TempDir(), "audit.jsonl"),
+		BufferSize:      10,
+		SQLiteBatchSize: 4,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	defer func() { _ = logger.Close() }()
+
+	if logger.batches != nil {
+		t.Error("Expected JSONL audit logging to stay synchronous")
+	}
+}
+
+func TestAuditConfig_SQLiteBatchSizeValidation(t *testing.T) {
+	config := (&Config{Audit: AuditConfig{
+		Enabled:         true,
+		OutputFile:      filepath.Join(t.TempDir(), "audit.db"),
+		BufferSize:      100,
+		FlushInterval:   time.Second,
+		SQLiteBatchSize: -1,
+	}}).WithDefaults()
+	if err := config.Validate(); err != ErrInvalidSQLiteBatchSize {
+		t.Errorf("Expected ErrInvalidSQLiteBatchSize, got %v", err)
+	}
+}
+
+func BenchmarkAuditLogger_SQLiteBatchSize(b *testing.B) {
+	for _, bc := range []struct {
+		name      string
+		batchSize int
+	}{{"sync", 0}, {"async", 256}, {"async_large", 4096}} {
+		b.Run(bc.name, func(b *testing.B) {
+			logger, err := NewAuditLogger(AuditConfig{
+				Enabled:         true,
+				OutputFile:      filepath.Join(b.TempDir(), "audit.db"),
+				BufferSize:      256,
+				FlushInterval:   time.Second,
+				SQLiteBatchSize: bc.batchSize,
+			})
+			if err != nil {
+				b.Fatalf("Failed to create audit logger: %v", err)
+			}
+			defer func() { _ = logger.Close() }()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				logger.LogFileWatch("file_changed", "/etc/app/config.json")
+			}
+		})
+	}
+}
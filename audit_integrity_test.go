@@ -0,0 +1,133 @@
+// audit_integrity_test.go: Tests for offline audit trail verification
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestVerifyAuditIntegrity_SQLite(t *testing.T) {
+	al := newQueryTestLogger(t)
+	defer func() {
+		if err := al.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	}()
+
+	base := time.Now().UTC().Add(-time.Minute)
+	for i := 0; i < 5; i++ {
+		writeEventAt(t, al, base.Add(time.Duration(i)*time.Second), fmt.Sprintf("event.%d", i), "c", AuditInfo)
+	}
+
+	report, err := VerifyAuditIntegrity(dbPath(t, al))
+	if err != nil {
+		t.Fatalf("Failed to verify audit trail: %v", err)
+	}
+	if !report.Intact() || report.RecordsChecked != 5 || report.Format != "sqlite" {
+		t.Fatalf("Expected an intact 5-record sqlite trail, got %+v", report)
+	}
+
+	db := rawDB(t, al)
+	if _, err := db.Exec(`UPDATE audit_events SET event = 'event.forged' WHERE id IN (2, 4)`); err != nil {
+		t.Fatalf("raw UPDATE failed: %v", err)
+	}
+
+	report, err = VerifyAuditIntegrity(dbPath(t, al))
+	if err != nil {
+		t.Fatalf("Failed to verify audit trail: %v", err)
+	}
+	if report.Intact() || report.FirstBrokenIndex != 1 || report.TamperedRecords != 2 {
+		t.Fatalf("Expected records 1 and 3 to be flagged, got %+v", report)
+	}
+	if report.FirstBroken == nil || report.FirstBroken.Event != "event.forged" || report.Reason != "checksum mismatch" {
+		t.Errorf("Expected the forged record in the report, got %+v", report.FirstBroken)
+	}
+	if report.RecordsChecked != 5 {
+		t.Errorf("Expected every record to be checked, got %d", report.RecordsChecked)
+	}
+}
+
+func TestVerifyAuditIntegrity_JSONL(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.jsonl")
+	writeAuditBatches(t, AuditConfig{
+		Enabled:    true,
+		OutputFile: outputFile,
+		MinLevel:   AuditInfo,
+		BufferSize: 10,
+	}, 3)
+
+	report, err := VerifyAuditIntegrity(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to verify audit trail: %v", err)
+	}
+	if !report.Intact() || report.RecordsChecked != 3 || report.Format != "jsonl" {
+		t.Fatalf("Expected an intact 3-record jsonl trail, got %+v", report)
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read audit file: %v", err)
+	}
+	forged := strings.Replace(string(data), `"batch-2"`, `"batch-X"`, 1) + "{not json\n"
+	if err := os.WriteFile(outputFile, []byte(forged), 0600); err != nil {
+		t.Fatalf("Failed to rewrite audit file: %v", err)
+	}
+
+	report, err = VerifyAuditIntegrity(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to verify audit trail: %v", err)
+	}
+	if report.FirstBrokenIndex != 2 || report.TamperedRecords != 2 || report.RecordsChecked != 4 {
+		t.Errorf("Expected the edited and the undecodable record to be flagged, got %+v", report)
+	}
+
+	if _, err := VerifyAuditIntegrity(filepath.Join(t.TempDir(), "missing.jsonl")); !errors.HasCode(err, ErrCodeIOError) {
+		t.Errorf("Expected an I/O error for a missing trail, got %v", err)
+	}
+}
+
+func TestVerifyAuditIntegrity_SQLiteDeletedRecords(t *testing.T) {
+	al := newQueryTestLogger(t)
+	defer func() {
+		if err := al.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	}()
+
+	base := time.Now().UTC().Add(-time.Minute)
+	for i := 0; i < 6; i++ {
+		writeEventAt(t, al, base.Add(time.Duration(i)*time.Second), fmt.Sprintf("event.%d", i), "c", AuditInfo)
+	}
+
+	// Deleting the oldest record looks like retention cleanup, deleting
+	// from the middle leaves a gap in the id sequence
+	db := rawDB(t, al)
+	if _, err := db.Exec(`DELETE FROM audit_events WHERE id IN (1, 3, 4)`); err != nil {
+		t.Fatalf("raw DELETE failed: %v", err)
+	}
+
+	report, err := VerifyAuditIntegrity(dbPath(t, al))
+	if err != nil {
+		t.Fatalf("Failed to verify audit trail: %v", err)
+	}
+	if report.Intact() || report.MissingRecords != 2 || report.TamperedRecords != 0 {
+		t.Fatalf("Expected 2 missing and no tampered records, got %+v", report)
+	}
+	if report.FirstBrokenIndex != 1 || report.FirstBroken == nil || report.FirstBroken.Event != "event.4" {
+		t.Errorf("Expected the record after the gap to be reported, got index %d: %+v", report.FirstBrokenIndex, report.FirstBroken)
+	}
+	if !strings.Contains(report.Reason, "2 records missing") {
+		t.Errorf("Expected the reason to count the missing records, got %q", report.Reason)
+	}
+}
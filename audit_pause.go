@@ -0,0 +1,120 @@
+// audit_pause.go: Temporary suppression of routine audit events
+//
+// Bulk operations such as config migrations legitimately rewrite many files
+// and would otherwise flood the trail with expected changes. Pausing replaces
+// that noise with a single bracketing event describing the window, its reason
+// and how many events were suppressed. Security events are never suppressed,
+// and the window is opened and closed by security events of its own.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// auditPause tracks the current pause window (guarded by AuditLogger.pauseMu)
+type auditPause struct {
+	reason     string
+	since      time.Time
+	suppressed atomic.Int64
+}
+
+// Pause suppresses non-security audit events until Resume is called and
+// records an "audit_pause_started" security event with the reason.
+// Security events (AuditSecurity) are still recorded. Calling Pause while
+// already paused keeps the original window and reason.
+//
+// Example:
+//
+//	logger.Pause("bulk config migration (ticket OPS-1234)")
+//	defer logger.Resume()
+func (al *AuditLogger) Pause(reason string) {
+	al.startPause(reason)
+}
+
+// startPause opens a pause window and reports whether this call opened it
+// (false when the logger is nil or already paused)
+func (al *AuditLogger) startPause(reason string) bool {
+	if al == nil {
+		return false
+	}
+
+	al.pauseMu.Lock()
+	if al.isPaused.Load() {
+		al.pauseMu.Unlock()
+		return false
+	}
+	since := time.Now()
+	al.pause.reason = reason
+	al.pause.since = since
+	al.pause.suppressed.Store(0)
+	al.isPaused.Store(true)
+	al.pauseMu.Unlock()
+
+	al.Log(AuditSecurity, "audit_pause_started", "argus", "", nil, nil, map[string]interface{}{
+		"reason":    reason,
+		"paused_at": since.UTC().Format(time.RFC3339Nano),
+	})
+	return true
+}
+
+// Resume ends a pause window and records a single "audit_paused" event with
+// the window bounds, the reason and the number of suppressed events. The event
+// is logged at AuditSecurity level, since suppressing the trail is itself
+// security relevant. It is a no-op when the logger is not paused.
+func (al *AuditLogger) Resume() {
+	if al == nil {
+		return
+	}
+
+	al.pauseMu.Lock()
+	if !al.isPaused.Load() {
+		al.pauseMu.Unlock()
+		return
+	}
+	al.isPaused.Store(false)
+	reason, since := al.pause.reason, al.pause.since
+	suppressed := al.pause.suppressed.Load()
+	al.pauseMu.Unlock()
+
+	until := time.Now()
+	al.Log(AuditSecurity, "audit_paused", "argus", "", nil, nil, map[string]interface{}{
+		"reason":            reason,
+		"paused_at":         since.UTC().Format(time.RFC3339Nano),
+		"resumed_at":        until.UTC().Format(time.RFC3339Nano),
+		"duration_ms":       until.Sub(since).Milliseconds(),
+		"suppressed_events": suppressed,
+	})
+}
+
+// IsPaused reports whether routine audit events are currently suppressed
+func (al *AuditLogger) IsPaused() bool {
+	return al != nil && al.isPaused.Load()
+}
+
+// SuspendAudit pauses routine audit logging for the watcher during a known
+// noisy operation and returns a function that resumes it. Security events
+// are still recorded; the pause window is bracketed by a start and an end event.
+//
+// Only the call that opened the pause can end it: when audit is already
+// paused, the returned function is a no-op, so nested suspensions do not cut
+// the outer window short.
+//
+// Example:
+//
+//	resume := watcher.SuspendAudit("bulk config migration")
+//	defer resume()
+func (w *Watcher) SuspendAudit(reason string) (resume func()) {
+	if !w.auditLogger.startPause(reason) {
+		return func() {}
+	}
+
+	var once sync.Once
+	return func() { once.Do(w.auditLogger.Resume) }
+}
@@ -79,6 +79,11 @@ type AuditEventFilter struct {
 	// Limit caps the number of events returned.
 	// 0 or negative → DefaultQueryLimit.
 	Limit int
+
+	// Offset skips that many matching events before returning Limit, for
+	// pagination: page n of size s uses Limit s and Offset n*s.
+	// 0 or negative → no events skipped.
+	Offset int
 }
 
 // queryableBackend is satisfied only by backends that implement event retrieval.
@@ -97,7 +102,8 @@ func (al *AuditLogger) GetStats() (*AuditDatabaseStats, error) {
 }
 
 // Query returns audit events matching filter, ordered newest-first
-// (descending timestamp). An empty result is always a non-nil empty slice,
+// (descending timestamp, then insertion order, so pages with Limit and
+// Offset neither overlap nor skip events). An empty result is always a non-nil empty slice,
 // never nil.
 //
 // SHA-chain integrity: every returned event's checksum is recomputed via
@@ -151,6 +157,7 @@ type normalizedFilter struct {
 	component string
 	levelInt  int
 	limit     int
+	offset    int
 }
 
 // normalizeFilter applies safe defaults to zero fields.
@@ -168,6 +175,11 @@ func normalizeFilter(f AuditEventFilter) normalizedFilter {
 		limit = DefaultQueryLimit
 	}
 
+	offset := f.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
 	return normalizedFilter{
 		sinceStr:  since.UTC().Format(time.RFC3339Nano),
 		untilStr:  until.UTC().Format(time.RFC3339Nano),
@@ -175,6 +187,7 @@ func normalizeFilter(f AuditEventFilter) normalizedFilter {
 		component: f.Component,
 		levelInt:  int(f.Level),
 		limit:     limit,
+		offset:    offset,
 	}
 }
 
@@ -209,8 +222,8 @@ SELECT id, timestamp, level, event, component,
    AND timestamp <= ?
    AND event LIKE ? ESCAPE '\'
    AND (? = '' OR component = ?)
- ORDER BY timestamp DESC
- LIMIT ?`
+ ORDER BY timestamp DESC, id DESC
+ LIMIT ? OFFSET ?`
 
 // queryEvents implements queryableBackend for the SQLite backend.
 // All operator inputs are bound as parameters; none are interpolated into SQL.
@@ -234,6 +247,7 @@ func (s *sqliteAuditBackend) queryEvents(filter AuditEventFilter) ([]AuditEvent,
 		norm.component, // used in: ? = ''
 		norm.component, // used in: component = ?
 		norm.limit,
+		norm.offset,
 	)
 	if err != nil {
 		// Typed error without echoing raw DB internals (CWE-209).
@@ -270,6 +284,13 @@ func scanRows(rows *sql.Rows) ([]AuditEvent, error) {
 // scanAuditRow scans one result row into an AuditEvent, deserialising JSON
 // columns using the same marshaller as the write path (insertEvent).
 func scanAuditRow(rows *sql.Rows) (AuditEvent, error) {
+	_, ev, err := scanAuditRowID(rows)
+	return ev, err
+}
+
+// scanAuditRowID is scanAuditRow also returning the row id, which is set
+// whenever the columns could be scanned, even if decoding them failed
+func scanAuditRowID(rows *sql.Rows) (int64, AuditEvent, error) {
 	var (
 		id           int64
 		tsStr        string
@@ -290,12 +311,12 @@ func scanAuditRow(rows *sql.Rows) (AuditEvent, error) {
 		&filePath, &oldValueJSON, &newValueJSON,
 		&processID, &processName, &contextJSON, &checksum,
 	); err != nil {
-		return AuditEvent{}, err
+		return 0, AuditEvent{}, err
 	}
 
 	ts, err := time.Parse(time.RFC3339Nano, tsStr)
 	if err != nil {
-		return AuditEvent{}, fmt.Errorf("invalid timestamp %q: %w", tsStr, err)
+		return id, AuditEvent{}, fmt.Errorf("invalid timestamp %q: %w", tsStr, err)
 	}
 
 	ev := AuditEvent{
@@ -310,20 +331,20 @@ func scanAuditRow(rows *sql.Rows) (AuditEvent, error) {
 	}
 
 	if err := unmarshalNullJSON(oldValueJSON, &ev.OldValue); err != nil {
-		return AuditEvent{}, fmt.Errorf("failed to deserialise old_value: %w", err)
+		return id, AuditEvent{}, fmt.Errorf("failed to deserialise old_value: %w", err)
 	}
 	if err := unmarshalNullJSON(newValueJSON, &ev.NewValue); err != nil {
-		return AuditEvent{}, fmt.Errorf("failed to deserialise new_value: %w", err)
+		return id, AuditEvent{}, fmt.Errorf("failed to deserialise new_value: %w", err)
 	}
 	if contextJSON.Valid && contextJSON.String != "" {
 		var ctx map[string]interface{}
 		if err := json.Unmarshal([]byte(contextJSON.String), &ctx); err != nil {
-			return AuditEvent{}, fmt.Errorf("failed to deserialise context: %w", err)
+			return id, AuditEvent{}, fmt.Errorf("failed to deserialise context: %w", err)
 		}
 		ev.Context = ctx
 	}
 
-	return ev, nil
+	return id, ev, nil
 }
 
 // unmarshalNullJSON deserialises a nullable JSON column into dst.
@@ -56,7 +56,7 @@ func buildPopulatedLogger(tb testing.TB, n int) *AuditLogger {
 		ev.Checksum = al.generateChecksum(ev)
 		events[i] = ev
 	}
-	if err := al.backend.Write(events); err != nil {
+	if err := al.backend.WriteBatch(events); err != nil {
 		tb.Fatalf("buildPopulatedLogger write: %v", err)
 	}
 	return al
@@ -59,7 +59,7 @@ func writeEventAt(t *testing.T, al *AuditLogger, ts time.Time, event, component
 		ProcessName: "test",
 	}
 	ev.Checksum = al.generateChecksum(ev)
-	if err := al.backend.Write([]AuditEvent{ev}); err != nil {
+	if err := al.backend.WriteBatch([]AuditEvent{ev}); err != nil {
 		t.Fatalf("writeEventAt: %v", err)
 	}
 }
@@ -83,7 +83,7 @@ func writeBatch(t *testing.T, al *AuditLogger, n int) {
 		ev.Checksum = al.generateChecksum(ev)
 		events[i] = ev
 	}
-	if err := al.backend.Write(events); err != nil {
+	if err := al.backend.WriteBatch(events); err != nil {
 		t.Fatalf("writeBatch(%d): %v", n, err)
 	}
 }
@@ -328,6 +328,51 @@ func TestQuery_LimitHonoured(t *testing.T) {
 	}
 }
 
+func TestQuery_OffsetPaginates(t *testing.T) {
+	t.Parallel()
+	al := newQueryTestLogger(t)
+	defer func() {
+		if err := al.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	}()
+
+	base := time.Now().UTC().Add(-time.Minute)
+	for i := 0; i < 25; i++ {
+		writeEventAt(t, al, base.Add(time.Duration(i)*time.Second), fmt.Sprintf("e%02d", i), "c", AuditInfo)
+	}
+
+	var pages []string
+	for offset := 0; ; offset += 10 {
+		events, err := al.Query(AuditEventFilter{Limit: 10, Offset: offset})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+		for _, ev := range events {
+			pages = append(pages, ev.Event)
+		}
+	}
+	if len(pages) != 25 {
+		t.Fatalf("expected 25 events across pages, got %d", len(pages))
+	}
+	for i, name := range pages {
+		if want := fmt.Sprintf("e%02d", 24-i); name != want {
+			t.Fatalf("expected %s at position %d, got %s", want, i, name)
+		}
+	}
+
+	events, err := al.Query(AuditEventFilter{Limit: 5, Offset: -3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 5 || events[0].Event != "e24" {
+		t.Errorf("expected a negative offset to start at the newest event, got %d events", len(events))
+	}
+}
+
 func TestQuery_DefaultLimitApplied(t *testing.T) {
 	// Not parallel: writes 11 000 events, keep isolated.
 	al := newQueryTestLogger(t)
@@ -602,7 +647,7 @@ func TestQuery_RoundtripsContextAndValues(t *testing.T) {
 		Context:     wantCtx,
 	}
 	ev.Checksum = al.generateChecksum(ev)
-	if err := al.backend.Write([]AuditEvent{ev}); err != nil {
+	if err := al.backend.WriteBatch([]AuditEvent{ev}); err != nil {
 		t.Fatalf("Write: %v", err)
 	}
 
@@ -0,0 +1,88 @@
+// audit_reader.go: Reading JSONL audit files, plain or gzip-compressed
+//
+// The JSONL backend writes one event per line, optionally gzip-compressed
+// (AuditConfig.Compress). ReadAuditLog detects compression from the gzip
+// magic bytes rather than the file name, so renamed or rotated files read
+// the same way.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// maxAuditLineSize bounds a single JSONL line, guarding against corrupt files
+const maxAuditLineSize = 1024 * 1024
+
+// ReadAuditLog reads every event of a JSONL audit file. Gzip-compressed
+// files, including files made of several appended gzip members, are
+// decompressed transparently. A compressed file whose writer crashed before
+// Close lacks its trailer; events flushed before the crash are returned.
+//
+// Example:
+//
+//	events, err := argus.ReadAuditLog("/var/log/argus/audit.jsonl.gz")
+func ReadAuditLog(path string) ([]AuditEvent, error) {
+	file, err := os.Open(path) // #nosec G304 -- operator-supplied audit path
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	events, err := readAuditEvents(file)
+	if err != nil {
+		return events, fmt.Errorf("failed to read audit log %s: %w", path, err)
+	}
+	return events, nil
+}
+
+// readAuditEvents decodes JSONL events from r, decompressing gzip input
+func readAuditEvents(r io.Reader) ([]AuditEvent, error) {
+	buffered := bufio.NewReader(r)
+	var src io.Reader = buffered
+
+	magic, err := buffered.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip header: %w", err)
+		}
+		defer func() { _ = gz.Close() }()
+		src = gz
+	}
+
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAuditLineSize)
+
+	var events []AuditEvent
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var event AuditEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			return events, fmt.Errorf("invalid event at line %d: %w", lineNum, err)
+		}
+		events = append(events, event)
+	}
+
+	// A missing gzip trailer only means the writer did not close cleanly;
+	// the partial line in progress, if any, is dropped by the scanner
+	if err := scanner.Err(); err != nil && !goerrors.Is(err, io.ErrUnexpectedEOF) {
+		return events, err
+	}
+	return events, nil
+}
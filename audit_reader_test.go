@@ -0,0 +1,137 @@
+// audit_reader_test.go: Tests for reading plain and compressed audit files
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAuditCompress_RoundTrip(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	config := AuditConfig{
+		Enabled:       true,
+		OutputFile:    outputFile,
+		MinLevel:      AuditInfo,
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+		Compress:      true,
+	}
+
+	// Two sessions append two gzip members to the same file
+	for session := 0; session < 2; session++ {
+		logger, err := NewAuditLogger(config)
+		if err != nil {
+			t.Fatalf("Failed to create audit logger: %v", err)
+		}
+		logger.LogFileWatch("file_changed", "/etc/app/config.json")
+		logger.LogSecurityEvent("test_event", "round trip", map[string]interface{}{"session": session})
+		if err := logger.Close(); err != nil {
+			t.Fatalf("Failed to close audit logger: %v", err)
+		}
+	}
+
+	if _, err := os.Stat(outputFile); !os.IsNotExist(err) {
+		t.Errorf("Expected no uncompressed file, stat returned %v", err)
+	}
+	compressed := outputFile + ".gz"
+	f, err := os.Open(compressed)
+	if err != nil {
+		t.Fatalf("Failed to open compressed file: %v", err)
+	}
+	if _, err := gzip.NewReader(f); err != nil {
+		t.Errorf("Expected a gzip file: %v", err)
+	}
+	_ = f.Close()
+
+	events, err := ReadAuditLog(compressed)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("Expected 4 events, got %d", len(events))
+	}
+	if events[0].Event != "file_changed" || events[0].FilePath != "/etc/app/config.json" {
+		t.Errorf("Unexpected first event: %+v", events[0])
+	}
+	if events[3].Event != "test_event" || events[3].Level != AuditSecurity {
+		t.Errorf("Unexpected last event: %+v", events[3])
+	}
+	for _, event := range events {
+		if event.Checksum == "" {
+			t.Error("Expected events to keep their checksum")
+		}
+	}
+}
+
+func TestAuditCompress_ReadableBeforeClose(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.jsonl.gz")
+
+	logger, err := NewAuditLogger(AuditConfig{
+		Enabled:       true,
+		OutputFile:    outputFile,
+		MinLevel:      AuditInfo,
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	defer func() { _ = logger.Close() }()
+
+	logger.LogFileWatch("file_changed", "/etc/app/config.json")
+	if err := logger.Flush(); err != nil {
+		t.Fatalf("Failed to flush audit logger: %v", err)
+	}
+
+	// No gzip trailer yet: flushed blocks must still be readable
+	events, err := ReadAuditLog(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	if len(events) != 1 || events[0].Event != "file_changed" {
+		t.Errorf("Expected the flushed event, got %+v", events)
+	}
+}
+
+func TestReadAuditLog_Plain(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	logger, err := NewAuditLogger(AuditConfig{
+		Enabled:       true,
+		OutputFile:    outputFile,
+		MinLevel:      AuditInfo,
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	logger.LogFileWatch("file_changed", "/etc/app/config.json")
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Failed to close audit logger: %v", err)
+	}
+
+	events, err := ReadAuditLog(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected 1 event, got %d", len(events))
+	}
+
+	if err := os.WriteFile(outputFile, []byte("{not json}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if _, err := ReadAuditLog(outputFile); err == nil {
+		t.Error("Expected error for a malformed line")
+	}
+}
@@ -0,0 +1,81 @@
+// audit_rotation.go: Size-based rotation of the JSONL audit file
+//
+// A long-running service appending to one audit file eventually fills the
+// disk. With AuditConfig.MaxFileSize the JSONL backend rotates the file the
+// way standard log rotation does: audit.jsonl becomes audit.jsonl.1, older
+// backups shift up by one and the oldest beyond MaxBackups is deleted.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// countingWriter counts the bytes written through it into *n
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+// Write writes p and adds the bytes written to the count
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	*c.n += int64(n)
+	return n, err
+}
+
+// rotate closes the current audit file, shifts the backups and opens a new
+// file. Called with j.mu held, before a batch is written. If the backups
+// cannot be shifted the current file is reopened and appended to, so events
+// are never lost; rotation is attempted again before the next batch.
+func (j *jsonlAuditBackend) rotate() error {
+	var closeErr error
+	if j.gz != nil {
+		closeErr = j.gz.Close() // Completes the gzip member of this file
+	}
+	if err := j.file.Close(); closeErr == nil {
+		closeErr = err
+	}
+	if closeErr != nil {
+		// The file handle is gone either way: keep the trail going
+		if err := j.open(); err != nil {
+			return err
+		}
+		return fmt.Errorf("failed to close JSONL audit file for rotation: %w", closeErr)
+	}
+
+	_ = j.shiftBackups() // On failure the current file keeps growing until the next attempt
+
+	// Always reopen: either a fresh file or, if shifting failed, the old one
+	return j.open()
+}
+
+// shiftBackups renames the audit file to .1, each backup .n to .n+1, and
+// deletes the backup beyond maxBackups (or the file itself with none kept)
+func (j *jsonlAuditBackend) shiftBackups() error {
+	if j.maxBackups <= 0 {
+		return os.Remove(j.sourceFile)
+	}
+
+	if err := os.Remove(j.backupPath(j.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for n := j.maxBackups - 1; n >= 1; n-- {
+		if err := os.Rename(j.backupPath(n), j.backupPath(n+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(j.sourceFile, j.backupPath(1))
+}
+
+// backupPath returns the name of the n-th rotated audit file
+func (j *jsonlAuditBackend) backupPath(n int) string {
+	return j.sourceFile + "." + strconv.Itoa(n)
+}
@@ -0,0 +1,121 @@
+// audit_rotation_test.go: Tests for JSONL audit file rotation
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeAuditBatches logs one event per batch, named batch-0, batch-1, ...
+func writeAuditBatches(t *testing.T, config AuditConfig, batches int) {
+	t.Helper()
+	logger, err := NewAuditLogger(config)
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	for i := 0; i < batches; i++ {
+		logger.LogFileWatch(fmt.Sprintf("batch-%d", i), "/etc/app/config.json")
+		if err := logger.Flush(); err != nil {
+			t.Fatalf("Failed to flush batch %d: %v", i, err)
+		}
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Failed to close audit logger: %v", err)
+	}
+}
+
+// auditFileEvents returns the event names stored in path
+func auditFileEvents(t *testing.T, path string) []string {
+	t.Helper()
+	events, err := ReadAuditLog(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", filepath.Base(path), err)
+	}
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.Event
+	}
+	return names
+}
+
+func TestAuditRotation_KeepsBackups(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.jsonl")
+	writeAuditBatches(t, AuditConfig{
+		Enabled:       true,
+		OutputFile:    outputFile,
+		MinLevel:      AuditInfo,
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+		MaxFileSize:   1, // Rotate before every batch but the first
+		MaxBackups:    2,
+	}, 4)
+
+	expected := map[string]string{
+		outputFile:        "batch-3",
+		outputFile + ".1": "batch-2",
+		outputFile + ".2": "batch-1",
+	}
+	for path, want := range expected {
+		if got := auditFileEvents(t, path); len(got) != 1 || got[0] != want {
+			t.Errorf("Expected %s to hold [%s], got %v", filepath.Base(path), want, got)
+		}
+	}
+	if _, err := os.Stat(outputFile + ".3"); !os.IsNotExist(err) {
+		t.Errorf("Expected backups beyond MaxBackups to be deleted, stat returned %v", err)
+	}
+}
+
+func TestAuditRotation_CompressedAndNoBackups(t *testing.T) {
+	dir := t.TempDir()
+
+	compressed := filepath.Join(dir, "audit.jsonl")
+	writeAuditBatches(t, AuditConfig{
+		Enabled:     true,
+		OutputFile:  compressed,
+		BufferSize:  10,
+		Compress:    true,
+		MaxFileSize: 1,
+		MaxBackups:  1,
+	}, 2)
+	if got := auditFileEvents(t, compressed+".gz.1"); len(got) != 1 || got[0] != "batch-0" {
+		t.Errorf("Expected the rotated compressed file to hold [batch-0], got %v", got)
+	}
+	if got := auditFileEvents(t, compressed+".gz"); len(got) != 1 || got[0] != "batch-1" {
+		t.Errorf("Expected the new compressed file to hold [batch-1], got %v", got)
+	}
+
+	plain := filepath.Join(dir, "nobackups.jsonl")
+	writeAuditBatches(t, AuditConfig{
+		Enabled:     true,
+		OutputFile:  plain,
+		BufferSize:  10,
+		MaxFileSize: 1,
+	}, 3)
+	if got := auditFileEvents(t, plain); len(got) != 1 || got[0] != "batch-2" {
+		t.Errorf("Expected only the latest batch without backups, got %v", got)
+	}
+	if _, err := os.Stat(plain + ".1"); !os.IsNotExist(err) {
+		t.Errorf("Expected no backup with MaxBackups 0, stat returned %v", err)
+	}
+}
+
+func TestAuditRotation_Validation(t *testing.T) {
+	config := (&Config{Audit: AuditConfig{
+		Enabled:       true,
+		OutputFile:    filepath.Join(t.TempDir(), "audit.jsonl"),
+		BufferSize:    10,
+		FlushInterval: time.Second,
+		MaxBackups:    -1,
+	}}).WithDefaults()
+	if err := config.Validate(); err != ErrInvalidAuditRotation {
+		t.Errorf("Expected ErrInvalidAuditRotation for negative MaxBackups, got %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+// audit_sink.go: Pluggable destinations for audit events
+//
+// Audit output used to be hardwired to the SQLite or JSONL file. Sinks let
+// applications forward the same events to stdout, a syslog socket or an HTTP
+// collector without changes to Argus. The built-in backends implement the
+// same interface and remain the system of record: sinks receive each batch
+// after it has been stored.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"bufio"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// AuditSink receives audit events. Write is called for each event of a
+// flushed batch, then Flush once for the batch. Calls for one AuditLogger
+// are serialized, but a sink shared by several loggers must be safe for
+// concurrent use. Events carry their tamper-detection Checksum.
+type AuditSink interface {
+	Write(event AuditEvent) error
+	Flush() error
+}
+
+// auditBatchSink is implemented by sinks that write a batch more efficiently
+// than event by event, like the built-in backends
+type auditBatchSink interface {
+	WriteBatch(events []AuditEvent) error
+}
+
+// writeSinks delivers a stored batch to every configured sink. A failing sink
+// does not hold back the others; the errors are joined and returned.
+func (al *AuditLogger) writeSinks(events []AuditEvent) error {
+	var errs []error
+	for i, sink := range al.config.Sinks {
+		if err := writeSink(sink, events); err != nil {
+			errs = append(errs, fmt.Errorf("audit sink %d: %w", i, err))
+		}
+	}
+	return goerrors.Join(errs...)
+}
+
+// writeSink writes events to sink and flushes it
+func writeSink(sink AuditSink, events []AuditEvent) error {
+	if batch, ok := sink.(auditBatchSink); ok {
+		if err := batch.WriteBatch(events); err != nil {
+			return err
+		}
+		return sink.Flush()
+	}
+
+	for _, event := range events {
+		if err := sink.Write(event); err != nil {
+			return err
+		}
+	}
+	return sink.Flush()
+}
+
+// jsonAuditSink writes events as JSON lines to an io.Writer
+type jsonAuditSink struct {
+	mu  sync.Mutex
+	out *bufio.Writer
+}
+
+// NewJSONAuditSink returns an AuditSink writing each event as one line of
+// JSON to w, in the JSONL backend's format. Writes are buffered until Flush.
+//
+// Example:
+//
+//	config := argus.DefaultAuditConfig()
+//	config.Sinks = []argus.AuditSink{argus.NewJSONAuditSink(os.Stdout)}
+func NewJSONAuditSink(w io.Writer) AuditSink {
+	return &jsonAuditSink{out: bufio.NewWriter(w)}
+}
+
+// Write encodes event as a JSON line
+func (s *jsonAuditSink) Write(event AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.out.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+	return nil
+}
+
+// Flush writes buffered lines to the underlying writer
+func (s *jsonAuditSink) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.out.Flush()
+}
@@ -0,0 +1,128 @@
+// audit_sink_test.go: Tests for pluggable audit sinks
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordingSink keeps the events it receives
+type recordingSink struct {
+	events  []AuditEvent
+	flushes int
+	fail    bool
+}
+
+func (s *recordingSink) Write(event AuditEvent) error {
+	if s.fail {
+		return fmt.Errorf("collector unreachable")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *recordingSink) Flush() error {
+	s.flushes++
+	return nil
+}
+
+func TestAuditSinks_ReceiveStoredEvents(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "audit.jsonl")
+	recorder := &recordingSink{}
+	failing := &recordingSink{fail: true}
+	var stdout bytes.Buffer
+
+	logger, err := NewAuditLogger(AuditConfig{
+		Enabled:       true,
+		OutputFile:    outputFile,
+		MinLevel:      AuditInfo,
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+		Sinks:         []AuditSink{failing, recorder, NewJSONAuditSink(&stdout)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	defer func() { _ = logger.Close() }()
+
+	logger.LogFileWatch("file_changed", "/etc/app/config.json")
+	logger.LogSecurityEvent("path_traversal_attempt", "blocked", nil)
+
+	err = logger.Flush()
+	if err == nil || !strings.Contains(err.Error(), "collector unreachable") {
+		t.Errorf("Expected the failing sink's error from Flush, got %v", err)
+	}
+
+	// The failing sink does not hold back storage or the other sinks
+	if len(recorder.events) != 2 || recorder.flushes != 1 {
+		t.Fatalf("Expected 2 events and 1 flush in the recording sink, got %d/%d", len(recorder.events), recorder.flushes)
+	}
+	if recorder.events[1].Event != "path_traversal_attempt" || recorder.events[1].Checksum == "" {
+		t.Errorf("Expected checksummed events in order, got %+v", recorder.events[1])
+	}
+	stored, err := ReadAuditLog(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	if len(stored) != 2 {
+		t.Errorf("Expected 2 stored events, got %d", len(stored))
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 JSON lines from the JSON sink, got %q", stdout.String())
+	}
+	var decoded AuditEvent
+	if err := json.Unmarshal([]byte(lines[0]), &decoded); err != nil || decoded.Event != "file_changed" {
+		t.Errorf("Expected a JSON-encoded file_changed event, got %q (%v)", lines[0], err)
+	}
+
+	// A sink failure does not leave the batch buffered for a second write
+	if err := logger.Flush(); err != nil {
+		t.Errorf("Expected an empty flush to succeed, got %v", err)
+	}
+	if len(recorder.events) != 2 {
+		t.Errorf("Expected no duplicate deliveries, got %d events", len(recorder.events))
+	}
+}
+
+func TestAuditSinks_BuiltinBackendsAreSinks(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "mirror.jsonl")
+	mirror, err := newJSONLBackend(AuditConfig{OutputFile: outputFile})
+	if err != nil {
+		t.Fatalf("Failed to create JSONL backend: %v", err)
+	}
+	defer func() { _ = mirror.Close() }()
+
+	var sink AuditSink = mirror
+	if err := sink.Write(AuditEvent{Event: "single", Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Failed to write through the sink interface: %v", err)
+	}
+	if err := sink.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+	if events, err := ReadAuditLog(outputFile); err != nil || len(events) != 1 || events[0].Event != "single" {
+		t.Errorf("Expected the event in the mirror file, got %v (%v)", events, err)
+	}
+}
+
+func TestAuditSinks_DefaultsKeepSinks(t *testing.T) {
+	sink := &recordingSink{}
+	config := (&Config{Audit: AuditConfig{Sinks: []AuditSink{sink}}}).WithDefaults()
+	if !config.Audit.Enabled {
+		t.Error("Expected an Audit with only Sinks to receive the enabled default")
+	}
+	if len(config.Audit.Sinks) != 1 || config.Audit.Sinks[0] != sink {
+		t.Errorf("Expected the sink to survive defaults, got %v", config.Audit.Sinks)
+	}
+}
@@ -0,0 +1,72 @@
+// audit_stack.go: Call-site stack traces for audit events
+//
+// A security event says what happened; with AuditConfig.IncludeStack it also
+// says which code path did it. The trace is trimmed to the frames outside the
+// audit logger and capped in depth, and StackMinLevel keeps the cost off
+// high-volume informational events.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// auditStackDepth caps the frames recorded per event
+	auditStackDepth = 16
+
+	// auditLoggerFrame prefixes the functions of the audit logger itself,
+	// which are trimmed from the top of the trace
+	auditLoggerFrame = "github.com/agilira/argus.(*AuditLogger)."
+)
+
+// wantsStack reports whether an event of level gets a stack trace
+func (al *AuditLogger) wantsStack(level AuditLevel) bool {
+	return al.config.IncludeStack && level >= al.config.StackMinLevel
+}
+
+// withStack returns a copy of context with the caller's stack under "stack".
+// The caller's map is never modified.
+func withStack(context map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(context)+1)
+	for k, v := range context {
+		result[k] = v
+	}
+	result["stack"] = callerStack()
+	return result
+}
+
+// callerStack returns the stack of the code that called into the audit
+// logger, one "function file:line" per line, innermost first
+func callerStack() string {
+	pcs := make([]uintptr, auditStackDepth+8)
+	n := runtime.Callers(3, pcs) // Skip runtime.Callers, callerStack, withStack
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var sb strings.Builder
+	depth := 0
+	for depth < auditStackDepth {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, auditLoggerFrame) {
+			if depth > 0 {
+				sb.WriteByte('\n')
+			}
+			sb.WriteString(frame.Function)
+			sb.WriteByte(' ')
+			sb.WriteString(frame.File)
+			sb.WriteByte(':')
+			sb.WriteString(strconv.Itoa(frame.Line))
+			depth++
+		}
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+// audit_stack_test.go: Tests for call-site stack traces in audit events
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuditIncludeStack(t *testing.T) {
+	sink := &recordingSink{}
+	logger, err := NewAuditLogger(AuditConfig{
+		Enabled:       true,
+		OutputFile:    filepath.Join(t.TempDir(), "audit.jsonl"),
+		MinLevel:      AuditInfo,
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+		IncludeStack:  true,
+		StackMinLevel: AuditWarn,
+		Sinks:         []AuditSink{sink},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	defer func() { _ = logger.Close() }()
+
+	context := map[string]interface{}{"source_ip": "10.0.0.1"}
+	logger.LogFileWatch("file_changed", "/etc/app/config.json")
+	logger.LogSecurityEvent("unauthorized_access", "denied", context)
+	if err := logger.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+
+	if len(sink.events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(sink.events))
+	}
+	if _, ok := sink.events[0].Context["stack"]; ok {
+		t.Error("Expected info events below StackMinLevel to skip the stack")
+	}
+
+	security := sink.events[1]
+	stack, _ := security.Context["stack"].(string)
+	firstFrame := strings.SplitN(stack, "\n", 2)[0]
+	if !strings.Contains(firstFrame, "TestAuditIncludeStack") {
+		t.Errorf("Expected the trace to start at the call site, got %q", firstFrame)
+	}
+	if strings.Contains(stack, "(*AuditLogger)") {
+		t.Errorf("Expected audit logger frames to be trimmed, got %q", stack)
+	}
+	if security.Context["source_ip"] != "10.0.0.1" {
+		t.Errorf("Expected caller context to be kept, got %v", security.Context)
+	}
+	if _, ok := context["stack"]; ok {
+		t.Error("Expected the caller's context map to be left untouched")
+	}
+}
@@ -7,7 +7,9 @@
 package argus
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -266,3 +268,216 @@ func TestAuditLevel_String(t *testing.T) {
 		}
 	}
 }
+
+func TestAuditLoggerPauseResume(t *testing.T) {
+	auditor, err := NewAuditLogger(AuditConfig{
+		Enabled:    true,
+		OutputFile: filepath.Join(t.TempDir(), "audit.db"),
+		MinLevel:   AuditInfo,
+		BufferSize: 100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := auditor.Close(); err != nil {
+			t.Errorf("Failed to close auditor: %v", err)
+		}
+	}()
+
+	auditor.LogFileWatch("before_pause", "/config/app.json")
+
+	auditor.Pause("bulk migration")
+	if !auditor.IsPaused() {
+		t.Fatal("Expected logger to be paused")
+	}
+	for i := 0; i < 5; i++ {
+		auditor.LogFileWatch("migration_write", "/config/app.json")
+	}
+	auditor.LogConfigChange("/config/app.json", nil, map[string]interface{}{"v": 2})
+	auditor.LogSecurityEvent("path_traversal_attempt", "still recorded", nil)
+	auditor.Resume()
+
+	auditor.LogFileWatch("after_resume", "/config/app.json")
+	if err := auditor.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+
+	events, err := auditor.Query(AuditEventFilter{})
+	if err != nil {
+		t.Fatalf("Failed to query audit trail: %v", err)
+	}
+
+	counts := make(map[string]int)
+	var bracket AuditEvent
+	for _, e := range events {
+		counts[e.Event]++
+		if e.Event == "audit_paused" {
+			bracket = e
+		}
+	}
+
+	if counts["migration_write"] != 0 || counts["config_change"] != 0 {
+		t.Errorf("Expected routine events to be suppressed while paused, got %v", counts)
+	}
+	if counts["path_traversal_attempt"] != 1 {
+		t.Errorf("Expected security event to flow while paused, got %v", counts)
+	}
+	if counts["before_pause"] != 1 || counts["after_resume"] != 1 {
+		t.Errorf("Expected events outside the pause window to be recorded, got %v", counts)
+	}
+	if counts["audit_pause_started"] != 1 {
+		t.Errorf("Expected a single audit_pause_started event, got %v", counts)
+	}
+	if counts["audit_paused"] != 1 {
+		t.Fatalf("Expected a single bracketing audit_paused event, got %v", counts)
+	}
+	if bracket.Context["reason"] != "bulk migration" {
+		t.Errorf("Expected pause reason in bracketing event, got %v", bracket.Context)
+	}
+	if fmt.Sprint(bracket.Context["suppressed_events"]) != "6" {
+		t.Errorf("Expected 6 suppressed events, got %v", bracket.Context["suppressed_events"])
+	}
+}
+
+func TestWatcherSuspendAudit_Nested(t *testing.T) {
+	watcher := New(Config{
+		PollInterval: 50 * time.Millisecond,
+		Audit: AuditConfig{
+			Enabled:    true,
+			OutputFile: filepath.Join(t.TempDir(), "audit.db"),
+			MinLevel:   AuditInfo,
+			BufferSize: 100,
+		},
+	})
+	defer func() { _ = watcher.Close() }()
+
+	resumeOuter := watcher.SuspendAudit("outer migration")
+	resumeInner := watcher.SuspendAudit("inner step")
+
+	// The inner call did not open the pause and must not end it
+	resumeInner()
+	if !watcher.auditLogger.IsPaused() {
+		t.Fatal("Expected nested resume to leave the outer pause active")
+	}
+
+	resumeOuter()
+	if watcher.auditLogger.IsPaused() {
+		t.Fatal("Expected outer resume to end the pause")
+	}
+
+	// Resume functions are idempotent and cannot end a later pause
+	watcher.auditLogger.Pause("later")
+	resumeOuter()
+	if !watcher.auditLogger.IsPaused() {
+		t.Error("Expected a stale resume to leave a later pause active")
+	}
+	watcher.auditLogger.Resume()
+}
+
+func TestAuditLoggerConfigChangeWithDiff(t *testing.T) {
+	auditor, err := NewAuditLogger(AuditConfig{
+		Enabled:    true,
+		OutputFile: filepath.Join(t.TempDir(), "audit.db"),
+		MinLevel:   AuditInfo,
+		BufferSize: 100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := auditor.Close(); err != nil {
+			t.Errorf("Failed to close auditor: %v", err)
+		}
+	}()
+
+	oldConfig := map[string]interface{}{
+		"name": "app",
+		"database": map[string]interface{}{
+			"host": "db1",
+			"pool": map[string]interface{}{"max": 10, "min": 1},
+		},
+		"features": []interface{}{"a", "b"},
+		"cache":    map[string]interface{}{"ttl": 30},
+		"legacy":   true,
+	}
+	newConfig := map[string]interface{}{
+		"name": "app",
+		"database": map[string]interface{}{
+			"host": "db2",
+			"pool": map[string]interface{}{"max": 10, "min": 1, "idle": 5},
+		},
+		"features": []interface{}{"a", "b"},
+		"cache":    "disabled", // Type change: map to string
+		"debug":    true,
+	}
+	auditor.LogConfigChangeWithDiff("/config/app.json", oldConfig, newConfig)
+	if err := auditor.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+
+	events, err := auditor.Query(AuditEventFilter{EventPrefix: "config_change"})
+	if err != nil {
+		t.Fatalf("Failed to query audit trail: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Expected one config_change event, got %d", len(events))
+	}
+	event := events[0]
+
+	oldValues, _ := event.OldValue.(map[string]interface{})
+	newValues, _ := event.NewValue.(map[string]interface{})
+	expectedOld := map[string]interface{}{"database.host": "db1", "cache": map[string]interface{}{"ttl": float64(30)}, "legacy": true}
+	expectedNew := map[string]interface{}{"database.host": "db2", "database.pool.idle": float64(5), "cache": "disabled", "debug": true}
+	if fmt.Sprint(oldValues) != fmt.Sprint(expectedOld) {
+		t.Errorf("Expected old values %v, got %v", expectedOld, oldValues)
+	}
+	if fmt.Sprint(newValues) != fmt.Sprint(expectedNew) {
+		t.Errorf("Expected new values %v, got %v", expectedNew, newValues)
+	}
+	for key, want := range map[string]string{"added": "2", "removed": "1", "changed": "2"} {
+		if got := fmt.Sprint(event.Context[key]); got != want {
+			t.Errorf("Expected %s count %s, got %s", key, want, got)
+		}
+	}
+}
+
+func TestAuditLoggerComponentLevels(t *testing.T) {
+	sink := &recordingSink{}
+	levels := map[string]AuditLevel{"poller": AuditWarn}
+	auditor, err := NewAuditLogger(AuditConfig{
+		Enabled:         true,
+		OutputFile:      filepath.Join(t.TempDir(), "audit.jsonl"),
+		MinLevel:        AuditInfo,
+		BufferSize:      100,
+		ComponentLevels: levels,
+		Sinks:           []AuditSink{sink},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := auditor.Close(); err != nil {
+			t.Errorf("Failed to close auditor: %v", err)
+		}
+	}()
+
+	// Writes to the caller's map after construction do not affect the logger
+	levels["poller"] = AuditInfo
+
+	auditor.Log(AuditInfo, "poll_tick", "poller", "", nil, nil, nil)
+	auditor.Log(AuditWarn, "poll_slow", "poller", "", nil, nil, nil)
+	auditor.Log(AuditSecurity, "poll_denied", "poller", "", nil, nil, nil)
+	auditor.Log(AuditInfo, "request", "api", "", nil, nil, nil)
+	if err := auditor.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+
+	var got []string
+	for _, e := range sink.events {
+		got = append(got, e.Event)
+	}
+	if fmt.Sprint(got) != "[poll_slow poll_denied request]" {
+		t.Errorf("Expected info events from poller to be filtered only, got %v", got)
+	}
+}
@@ -7,9 +7,12 @@
 package argus
 
 import (
+	"context"
 	"runtime"
 	"sync/atomic"
 	"time"
+
+	"github.com/agilira/go-errors"
 )
 
 // FileChangeEvent represents a file change optimized for minimal memory footprint
@@ -39,7 +42,7 @@ type FileChangeEvent struct {
 	Size    int64     // File size (8 bytes)
 	Path    [110]byte // FULL POWER: 110 bytes for any file path (109 chars + null terminator)
 	PathLen uint8     // Actual path length (1 byte)
-	Flags   uint8     // Create(1), Delete(2), Modify(4) bits (1 byte)
+	Flags   uint8     // Create(1), Delete(2), Modify(4), Rename(8) bits (1 byte)
 	// Total: 8+8+110+1+1 = 128 bytes exactly with proper alignment
 }
 
@@ -48,6 +51,7 @@ const (
 	FileEventCreate uint8 = 1 << iota
 	FileEventDelete
 	FileEventModify
+	FileEventRename
 )
 
 // BoreasLite - Ultra-fast MPSC ring buffer for file watching
@@ -104,6 +108,24 @@ type BoreasLite struct {
 	// Ultra-simple stats (just counters)
 	processed atomic.Int64
 	dropped   atomic.Int64
+
+	// Capacity planning, see boreaslite_metrics.go
+	maxOccupancy  atomic.Int64
+	droppedBase   atomic.Int64
+	processedBase atomic.Int64
+
+	// Adaptive capacity, see EnableAdaptiveCapacity
+	adaptive        bool
+	minCapacity     int64
+	maxCapacity     int64
+	shrinkIdle      time.Duration
+	resizing        atomic.Bool  // Set while the consumer swaps buffers
+	writers         atomic.Int64 // Producers inside writeAdaptive
+	currentCapacity atomic.Int64 // capacity, readable outside the consumer
+	resizes         atomic.Int64
+	pressure        int   // Consecutive batches under pressure (consumer only)
+	lastDropped     int64 // dropped at the previous batch (consumer only)
+	lastBusy        int64 // monoNow of the last non-empty batch (consumer only)
 }
 
 // NewBoreasLite creates a new ultra-fast ring buffer for file events
@@ -151,6 +173,7 @@ func NewBoreasLite(capacity int64, strategy OptimizationStrategy, processor func
 	for i := range b.availableBuffer {
 		b.availableBuffer[i].Store(-1)
 	}
+	b.currentCapacity.Store(capacity)
 
 	b.running.Store(true)
 	return b
@@ -196,13 +219,13 @@ func (b *BoreasLite) WriteFileEvent(event *FileChangeEvent) bool {
 		b.dropped.Add(1)
 		return false
 	}
+	if b.adaptive {
+		return b.writeAdaptive(event)
+	}
 
 	// MPSC: Claim sequence atomically
-	sequence := b.writerCursor.Add(1) - 1
-
-	// Check buffer full (file events should NEVER be dropped, but safety check)
-	if sequence >= b.readerCursor.Load()+b.capacity {
-		b.dropped.Add(1)
+	sequence, ok := b.claimSequence()
+	if !ok {
 		return false
 	}
 
@@ -216,6 +239,26 @@ func (b *BoreasLite) WriteFileEvent(event *FileChangeEvent) bool {
 	return true
 }
 
+// claimSequence reserves the next slot for a writer. A slot is claimed only
+// while the ring has room: claiming first and checking afterwards would
+// leave a sequence that is never written, stalling the consumer for good.
+// A full ring counts a dropped event instead.
+func (b *BoreasLite) claimSequence() (int64, bool) {
+	for {
+		sequence := b.writerCursor.Load()
+		reader := b.readerCursor.Load()
+		// Check buffer full (file events should NEVER be dropped, but safety check)
+		if sequence >= reader+b.capacity {
+			b.dropped.Add(1)
+			return 0, false
+		}
+		if b.writerCursor.CompareAndSwap(sequence, sequence+1) {
+			b.noteOccupancy(sequence, reader)
+			return sequence, true
+		}
+	}
+}
+
 // WriteFileChange is a convenience method for creating events from parameters.
 // Slightly slower than WriteFileEvent but more convenient for direct parameter usage.
 // Automatically handles path length limits and flag setting.
@@ -231,9 +274,25 @@ func (b *BoreasLite) WriteFileEvent(event *FileChangeEvent) bool {
 // Returns:
 //   - bool: true if event was successfully queued, false if buffer is full
 func (b *BoreasLite) WriteFileChange(path string, modTime time.Time, size int64, isCreate, isDelete, isModify bool) bool {
+	var flags uint8
+	if isCreate {
+		flags |= FileEventCreate
+	}
+	if isDelete {
+		flags |= FileEventDelete
+	}
+	if isModify {
+		flags |= FileEventModify
+	}
+	return b.writeFileChangeFlags(path, modTime, size, flags)
+}
+
+// writeFileChangeFlags is WriteFileChange with the event flags given as bits
+func (b *BoreasLite) writeFileChangeFlags(path string, modTime time.Time, size int64, flags uint8) bool {
 	event := FileChangeEvent{
-		ModTime: modTime.UnixNano(),
+		ModTime: eventModTime(modTime),
 		Size:    size,
+		Flags:   flags,
 	}
 
 	// Copy path with bounds checking
@@ -246,17 +305,6 @@ func (b *BoreasLite) WriteFileChange(path string, modTime time.Time, size int64,
 	// Safe conversion: copyLen is guaranteed <= 109 (fits in uint8)
 	event.PathLen = uint8(copyLen) // #nosec G115 -- bounds checked above, copyLen <= 109
 
-	// Set flags
-	if isCreate {
-		event.Flags |= FileEventCreate
-	}
-	if isDelete {
-		event.Flags |= FileEventDelete
-	}
-	if isModify {
-		event.Flags |= FileEventModify
-	}
-
 	return b.WriteFileEvent(&event)
 }
 
@@ -266,6 +314,10 @@ func (b *BoreasLite) WriteFileChange(path string, modTime time.Time, size int64,
 // Returns:
 //   - int: Number of events processed
 func (b *BoreasLite) ProcessBatch() int {
+	if b.adaptive {
+		b.adaptCapacity()
+	}
+
 	current := b.readerCursor.Load()
 	writerPos := b.writerCursor.Load()
 
@@ -669,6 +721,30 @@ func (b *BoreasLite) Stop() {
 	b.running.Store(false)
 }
 
+// Flush blocks until every event written before the call has been processed,
+// or returns an ErrCodeFlushTimeout error once timeout elapses. Events written
+// during the flush are not waited for, so a busy producer cannot hold it
+// forever. The processor must be running: a stopped ring is never drained.
+func (b *BoreasLite) Flush(timeout time.Duration) error {
+	if timeout <= 0 {
+		return errors.New(ErrCodeInvalidConfig, "flush timeout must be positive")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return b.flush(ctx)
+}
+
+// flush is Flush bounded by ctx. The returned error wraps ctx.Err().
+func (b *BoreasLite) flush(ctx context.Context) error {
+	target := b.writerCursor.Load()
+	err := waitUntil(ctx, func() bool { return b.readerCursor.Load() >= target })
+	if err != nil {
+		return errors.Wrap(err, ErrCodeFlushTimeout, "event ring not drained before timeout").
+			WithContext("pending", target-b.readerCursor.Load())
+	}
+	return nil
+}
+
 // Stats returns minimal statistics for monitoring ring buffer performance.
 // Provides real-time metrics for debugging and performance analysis.
 //
@@ -677,8 +753,10 @@ func (b *BoreasLite) Stop() {
 //   - reader_position: Current reader sequence number
 //   - buffer_size: Ring buffer capacity
 //   - items_buffered: Number of events waiting to be processed
-//   - items_processed: Total events processed since startup
-//   - items_dropped: Total events dropped due to buffer overflow
+//   - items_processed: Events processed since startup or ResetStats
+//   - items_dropped: Events dropped due to buffer overflow since startup or ResetStats
+//   - max_occupancy: Highest number of buffered events since startup or ResetStats
+//   - resizes: Capacity changes made by adaptive capacity
 //   - running: 1 if processor is running, 0 if stopped
 func (b *BoreasLite) Stats() map[string]int64 {
 	writerPos := b.writerCursor.Load()
@@ -687,10 +765,12 @@ func (b *BoreasLite) Stats() map[string]int64 {
 	return map[string]int64{
 		"writer_position": writerPos,
 		"reader_position": readerPos,
-		"buffer_size":     b.capacity,
+		"buffer_size":     b.Capacity(),
 		"items_buffered":  writerPos - readerPos,
-		"items_processed": b.processed.Load(),
-		"items_dropped":   b.dropped.Load(),
+		"items_processed": b.TotalProcessed(),
+		"items_dropped":   b.DroppedEvents(),
+		"max_occupancy":   b.MaxOccupancy(),
+		"resizes":         b.resizes.Load(),
 		"running":         boolToInt64(b.running.Load()),
 	}
 }
@@ -700,7 +780,7 @@ func (b *BoreasLite) Stats() map[string]int64 {
 // Handles path truncation and flag conversion automatically.
 func ConvertChangeEventToFileEvent(event ChangeEvent) FileChangeEvent {
 	fileEvent := FileChangeEvent{
-		ModTime: event.ModTime.UnixNano(),
+		ModTime: eventModTime(event.ModTime),
 		Size:    event.Size,
 	}
 
@@ -724,6 +804,9 @@ func ConvertChangeEventToFileEvent(event ChangeEvent) FileChangeEvent {
 	if !event.IsCreate && !event.IsDelete {
 		fileEvent.Flags |= FileEventModify
 	}
+	if event.IsRename {
+		fileEvent.Flags |= FileEventRename
+	}
 
 	return fileEvent
 }
@@ -734,12 +817,30 @@ func ConvertChangeEventToFileEvent(event ChangeEvent) FileChangeEvent {
 func ConvertFileEventToChangeEvent(fileEvent FileChangeEvent) ChangeEvent {
 	return ChangeEvent{
 		Path:     string(fileEvent.Path[:fileEvent.PathLen]),
-		ModTime:  time.Unix(0, fileEvent.ModTime),
+		ModTime:  changeModTime(fileEvent.ModTime),
 		Size:     fileEvent.Size,
 		IsCreate: (fileEvent.Flags & FileEventCreate) != 0,
 		IsDelete: (fileEvent.Flags & FileEventDelete) != 0,
 		IsModify: (fileEvent.Flags & FileEventModify) != 0,
+		IsRename: (fileEvent.Flags & FileEventRename) != 0,
+	}
+}
+
+// eventModTime encodes a modification time as Unix nanoseconds. The zero
+// time of delete events, which UnixNano cannot represent, is encoded as 0.
+func eventModTime(modTime time.Time) int64 {
+	if modTime.IsZero() {
+		return 0
+	}
+	return modTime.UnixNano()
+}
+
+// changeModTime decodes a modification time encoded by eventModTime
+func changeModTime(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
 	}
+	return time.Unix(0, nanos)
 }
 
 // minInt64 returns the smaller of two int64 values.
@@ -0,0 +1,164 @@
+// boreaslite_adaptive.go: Opt-in capacity growth for BoreasLite
+//
+// A fixed ring sized for the steady state drops events when an orchestrator
+// rewrites many files at once, while a ring sized for the worst burst wastes
+// memory the rest of the time. With adaptive capacity the consumer doubles
+// the ring under sustained pressure, up to a maximum, and returns to the
+// initial size after a quiet period.
+//
+// Resizing needs the producers out of the way. In adaptive mode every write
+// registers itself in a counter; the consumer raises a flag, waits for the
+// counter to reach zero, moves what is buffered out and swaps the buffers.
+// Writers arriving meanwhile yield until the flag drops. The moved events
+// are delivered only after the flag dropped, so a callback that writes to
+// the ring (e.g. through Reload) cannot wait on its own consumer. Rings
+// without adaptive capacity never take this path.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultBoreasLiteMaxCapacity is the adaptive ceiling when
+	// Config.BoreasLiteMaxCapacity is unset
+	defaultBoreasLiteMaxCapacity = 4096
+
+	// adaptiveGrowAfter is how many consecutive batches must find the ring
+	// at least 3/4 full, or new drops, before its capacity doubles
+	adaptiveGrowAfter = 3
+
+	// adaptiveShrinkIdle is how long a grown ring must stay empty before it
+	// returns to its initial capacity
+	adaptiveShrinkIdle = 30 * time.Second
+)
+
+// EnableAdaptiveCapacity lets the ring double its capacity under sustained
+// backpressure, up to maxCapacity, and shrink back to its initial capacity
+// once idle. It must be called before the ring is used. A maxCapacity that
+// is not a power of 2 is rounded down to one; a maxCapacity not above the
+// current capacity disables growth.
+func (b *BoreasLite) EnableAdaptiveCapacity(maxCapacity int64) {
+	for maxCapacity&(maxCapacity-1) != 0 {
+		maxCapacity &= maxCapacity - 1 // Clear the lowest set bit
+	}
+	if maxCapacity <= b.capacity {
+		return
+	}
+	b.adaptive = true
+	b.minCapacity = b.capacity
+	b.maxCapacity = maxCapacity
+	b.shrinkIdle = adaptiveShrinkIdle
+	b.lastBusy = monoNow()
+}
+
+// Capacity returns the current capacity of the ring
+func (b *BoreasLite) Capacity() int64 {
+	return b.currentCapacity.Load()
+}
+
+// Resizes returns how many times adaptive capacity resized the ring
+func (b *BoreasLite) Resizes() int64 {
+	return b.resizes.Load()
+}
+
+// writeAdaptive is WriteFileEvent for rings with adaptive capacity: the slot
+// is claimed and written while no resize is in progress.
+func (b *BoreasLite) writeAdaptive(event *FileChangeEvent) bool {
+	for {
+		b.writers.Add(1)
+		if !b.resizing.Load() {
+			break
+		}
+		b.writers.Add(-1)
+		runtime.Gosched()
+	}
+	defer b.writers.Add(-1)
+
+	sequence, ok := b.claimSequence()
+	if !ok {
+		return false
+	}
+
+	b.buffer[sequence&b.mask] = *event
+	b.availableBuffer[sequence&b.mask].Store(sequence)
+	return true
+}
+
+// adaptCapacity grows or shrinks the ring according to recent pressure.
+// Called by the consumer before each batch.
+func (b *BoreasLite) adaptCapacity() {
+	occupancy := b.writerCursor.Load() - b.readerCursor.Load()
+	dropped := b.dropped.Load()
+	newDrops := dropped != b.lastDropped
+	b.lastDropped = dropped
+
+	if newDrops || occupancy >= b.capacity*3/4 {
+		b.pressure++
+		b.lastBusy = monoNow()
+		if b.pressure >= adaptiveGrowAfter && b.capacity < b.maxCapacity {
+			b.pressure = 0
+			b.resize(b.capacity * 2)
+		}
+		return
+	}
+	b.pressure = 0
+
+	if b.capacity == b.minCapacity {
+		return
+	}
+	if occupancy > 0 {
+		b.lastBusy = monoNow()
+	} else if time.Duration(monoNow()-b.lastBusy) >= b.shrinkIdle {
+		b.resize(b.minCapacity)
+	}
+}
+
+// resize replaces the ring buffers with ones of newCapacity, then delivers
+// the events buffered before the swap in order. Called by the consumer only.
+func (b *BoreasLite) resize(newCapacity int64) {
+	b.resizing.Store(true)
+	for b.writers.Load() != 0 {
+		runtime.Gosched()
+	}
+
+	// No writer is active: every claimed slot has been written
+	readerPos, writerPos := b.readerCursor.Load(), b.writerCursor.Load()
+	moved := make([]FileChangeEvent, 0, writerPos-readerPos)
+	for seq := readerPos; seq < writerPos; seq++ {
+		moved = append(moved, b.buffer[seq&b.mask])
+	}
+	b.swapBuffers(newCapacity)
+	b.resizing.Store(false)
+
+	// New writes land at writerPos and beyond; the reader cursor stays behind
+	// the moved events until they are delivered, so they keep their slots in
+	// the capacity accounting and Flush waits for them
+	for i := range moved {
+		b.processor(&moved[i])
+		b.processed.Add(1)
+	}
+	b.readerCursor.Store(writerPos)
+}
+
+// swapBuffers installs empty buffers of newCapacity. Called with writers
+// held off by the resizing flag.
+func (b *BoreasLite) swapBuffers(newCapacity int64) {
+	available := make([]atomic.Int64, newCapacity)
+	for i := range available {
+		available[i].Store(-1)
+	}
+	b.buffer = make([]FileChangeEvent, newCapacity)
+	b.availableBuffer = available
+	b.capacity = newCapacity
+	b.mask = newCapacity - 1
+	b.currentCapacity.Store(newCapacity)
+	b.resizes.Add(1)
+}
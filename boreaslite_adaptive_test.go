@@ -0,0 +1,208 @@
+// boreaslite_adaptive_test.go: Tests for adaptive BoreasLite capacity
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fillRing writes events until the ring rejects one
+func fillRing(b *BoreasLite, start int) int {
+	n := start
+	for b.WriteFileChange(fmt.Sprintf("/tmp/adaptive-%d.json", n), time.Now(), 1, false, false, true) {
+		n++
+	}
+	return n
+}
+
+func TestBoreasLiteAdaptiveGrowAndShrink(t *testing.T) {
+	var delivered []string
+	b := NewBoreasLite(8, OptimizationSmallBatch, func(e *FileChangeEvent) {
+		delivered = append(delivered, string(e.Path[:e.PathLen]))
+	})
+	b.EnableAdaptiveCapacity(32)
+
+	// Keep the ring full across consecutive batches without draining it
+	next := 0
+	for i := 0; i < adaptiveGrowAfter; i++ {
+		next = fillRing(b, next)
+		b.adaptCapacity()
+	}
+	if got := b.Capacity(); got != 16 {
+		t.Fatalf("Expected capacity to double to 16 under pressure, got %d", got)
+	}
+	if got := len(delivered); got != 8 {
+		t.Fatalf("Expected the 8 buffered events to be delivered on resize, got %d", got)
+	}
+	for i, path := range delivered {
+		if want := fmt.Sprintf("/tmp/adaptive-%d.json", i); path != want {
+			t.Fatalf("Expected events in order, got %s at %d", path, i)
+		}
+	}
+
+	// Growth stops at the maximum
+	for i := 0; i < 4*adaptiveGrowAfter; i++ {
+		next = fillRing(b, next)
+		b.adaptCapacity()
+	}
+	if got := b.Capacity(); got != 32 {
+		t.Fatalf("Expected capacity to stop at the 32 maximum, got %d", got)
+	}
+
+	// Idle long enough and the ring returns to its initial size
+	for b.ProcessBatch() > 0 {
+	}
+	b.shrinkIdle = time.Millisecond
+	b.adaptCapacity() // Clears the pressure left by the drops above
+	time.Sleep(5 * time.Millisecond)
+	b.adaptCapacity()
+	if got := b.Capacity(); got != 8 {
+		t.Fatalf("Expected capacity to shrink back to 8 when idle, got %d", got)
+	}
+
+	stats := b.Stats()
+	if stats["resizes"] != 3 {
+		t.Errorf("Expected 3 resizes (8->16->32->8), got %d", stats["resizes"])
+	}
+	if stats["buffer_size"] != 8 {
+		t.Errorf("Expected buffer_size 8, got %d", stats["buffer_size"])
+	}
+}
+
+func TestBoreasLiteAdaptiveConcurrentWriters(t *testing.T) {
+	var received atomic.Int64
+	b := NewBoreasLite(8, OptimizationSmallBatch, func(*FileChangeEvent) {
+		received.Add(1)
+	})
+	b.EnableAdaptiveCapacity(256)
+	b.shrinkIdle = time.Millisecond
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.RunProcessor()
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				b.WriteFileChange(fmt.Sprintf("/tmp/writer-%d.json", w), time.Now(), int64(i), false, false, true)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for b.readerCursor.Load() < b.writerCursor.Load() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	b.Stop()
+	<-done
+
+	written := b.writerCursor.Load()
+	if got := received.Load(); got != written {
+		t.Errorf("Expected every accepted event to be delivered: written %d, received %d", written, got)
+	}
+	if got := received.Load() + b.dropped.Load(); got != 16000 {
+		t.Errorf("Expected delivered plus dropped to equal 16000 writes, got %d", got)
+	}
+}
+
+func TestAdaptiveCapacityConfig(t *testing.T) {
+	config := (&Config{AdaptiveCapacity: true, BoreasLiteCapacity: 64}).WithDefaults()
+	if config.BoreasLiteMaxCapacity != defaultBoreasLiteMaxCapacity {
+		t.Errorf("Expected default max capacity %d, got %d", defaultBoreasLiteMaxCapacity, config.BoreasLiteMaxCapacity)
+	}
+
+	bad := (&Config{AdaptiveCapacity: true, BoreasLiteCapacity: 64, BoreasLiteMaxCapacity: 32}).WithDefaults()
+	if err := bad.Validate(); err != ErrBoreasMaxCapacity {
+		t.Errorf("Expected ErrBoreasMaxCapacity for a max below the capacity, got %v", err)
+	}
+
+	watcher := New(Config{AdaptiveCapacity: true, BoreasLiteCapacity: 64, BoreasLiteMaxCapacity: 256})
+	defer watcher.Close()
+	stats := watcher.Stats()
+	if stats.RingCapacity != 64 || stats.RingResizes != 0 {
+		t.Errorf("Expected ring capacity 64 with no resizes, got %d/%d", stats.RingCapacity, stats.RingResizes)
+	}
+	if !watcher.eventRing.adaptive || watcher.eventRing.maxCapacity != 256 {
+		t.Errorf("Expected adaptive ring with max 256, got %v/%d", watcher.eventRing.adaptive, watcher.eventRing.maxCapacity)
+	}
+}
+
+func TestBoreasLiteAdaptiveResizeCallbackReload(t *testing.T) {
+	configPath, err := filepath.Abs(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{
+		PollInterval:          time.Hour,
+		BoreasLiteCapacity:    8,
+		AdaptiveCapacity:      true,
+		BoreasLiteMaxCapacity: 32,
+		DisableAudit:          true,
+	})
+	var delivered int
+	var reloadErr error
+	if err := watcher.Watch(configPath, func(ChangeEvent) {
+		delivered++
+		if delivered == 1 {
+			// Writes to the ring from inside the resize that delivers this event
+			if err := os.WriteFile(configPath, []byte(`{"v": 22}`), 0600); err != nil {
+				t.Errorf("Failed to update config file: %v", err)
+			}
+			reloadErr = watcher.Reload()
+		}
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	// The test drives the consumer itself; Reload only needs a running watcher
+	watcher.running.Store(true)
+	defer watcher.running.Store(false)
+
+	ring := watcher.eventRing
+	for ring.WriteFileChange(configPath, time.Now(), 1, false, false, true) {
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < adaptiveGrowAfter; i++ {
+			ring.adaptCapacity()
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the resize to complete while a callback reloads the watcher")
+	}
+
+	if reloadErr != nil {
+		t.Fatalf("Failed to reload from a callback: %v", reloadErr)
+	}
+	if got := ring.Capacity(); got != 16 {
+		t.Errorf("Expected capacity to double to 16, got %d", got)
+	}
+	for ring.ProcessBatch() > 0 {
+	}
+	if delivered != 9 {
+		t.Errorf("Expected the 8 buffered events and the reloaded change to be delivered, got %d", delivered)
+	}
+}
@@ -0,0 +1,56 @@
+// boreaslite_metrics.go: Capacity planning counters for BoreasLite
+//
+// Dropped events and the high-water mark tell whether BoreasLiteCapacity
+// fits the workload: drops mean callbacks fell behind a full ring, and a
+// high-water mark close to the capacity means they nearly did. The raw
+// counters keep growing for the watcher's own bookkeeping (saturation
+// warnings, adaptive capacity); ResetStats moves a baseline instead.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// noteOccupancy raises the high-water mark to the occupancy seen by the
+// writer that claimed sequence. The common case is a single load.
+func (b *BoreasLite) noteOccupancy(sequence, reader int64) {
+	occupancy := sequence - reader + 1
+	for {
+		peak := b.maxOccupancy.Load()
+		if occupancy <= peak || b.maxOccupancy.CompareAndSwap(peak, occupancy) {
+			return
+		}
+	}
+}
+
+// DroppedEvents returns the number of events dropped because the ring was
+// full or stopped, since creation or the last ResetStats
+func (b *BoreasLite) DroppedEvents() int64 {
+	return b.dropped.Load() - b.droppedBase.Load()
+}
+
+// TotalProcessed returns the number of events delivered to the processor,
+// since creation or the last ResetStats
+func (b *BoreasLite) TotalProcessed() int64 {
+	return b.processed.Load() - b.processedBase.Load()
+}
+
+// MaxOccupancy returns the highest number of buffered events observed by a
+// writer, since creation or the last ResetStats
+func (b *BoreasLite) MaxOccupancy() int64 {
+	return b.maxOccupancy.Load()
+}
+
+// Buffered returns the number of events currently waiting to be processed
+func (b *BoreasLite) Buffered() int64 {
+	return max(b.writerCursor.Load()-b.readerCursor.Load(), 0)
+}
+
+// ResetStats restarts DroppedEvents, TotalProcessed and MaxOccupancy from
+// zero. Counts racing with the reset land on either side of it.
+func (b *BoreasLite) ResetStats() {
+	b.droppedBase.Store(b.dropped.Load())
+	b.processedBase.Store(b.processed.Load())
+	b.maxOccupancy.Store(0)
+}
@@ -7,12 +7,16 @@
 package argus
 
 import (
+	"context"
+	goerrors "errors"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 	"unsafe"
 
+	"github.com/agilira/go-errors"
 	"github.com/agilira/go-timecache"
 )
 
@@ -314,3 +318,46 @@ func TestConvertChangeEventToFileEvent(t *testing.T) {
 		t.Error("Create flag not set")
 	}
 }
+
+// TestBoreasLiteFlush verifies Flush waits for queued events and times out
+// when the processor cannot keep up
+func TestBoreasLiteFlush(t *testing.T) {
+	var processed atomic.Int64
+	release := make(chan struct{})
+	b := NewBoreasLite(64, OptimizationSmallBatch, func(*FileChangeEvent) {
+		<-release
+		time.Sleep(time.Millisecond)
+		processed.Add(1)
+	})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.RunProcessor()
+	}()
+	defer func() {
+		b.Stop()
+		<-done
+	}()
+
+	for i := 0; i < 5; i++ {
+		b.WriteFileChange("/tmp/flush.json", time.Now(), int64(i), false, false, true)
+	}
+
+	// The processor is blocked, so the flush must time out
+	err := b.Flush(20 * time.Millisecond)
+	if !errors.HasCode(err, ErrCodeFlushTimeout) || !goerrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a flush timeout wrapping DeadlineExceeded, got %v", err)
+	}
+
+	close(release)
+	if err := b.Flush(2 * time.Second); err != nil {
+		t.Fatalf("Failed to flush queued events: %v", err)
+	}
+	if got := processed.Load(); got != 5 {
+		t.Errorf("Expected all 5 events processed after Flush, got %d", got)
+	}
+
+	if err := b.Flush(0); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected invalid config error for a zero timeout, got %v", err)
+	}
+}
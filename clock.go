@@ -0,0 +1,34 @@
+// clock.go: Clock-jump tolerant time arithmetic
+//
+// go-timecache and time.Unix values carry wall-clock time only. When the
+// system clock jumps (NTP step, VM suspend/resume) differences between two
+// such values can turn negative or huge. Intervals that drive behavior (cache
+// expiry, stall detection) therefore use the monotonic clock or treat
+// negative differences as a clock jump; wall time is kept for timestamps
+// shown to users and written to the audit trail.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "time"
+
+// monoEpoch anchors monoNow; time.Since on it reads the monotonic clock
+var monoEpoch = time.Now()
+
+// monoNow returns nanoseconds elapsed on the monotonic clock since the
+// package was initialized. Unaffected by wall-clock jumps.
+func monoNow() int64 {
+	return int64(time.Since(monoEpoch))
+}
+
+// wallElapsed returns now - since for wall-clock nanosecond timestamps,
+// reporting false when the clock went backwards in between
+func wallElapsed(now, since int64) (time.Duration, bool) {
+	if now < since {
+		return 0, false
+	}
+	return time.Duration(now - since), true
+}
@@ -0,0 +1,70 @@
+// clock_test.go: Tests for clock-jump tolerant time arithmetic
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agilira/go-timecache"
+)
+
+func TestFileStatIsExpired_ClockJump(t *testing.T) {
+	ttl := time.Second
+	now := timecache.CachedTimeNano()
+
+	tests := []struct {
+		name     string
+		cachedAt int64
+		want     bool
+	}{
+		{"fresh entry", now, false},
+		{"entry older than ttl", now - int64(2*time.Second), true},
+		// Stamped before the clock jumped back an hour: without handling it
+		// would stay cached, hiding file changes, for the next hour
+		{"clock jumped backwards", now + int64(time.Hour), true},
+		// Stamped before the clock jumped forward: expiring early only costs a stat
+		{"clock jumped forwards", now - int64(time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stat := fileStat{cachedAt: tt.cachedAt}
+			if got := stat.isExpired(ttl); got != tt.want {
+				t.Errorf("isExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetCacheStats_ClockJumpClampsAges(t *testing.T) {
+	watcher := New(Config{DisableAudit: true})
+	defer watcher.Close()
+
+	watcher.updateCache("/tmp/future.json", fileStat{cachedAt: timecache.CachedTimeNano() + int64(time.Hour)})
+
+	stats := watcher.GetCacheStats()
+	if stats.OldestAge < 0 || stats.NewestAge < 0 {
+		t.Errorf("Expected non-negative cache ages after a backward clock jump, got %+v", stats)
+	}
+}
+
+func TestWatcherHealth_IgnoresWallClockJump(t *testing.T) {
+	watcher := New(Config{PollInterval: time.Second, DisableAudit: true})
+	defer watcher.Close()
+
+	watcher.running.Store(true)
+	defer watcher.running.Store(false)
+	watcher.markPoll()
+
+	// The wall clock jumped forward a day right after the last poll
+	watcher.lastPoll.Store(time.Now().Add(-24 * time.Hour).UnixNano())
+
+	if h := watcher.Health(); !h.Healthy || h.SinceLastPoll > time.Second {
+		t.Errorf("Expected a healthy report unaffected by the wall-clock jump, got %+v", h)
+	}
+}
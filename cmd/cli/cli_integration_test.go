@@ -810,3 +810,56 @@ func TestCLI_PathSecurity(t *testing.T) {
 		}
 	})
 }
+
+// TestCLI_Stdin tests "-" as input for validate and convert
+func TestCLI_Stdin(t *testing.T) {
+	fixture := NewCLITestFixture(t)
+	defer fixture.Cleanup()
+
+	// withStdin replaces os.Stdin with a pipe holding content
+	withStdin := func(t *testing.T, content string, fn func()) {
+		t.Helper()
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatalf("Failed to create pipe: %v", err)
+		}
+		if _, err := w.WriteString(content); err != nil {
+			t.Fatalf("Failed to write to pipe: %v", err)
+		}
+		_ = w.Close()
+
+		oldStdin := os.Stdin
+		os.Stdin = r
+		defer func() {
+			os.Stdin = oldStdin
+			_ = r.Close()
+		}()
+		fn()
+	}
+
+	t.Run("validate_sniffed_json", func(t *testing.T) {
+		withStdin(t, `{"app": {"name": "piped"}}`, func() {
+			if _, err := fixture.RunCLI("config", "validate", "-"); err != nil {
+				t.Errorf("Validate from stdin should work: %v", err)
+			}
+		})
+	})
+
+	t.Run("validate_invalid", func(t *testing.T) {
+		withStdin(t, `{"app": broken}`, func() {
+			if _, err := fixture.RunCLI("config", "validate", "-", "--format", "json"); err == nil {
+				t.Error("Validate should fail for invalid stdin content")
+			}
+		})
+	})
+
+	t.Run("convert_yaml_to_json", func(t *testing.T) {
+		jsonPath := filepath.Join(fixture.tempDir, "piped.json")
+		withStdin(t, "app:\n  name: piped\n", func() {
+			if _, err := fixture.RunCLI("config", "convert", "-", jsonPath, "--from", "yaml"); err != nil {
+				t.Fatalf("Convert from stdin should work: %v", err)
+			}
+		})
+		fixture.AssertFileContains(jsonPath, "piped")
+	})
+}
@@ -210,7 +210,6 @@ func (m *Manager) handleConfigList(ctx *orpheus.Context) error {
 func (m *Manager) handleConfigConvert(ctx *orpheus.Context) error {
 	inputPath := ctx.GetArg(0)
 	outputPath := ctx.GetArg(1)
-	fromFormat := m.detectFormat(inputPath, ctx.GetFlagString("from"))
 	toFormat := m.detectFormat(outputPath, ctx.GetFlagString("to"))
 
 	// Audit command execution (optional)
@@ -218,8 +217,8 @@ func (m *Manager) handleConfigConvert(ctx *orpheus.Context) error {
 		m.auditLogger.LogFileWatch("cli_config_convert", inputPath)
 	}
 
-	// Load input configuration
-	config, err := m.loadConfig(inputPath, fromFormat)
+	// Load input configuration ("-" reads stdin)
+	config, fromFormat, err := m.loadInput(inputPath, ctx.GetFlagString("from"))
 	if err != nil {
 		return errors.Wrap(err, argus.ErrCodeIOError, "failed to load input configuration")
 	}
@@ -252,9 +251,8 @@ func (m *Manager) handleConfigConvert(ctx *orpheus.Context) error {
 func (m *Manager) handleConfigValidate(ctx *orpheus.Context) error {
 	filePath := ctx.GetArg(0)
 
-	// Detect format and attempt to parse
-	format := m.detectFormat(filePath, ctx.GetFlagString("format"))
-	_, err := m.loadConfig(filePath, format)
+	// Detect format and attempt to parse ("-" reads stdin)
+	_, format, err := m.loadInput(filePath, ctx.GetFlagString("format"))
 
 	if err != nil {
 		fmt.Printf("Invalid %s configuration: %v\n", format.String(), err)
@@ -438,7 +436,10 @@ func (m *Manager) handleInfo(ctx *orpheus.Context) error {
 	if verbose {
 		fmt.Printf("\n System Details:\n")
 		fmt.Printf("Go version: %s\n", "1.23+")
-		fmt.Printf("Supported formats: JSON, YAML, TOML, HCL, INI, Properties\n")
+		fmt.Printf("Supported formats: JSON, JSONC, YAML, TOML, HCL, INI, Properties\n")
+		for _, parser := range argus.ListParsers() {
+			fmt.Printf("Registered parser: %s %v\n", parser.Name, parser.Formats)
+		}
 		fmt.Printf("Audit logging: %v\n", m.auditLogger != nil)
 
 		// Show memory usage and other diagnostics
@@ -94,12 +94,12 @@ func (m *Manager) setupConfigCommands() {
 	listCmd.AddFlag("prefix", "p", "", "Key prefix filter")
 	listCmd.AddFlag("format", "f", "auto", "File format (auto|json|yaml|toml|hcl|ini|properties)")
 
-	// config convert <input> <output> [--from=auto] [--to=auto]
+	// config convert <input|-> <output> [--from=auto] [--to=auto]
 	convertCmd := configCmd.Subcommand("convert", "Convert between configuration formats", m.handleConfigConvert)
 	convertCmd.AddFlag("from", "", "auto", "Input format (auto|json|yaml|toml|hcl|ini|properties)")
 	convertCmd.AddFlag("to", "", "auto", "Output format (auto|json|yaml|toml|hcl|ini|properties)")
 
-	// config validate <file> [--format=auto]
+	// config validate <file|-> [--format=auto]
 	validateCmd := configCmd.Subcommand("validate", "Validate configuration file", m.handleConfigValidate)
 	validateCmd.AddFlag("format", "f", "auto", "File format (auto|json|yaml|toml|hcl|ini|properties)")
 
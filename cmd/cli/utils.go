@@ -37,6 +37,8 @@ func (m *Manager) parseExplicitFormat(formatStr string) argus.ConfigFormat {
 	switch strings.ToLower(formatStr) {
 	case "json":
 		return argus.FormatJSON
+	case "jsonc":
+		return argus.FormatJSONC
 	case "yaml", "yml":
 		return argus.FormatYAML
 	case "toml":
@@ -79,6 +81,24 @@ func (m *Manager) loadConfig(filePath string, format argus.ConfigFormat) (map[st
 	return config, nil
 }
 
+// loadInput loads configuration from a file or, when filePath is "-", from stdin.
+// Stdin is read once through the library loader; without an explicit format the
+// content is sniffed. Returns the configuration together with the format used.
+func (m *Manager) loadInput(filePath, explicitFormat string) (map[string]interface{}, argus.ConfigFormat, error) {
+	format := m.detectFormat(filePath, explicitFormat)
+	if filePath != argus.StdinPath {
+		config, err := m.loadConfig(filePath, format)
+		return config, format, err
+	}
+
+	config, format, err := argus.LoadConfigFile(argus.StdinPath, format)
+	if err != nil {
+		return nil, format, fmt.Errorf("failed to load configuration from stdin: %w", err)
+	}
+
+	return config, format, nil
+}
+
 // parseValue automatically parses a string value to the appropriate Go type.
 // Supports: bool, int, float64, and strings with smart type detection.
 func parseValue(value string) interface{} {
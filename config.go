@@ -6,7 +6,10 @@
 
 package argus
 
-import "time"
+import (
+	"reflect"
+	"time"
+)
 
 // IdleStrategy defines how the watcher should behave when no file changes
 // are detected. This allows for power management and CPU optimization.
@@ -62,6 +65,29 @@ func (c *Config) WithDefaults() *Config {
 	return &config
 }
 
+// Clone returns a deep copy of the configuration, so variants derived from a
+// template (e.g. with a different PollInterval) never share state with it.
+// InitialState, Audit.ComponentLevels and Audit.Sinks are copied; the sinks
+// themselves and the callback fields are shared, as they are not copyable.
+func (c Config) Clone() Config {
+	clone := c
+
+	if c.InitialState != nil {
+		clone.InitialState = append([]byte(nil), c.InitialState...)
+	}
+	if c.Audit.ComponentLevels != nil {
+		clone.Audit.ComponentLevels = make(map[string]AuditLevel, len(c.Audit.ComponentLevels))
+		for component, level := range c.Audit.ComponentLevels {
+			clone.Audit.ComponentLevels[component] = level
+		}
+	}
+	if c.Audit.Sinks != nil {
+		clone.Audit.Sinks = append([]AuditSink(nil), c.Audit.Sinks...)
+	}
+
+	return clone
+}
+
 // setTimingDefaults sets default values for timing-related configuration
 func (c *Config) setTimingDefaults() {
 	if c.PollInterval <= 0 {
@@ -83,23 +109,45 @@ func (c *Config) setFileDefaults() {
 	if c.MaxWatchedFiles <= 0 {
 		c.MaxWatchedFiles = 100
 	}
+
+	if c.EventsBufferSize <= 0 {
+		c.EventsBufferSize = 64
+	}
+
+	if c.PollStallMultiplier <= 0 {
+		c.PollStallMultiplier = 5
+	}
+
+	if c.WorkerPoolThreshold <= 0 {
+		c.WorkerPoolThreshold = 8
+	}
 }
 
 // setAuditDefaults sets default audit configuration.
 //
 // DisableAudit wins over the secure default: when set, audit is forced off and
 // the enabled default is never applied (argus.New then installs an inert logger
-// that opens no backend). Otherwise an unset Audit gets the enabled default.
+// that opens no backend). Otherwise an unset Audit gets the enabled default;
+// Sinks and ComponentLevels alone do not count as setting it and are kept.
 func (c *Config) setAuditDefaults() {
 	if c.DisableAudit {
 		c.Audit = AuditConfig{Enabled: false}
 		return
 	}
-	if c.Audit == (AuditConfig{}) {
+	if auditConfigUnset(c.Audit) {
+		sinks, levels := c.Audit.Sinks, c.Audit.ComponentLevels
 		c.Audit = DefaultAuditConfig()
+		c.Audit.Sinks, c.Audit.ComponentLevels = sinks, levels
 	}
 }
 
+// auditConfigUnset reports whether every field of audit but Sinks and
+// ComponentLevels is zero
+func auditConfigUnset(audit AuditConfig) bool {
+	audit.Sinks, audit.ComponentLevels = nil, nil
+	return reflect.ValueOf(audit).IsZero()
+}
+
 // setBoreasLiteDefaults sets default BoreasLite optimization configuration
 func (c *Config) setBoreasLiteDefaults() {
 	// Set BoreasLite optimization defaults
@@ -115,6 +163,14 @@ func (c *Config) setBoreasLiteDefaults() {
 
 	// Ensure capacity is power of 2
 	c.BoreasLiteCapacity = c.nextPowerOfTwo(c.BoreasLiteCapacity)
+
+	// Give adaptive capacity room to grow unless a ceiling was chosen
+	if c.AdaptiveCapacity && c.BoreasLiteMaxCapacity == 0 {
+		c.BoreasLiteMaxCapacity = defaultBoreasLiteMaxCapacity
+		if c.BoreasLiteMaxCapacity < c.BoreasLiteCapacity {
+			c.BoreasLiteMaxCapacity = c.BoreasLiteCapacity
+		}
+	}
 }
 
 // getDefaultCapacityByStrategy returns the default capacity for the optimization strategy
@@ -168,6 +224,10 @@ func (c *Config) setRemoteConfigDefaults() {
 		c.Remote.RetryDelay = 1 * time.Second // Exponential backoff base
 	}
 
+	if c.Remote.CircuitBreakerThreshold > 0 && c.Remote.CircuitBreakerCooldown <= 0 {
+		c.Remote.CircuitBreakerCooldown = 1 * time.Minute
+	}
+
 	// Validation: Timeout should allow for retries
 	// Safe calculation to prevent integer overflow
 	var maxRetryTime time.Duration
@@ -12,6 +12,7 @@ package argus
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -30,8 +31,27 @@ const (
 	bindBool
 	bindFloat64
 	bindDuration
+
+	// Optional (pointer) kinds: the target is a **T that stays nil when the
+	// key is absent, so "explicitly false/zero" differs from "not specified"
+	bindStringPtr
+	bindIntPtr
+	bindInt64Ptr
+	bindBoolPtr
+	bindFloat64Ptr
+	bindDurationPtr
+
+	// Custom kind: conversion delegated to a typed closure in bindingExt,
+	// used by the generic binders (BindTyped, BindEnum)
+	bindCustom
 )
 
+// isOptional reports whether the kind binds into a pointer target that
+// distinguishes absent keys from zero values
+func (k bindKind) isOptional() bool {
+	return k >= bindStringPtr && k <= bindDurationPtr
+}
+
 // binding represents a single configuration binding with minimal memory footprint
 //
 // ═══════════════════════════════════════════════════════════════════════════════
@@ -63,13 +83,36 @@ type binding struct {
 	key      string         // Configuration key (e.g., "database.host")
 	defValue string         // Default value as string (universal representation)
 	kind     bindKind       // Type of binding for fast switching
+	required bool           // Apply fails when the key is absent (fits in kind's padding)
+	hasDef   bool           // An inline default was passed, shadowing WithDefaults
+	ext      *bindingExt    // Rarely used extras (nil for the common kinds)
+}
+
+// bindingExt holds state for less common binding kinds. The ext pointer costs
+// 8 bytes per binding, but keeps closures and future extras out of the
+// hot-path struct so the common kinds only carry a nil pointer.
+type bindingExt struct {
+	// apply converts and assigns the raw value (exists=false when the key is absent)
+	apply func(value interface{}, exists bool) error
 }
 
 // ConfigBinder provides ultra-fast configuration binding with fluent API
 type ConfigBinder struct {
-	bindings []binding              // Pre-allocated slice of bindings
-	config   map[string]interface{} // Configuration source
-	err      error                  // Accumulated error state
+	bindings      []binding              // Pre-allocated slice of bindings
+	config        map[string]interface{} // Configuration source
+	err           error                  // Accumulated error state
+	strictNumeric bool                   // Reject lossy numeric coercions
+
+	// Per-key validators run on converted values before assignment (nil when unused)
+	validators map[string][]func(value interface{}) error
+
+	// Lookup normalization (see NewConfigBinderWithOptions); normIndex is
+	// built on the first lookup that misses an exact match
+	options   BinderOptions
+	normIndex map[string]interface{}
+
+	// Fallback source for keys absent from config (see WithDefaults)
+	defaults *ConfigBinder
 }
 
 // NewConfigBinder creates a new high-performance configuration binder
@@ -80,6 +123,21 @@ func NewConfigBinder(config map[string]interface{}) *ConfigBinder {
 	}
 }
 
+// StrictNumeric makes integer bindings reject lossy coercions instead of
+// truncating: a float with a fractional part (replicas: 2.5) or a value out
+// of the target type's range fails Apply. The default is lenient truncation.
+//
+// Example:
+//
+//	err := argus.BindFromConfig(config).
+//	    StrictNumeric().
+//	    BindInt(&replicas, "replicas").
+//	    Apply()
+func (cb *ConfigBinder) StrictNumeric() *ConfigBinder {
+	cb.strictNumeric = true
+	return cb
+}
+
 // BindString binds a string configuration value with optional default
 func (cb *ConfigBinder) BindString(target *string, key string, defaultValue ...string) *ConfigBinder {
 	if cb.err != nil {
@@ -95,6 +153,7 @@ func (cb *ConfigBinder) BindString(target *string, key string, defaultValue ...s
 		target:   unsafe.Pointer(target), // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
 		key:      key,
 		defValue: defVal,
+		hasDef:   len(defaultValue) > 0,
 		kind:     bindString,
 	})
 
@@ -116,6 +175,7 @@ func (cb *ConfigBinder) BindInt(target *int, key string, defaultValue ...int) *C
 		target:   unsafe.Pointer(target), // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
 		key:      key,
 		defValue: defVal,
+		hasDef:   len(defaultValue) > 0,
 		kind:     bindInt,
 	})
 
@@ -137,6 +197,7 @@ func (cb *ConfigBinder) BindInt64(target *int64, key string, defaultValue ...int
 		target:   unsafe.Pointer(target), // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
 		key:      key,
 		defValue: defVal,
+		hasDef:   len(defaultValue) > 0,
 		kind:     bindInt64,
 	})
 
@@ -158,6 +219,7 @@ func (cb *ConfigBinder) BindBool(target *bool, key string, defaultValue ...bool)
 		target:   unsafe.Pointer(target), // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
 		key:      key,
 		defValue: defVal,
+		hasDef:   len(defaultValue) > 0,
 		kind:     bindBool,
 	})
 
@@ -179,13 +241,16 @@ func (cb *ConfigBinder) BindFloat64(target *float64, key string, defaultValue ..
 		target:   unsafe.Pointer(target), // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
 		key:      key,
 		defValue: defVal,
+		hasDef:   len(defaultValue) > 0,
 		kind:     bindFloat64,
 	})
 
 	return cb
 }
 
-// BindDuration binds a time.Duration configuration value with optional default
+// BindDuration binds a time.Duration configuration value with optional default.
+// Strings are parsed with time.ParseDuration ("30s"); bare integers are
+// nanoseconds, so 30 is 30ns. Use BindDurationUnit for numbers in another unit.
 func (cb *ConfigBinder) BindDuration(target *time.Duration, key string, defaultValue ...time.Duration) *ConfigBinder {
 	if cb.err != nil {
 		return cb
@@ -200,12 +265,164 @@ func (cb *ConfigBinder) BindDuration(target *time.Duration, key string, defaultV
 		target:   unsafe.Pointer(target), // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
 		key:      key,
 		defValue: defVal,
+		hasDef:   len(defaultValue) > 0,
 		kind:     bindDuration,
 	})
 
 	return cb
 }
 
+// BindDurationUnit binds a time.Duration where bare numbers are expressed in
+// unit, for human-authored config such as "timeout: 30" meaning seconds.
+// Numbers (including numeric strings and fractions like 1.5) are multiplied
+// by unit; strings with a unit suffix ("30s", "2m") are parsed directly.
+// When the key is absent the optional default is used, otherwise zero.
+//
+// Example:
+//
+//	var timeout time.Duration
+//	err := argus.BindFromConfig(config).
+//	    BindDurationUnit(&timeout, "server.timeout", time.Second, 30*time.Second).
+//	    Apply()
+func (cb *ConfigBinder) BindDurationUnit(target *time.Duration, key string, unit time.Duration, defaultValue ...time.Duration) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+	if unit <= 0 {
+		cb.err = errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("BindDurationUnit unit for key '%s' must be positive, got %v", key, unit))
+		return cb
+	}
+
+	return cb.addCustomBinding(key, len(defaultValue) > 0, func(value interface{}, exists bool) error {
+		if !exists {
+			var d time.Duration
+			if len(defaultValue) > 0 {
+				d = defaultValue[0]
+			}
+			return setValidated(cb, key, target, d)
+		}
+
+		d, err := cb.toDurationUnit(value, unit)
+		if err != nil {
+			return err
+		}
+		return setValidated(cb, key, target, d)
+	})
+}
+
+// BindStringPtr binds an optional string value.
+// The target is set to a non-nil pointer only when the key exists in the
+// configuration; when it is absent the target is left untouched, so nil
+// means "never specified" and earlier layers are preserved.
+func (cb *ConfigBinder) BindStringPtr(target **string, key string) *ConfigBinder {
+	return cb.addOptionalBinding(unsafe.Pointer(target), key, bindStringPtr) // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
+}
+
+// BindIntPtr binds an optional integer value (untouched when the key is absent)
+func (cb *ConfigBinder) BindIntPtr(target **int, key string) *ConfigBinder {
+	return cb.addOptionalBinding(unsafe.Pointer(target), key, bindIntPtr) // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
+}
+
+// BindInt64Ptr binds an optional int64 value (untouched when the key is absent)
+func (cb *ConfigBinder) BindInt64Ptr(target **int64, key string) *ConfigBinder {
+	return cb.addOptionalBinding(unsafe.Pointer(target), key, bindInt64Ptr) // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
+}
+
+// BindBoolPtr binds an optional boolean value.
+// Useful for tri-state settings where "explicitly disabled" (false) must be
+// distinguished from "not specified" (nil), e.g. when layering configs.
+func (cb *ConfigBinder) BindBoolPtr(target **bool, key string) *ConfigBinder {
+	return cb.addOptionalBinding(unsafe.Pointer(target), key, bindBoolPtr) // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
+}
+
+// BindFloat64Ptr binds an optional float64 value (untouched when the key is absent)
+func (cb *ConfigBinder) BindFloat64Ptr(target **float64, key string) *ConfigBinder {
+	return cb.addOptionalBinding(unsafe.Pointer(target), key, bindFloat64Ptr) // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
+}
+
+// BindDurationPtr binds an optional time.Duration value (untouched when the key is absent)
+func (cb *ConfigBinder) BindDurationPtr(target **time.Duration, key string) *ConfigBinder {
+	return cb.addOptionalBinding(unsafe.Pointer(target), key, bindDurationPtr) // #nosec G103 - intentional unsafe.Pointer usage for zero-reflection binding
+}
+
+// addOptionalBinding registers a pointer binding; optional bindings have no default
+func (cb *ConfigBinder) addOptionalBinding(target unsafe.Pointer, key string, kind bindKind) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+
+	cb.bindings = append(cb.bindings, binding{
+		target: target,
+		key:    key,
+		kind:   kind,
+	})
+
+	return cb
+}
+
+// addCustomBinding registers a binding whose conversion is performed by apply;
+// hasDefault reports whether apply carries an inline default
+func (cb *ConfigBinder) addCustomBinding(key string, hasDefault bool, apply func(value interface{}, exists bool) error) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+
+	cb.bindings = append(cb.bindings, binding{
+		key:    key,
+		kind:   bindCustom,
+		hasDef: hasDefault,
+		ext:    &bindingExt{apply: apply},
+	})
+
+	return cb
+}
+
+// Required marks the binding registered just before it as mandatory: Apply
+// fails with ErrCodeMissingRequiredKey when its key is absent, instead of
+// falling back to the default or zero value. All missing required keys are
+// listed in one error and no target is assigned.
+//
+// Example:
+//
+//	err := argus.BindFromConfig(config).
+//	    BindString(&host, "database.host").Required().
+//	    BindInt(&port, "database.port", 5432).
+//	    Apply()
+func (cb *ConfigBinder) Required() *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+	if len(cb.bindings) == 0 {
+		cb.err = errors.New(ErrCodeInvalidConfig, "Required must follow a Bind call")
+		return cb
+	}
+
+	cb.bindings[len(cb.bindings)-1].required = true
+	return cb
+}
+
+// checkRequired reports every required key absent from the configuration
+func (cb *ConfigBinder) checkRequired() error {
+	var missing []string
+	for _, b := range cb.bindings {
+		if !b.required {
+			continue
+		}
+		// Invalid list indices are reported by the binding itself
+		if _, exists, _, err := cb.resolve(b); !exists && err == nil {
+			missing = append(missing, b.key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return errors.New(ErrCodeMissingRequiredKey,
+		fmt.Sprintf("missing %d required key(s): %s", len(missing), strings.Join(missing, ", "))).
+		WithContext("keys", missing)
+}
+
 // Apply executes all bindings in a single optimized pass
 // This is where the magic happens - ultra-fast batch processing
 //
@@ -234,21 +451,42 @@ func (cb *ConfigBinder) Apply() error {
 	if cb.err != nil {
 		return cb.err
 	}
+	cb.resetIndex() // The config maps may have changed since the last run
+	if err := cb.checkRequired(); err != nil {
+		return err
+	}
 
 	// Single loop - maximum performance
 	for _, b := range cb.bindings {
-		if err := cb.applyBinding(b); err != nil {
-			return errors.Wrap(err, ErrCodeInvalidConfig, "failed to bind key '"+b.key+"'")
+		// Get value from config (or WithDefaults) with nested key support
+		value, exists, _, err := cb.resolve(b)
+		if err == nil {
+			err = cb.applyBinding(b, value, exists)
+		}
+		if err != nil {
+			return bindError(b.key, err)
 		}
 	}
 
 	return nil
 }
 
+// bindError wraps a binding failure with the key it belongs to
+func bindError(key string, err error) error {
+	return errors.Wrap(err, ErrCodeInvalidConfig, "failed to bind key '"+key+"'")
+}
+
 // applyBinding applies a single binding with zero-allocation type switching
-func (cb *ConfigBinder) applyBinding(b binding) error {
-	// Get value from config with nested key support
-	value, exists := cb.getValue(b.key)
+func (cb *ConfigBinder) applyBinding(b binding, value interface{}, exists bool) error {
+	// Optional bindings never fall back to a default: absence is meaningful
+	if b.kind.isOptional() {
+		return cb.applyOptionalBinding(b, value, exists)
+	}
+
+	if b.kind == bindCustom {
+		return b.ext.apply(value, exists)
+	}
+
 	if !exists {
 		// Use default value
 		value = b.defValue
@@ -257,76 +495,157 @@ func (cb *ConfigBinder) applyBinding(b binding) error {
 	// Ultra-fast type switching without reflection
 	switch b.kind {
 	case bindString:
-		*(*string)(b.target) = cb.toString(value)
+		return setValidated(cb, b.key, (*string)(b.target), cb.toString(value))
 	case bindInt:
 		val, err := cb.toInt(value)
 		if err != nil {
 			return err
 		}
-		*(*int)(b.target) = val
+		return setValidated(cb, b.key, (*int)(b.target), val)
 	case bindInt64:
 		val, err := cb.toInt64(value)
 		if err != nil {
 			return err
 		}
-		*(*int64)(b.target) = val
+		return setValidated(cb, b.key, (*int64)(b.target), val)
 	case bindBool:
 		val, err := cb.toBool(value)
 		if err != nil {
 			return err
 		}
-		*(*bool)(b.target) = val
+		return setValidated(cb, b.key, (*bool)(b.target), val)
 	case bindFloat64:
 		val, err := cb.toFloat64(value)
 		if err != nil {
 			return err
 		}
-		*(*float64)(b.target) = val
+		return setValidated(cb, b.key, (*float64)(b.target), val)
 	case bindDuration:
 		val, err := cb.toDuration(value)
 		if err != nil {
 			return err
 		}
-		*(*time.Duration)(b.target) = val
+		return setValidated(cb, b.key, (*time.Duration)(b.target), val)
 	default:
 		return errors.New(ErrCodeInvalidConfig, fmt.Sprintf("unsupported binding kind: %d", b.kind))
 	}
+}
 
-	return nil
+// applyOptionalBinding assigns a freshly allocated value to a pointer target.
+// Absent keys leave the target untouched so that layered sources compose.
+func (cb *ConfigBinder) applyOptionalBinding(b binding, value interface{}, exists bool) error {
+	if !exists {
+		return nil
+	}
+
+	switch b.kind {
+	case bindStringPtr:
+		return setValidatedPtr(cb, b.key, (**string)(b.target), cb.toString(value))
+	case bindIntPtr:
+		val, err := cb.toInt(value)
+		if err != nil {
+			return err
+		}
+		return setValidatedPtr(cb, b.key, (**int)(b.target), val)
+	case bindInt64Ptr:
+		val, err := cb.toInt64(value)
+		if err != nil {
+			return err
+		}
+		return setValidatedPtr(cb, b.key, (**int64)(b.target), val)
+	case bindBoolPtr:
+		val, err := cb.toBool(value)
+		if err != nil {
+			return err
+		}
+		return setValidatedPtr(cb, b.key, (**bool)(b.target), val)
+	case bindFloat64Ptr:
+		val, err := cb.toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return setValidatedPtr(cb, b.key, (**float64)(b.target), val)
+	case bindDurationPtr:
+		val, err := cb.toDuration(value)
+		if err != nil {
+			return err
+		}
+		return setValidatedPtr(cb, b.key, (**time.Duration)(b.target), val)
+	default:
+		return errors.New(ErrCodeInvalidConfig, fmt.Sprintf("unsupported optional binding kind: %d", b.kind))
+	}
 }
 
-// getValue retrieves a value from config with support for nested keys (e.g., "database.host")
+// getValue retrieves a value from config with support for nested keys
+// (e.g., "database.host", "servers.0.port"). Invalid list indices count as
+// absent; Apply reports them through lookup.
 func (cb *ConfigBinder) getValue(key string) (interface{}, bool) {
+	val, exists, _ := cb.lookup(key)
+	return val, exists
+}
+
+// lookup resolves key, reporting invalid list indices as errors.
+// An exact match always wins; BinderOptions enable a normalized fallback.
+func (cb *ConfigBinder) lookup(key string) (interface{}, bool, error) {
+	val, exists, err := cb.getExactValue(key)
+	if exists || err != nil || !cb.options.normalizes() {
+		return val, exists, err
+	}
+	val, exists = cb.getNormalizedValue(key)
+	return val, exists, nil
+}
+
+// getExactValue resolves key by walking nested maps segment by segment.
+// A segment applied to a list is an index into it.
+func (cb *ConfigBinder) getExactValue(key string) (interface{}, bool, error) {
 	if !strings.Contains(key, ".") {
 		// Simple key - direct lookup
 		val, exists := cb.config[key]
-		return val, exists
+		return val, exists, nil
 	}
 
-	// Nested key - traverse the map
+	// Nested key - traverse maps and lists
 	parts := strings.Split(key, ".")
-	current := cb.config
+	var current interface{} = cb.config
 
 	for i, part := range parts {
-		val, exists := current[part]
-		if !exists {
-			return nil, false
+		switch node := current.(type) {
+		case map[string]interface{}:
+			val, exists := node[part]
+			if !exists {
+				return nil, false, nil
+			}
+			current = val
+		case []interface{}:
+			index, err := listIndex(strings.Join(parts[:i], "."), part, len(node))
+			if err != nil {
+				return nil, false, err
+			}
+			current = node[index]
+		default:
+			// Intermediate part is a scalar
+			return nil, false, nil
 		}
+	}
 
-		if i == len(parts)-1 {
-			// Last part - return the value
-			return val, true
-		}
+	return current, true, nil
+}
 
-		// Intermediate part - must be a map
-		if nestedMap, ok := val.(map[string]interface{}); ok {
-			current = nestedMap
-		} else {
-			return nil, false
-		}
+// listIndex parses segment as an index into the list at path of length n
+func listIndex(path, segment string, n int) (int, error) {
+	index, err := strconv.Atoi(segment)
+	switch {
+	case err != nil:
+		return 0, errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("'%s' is a list, segment '%s' is not an index", path, segment))
+	case index < 0:
+		return 0, errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("negative index %d for list '%s'", index, path))
+	case index >= n:
+		return 0, errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("index %d out of range for list '%s' of length %d", index, path, n))
 	}
-
-	return nil, false
+	return index, nil
 }
 
 // Type conversion methods with minimal allocations
@@ -347,8 +666,16 @@ func (cb *ConfigBinder) toInt(value interface{}) (int, error) {
 	case int:
 		return v, nil
 	case int64:
+		if cb.strictNumeric && (v < math.MinInt || v > math.MaxInt) {
+			return 0, errors.New(ErrCodeInvalidConfig, fmt.Sprintf("value %d overflows int", v))
+		}
 		return int(v), nil
 	case float64:
+		if cb.strictNumeric {
+			if err := checkIntegralFloat(v, math.MinInt, "int"); err != nil {
+				return 0, err
+			}
+		}
 		return int(v), nil
 	case string:
 		return strconv.Atoi(v)
@@ -364,6 +691,11 @@ func (cb *ConfigBinder) toInt64(value interface{}) (int64, error) {
 	case int:
 		return int64(v), nil
 	case float64:
+		if cb.strictNumeric {
+			if err := checkIntegralFloat(v, math.MinInt64, "int64"); err != nil {
+				return 0, err
+			}
+		}
 		return int64(v), nil
 	case string:
 		return strconv.ParseInt(v, 10, 64)
@@ -372,6 +704,19 @@ func (cb *ConfigBinder) toInt64(value interface{}) (int64, error) {
 	}
 }
 
+// checkIntegralFloat rejects floats that would lose information when
+// converted to a signed integer type whose minimum is minVal
+func checkIntegralFloat(v float64, minVal int64, typeName string) error {
+	if v != math.Trunc(v) {
+		return errors.New(ErrCodeInvalidConfig, fmt.Sprintf("value %v has a fractional part, not a valid %s", v, typeName))
+	}
+	// -minVal is maxVal+1, a power of two that float64 represents exactly
+	if v < float64(minVal) || v >= -float64(minVal) {
+		return errors.New(ErrCodeInvalidConfig, fmt.Sprintf("value %v overflows %s", v, typeName))
+	}
+	return nil
+}
+
 func (cb *ConfigBinder) toBool(value interface{}) (bool, error) {
 	switch v := value.(type) {
 	case bool:
@@ -421,6 +766,35 @@ func (cb *ConfigBinder) toDuration(value interface{}) (time.Duration, error) {
 	}
 }
 
+// toDurationUnit converts value to a duration, reading bare numbers in unit
+func (cb *ConfigBinder) toDurationUnit(value interface{}, unit time.Duration) (time.Duration, error) {
+	var n float64
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case int:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case float64:
+		n = v
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return time.ParseDuration(v) // Has a unit suffix, e.g. "30s"
+		}
+		n = f
+	default:
+		return 0, errors.New(ErrCodeInvalidConfig, fmt.Sprintf("cannot convert %T to time.Duration", value))
+	}
+
+	d := n * float64(unit)
+	if math.IsNaN(d) || d >= math.MaxInt64 || d < math.MinInt64 {
+		return 0, errors.New(ErrCodeInvalidConfig, fmt.Sprintf("duration %v x %v out of range", n, unit))
+	}
+	return time.Duration(d), nil
+}
+
 // BindFromConfig creates a new ConfigBinder from a parsed configuration map
 // This is the main entry point for users
 func BindFromConfig(config map[string]interface{}) *ConfigBinder {
@@ -0,0 +1,58 @@
+// config_binder_defaults.go: Default value source for the ConfigBinder
+//
+// Repeating a default at every Bind call scatters the baked-in configuration
+// across the code. WithDefaults lets a binder consult a second map, typically
+// an embedded JSON of defaults, for every key the configuration lacks.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// WithDefaults registers defaults as a fallback source, in the same nested
+// shape as the configuration. A key absent from the configuration is looked
+// up in defaults before the binding falls back to its inline default, but an
+// inline default passed to the Bind call (or an `argus:"key,default"` tag)
+// takes precedence over the defaults map. A key found in defaults satisfies
+// Required. Lookups in defaults honour the binder's BinderOptions.
+//
+// Example:
+//
+//	var defaults map[string]interface{}
+//	_ = json.Unmarshal(embeddedDefaults, &defaults)
+//
+//	err := argus.BindFromConfig(config).
+//	    WithDefaults(defaults).
+//	    BindInt(&port, "server.port").           // config, then defaults
+//	    BindString(&level, "log.level", "info"). // config, then "info"
+//	    Apply()
+func (cb *ConfigBinder) WithDefaults(defaults map[string]interface{}) *ConfigBinder {
+	if defaults == nil {
+		cb.defaults = nil
+		return cb
+	}
+	cb.defaults = &ConfigBinder{config: defaults, options: cb.options}
+	return cb
+}
+
+// resolve looks up the value of b in the configuration, then in the
+// WithDefaults map when b has no inline default. defaulted reports that the
+// value came from the defaults map.
+func (cb *ConfigBinder) resolve(b binding) (value interface{}, exists, defaulted bool, err error) {
+	value, exists, err = cb.lookup(b.key)
+	if exists || err != nil || b.hasDef || cb.defaults == nil {
+		return value, exists, false, err
+	}
+	value, exists, err = cb.defaults.lookup(b.key)
+	return value, exists, exists, err
+}
+
+// resetIndex drops the normalized lookup indexes before a run, since the
+// configuration maps may have changed since the last one
+func (cb *ConfigBinder) resetIndex() {
+	cb.normIndex = nil
+	if cb.defaults != nil {
+		cb.defaults.normIndex = nil
+	}
+}
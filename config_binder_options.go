@@ -0,0 +1,97 @@
+// config_binder_options.go: Normalized key lookup for ConfigBinder
+//
+// Environment variables arrive as DATABASE_HOST while files use
+// database.host. With BinderOptions a single set of bindings resolves keys
+// from both sources: when a key has no exact match, the lookup compares keys
+// with case and separators normalized.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"sort"
+	"strings"
+)
+
+// BinderOptions tunes how a ConfigBinder resolves keys. Exact matches always
+// win; the options only add a normalized fallback.
+type BinderOptions struct {
+	// CaseInsensitive matches keys regardless of case ("Database.Host")
+	CaseInsensitive bool
+
+	// EnvStyleKeys treats '.' and '_' as the same separator, so
+	// "database.host" matches DATABASE_HOST in a flat map and vice versa.
+	// Combine with CaseInsensitive to match upper-case environment names.
+	EnvStyleKeys bool
+}
+
+// normalizes reports whether any lookup normalization is enabled
+func (o BinderOptions) normalizes() bool {
+	return o.CaseInsensitive || o.EnvStyleKeys
+}
+
+// normalize returns the form of key compared by the fallback lookup
+func (o BinderOptions) normalize(key string) string {
+	if o.CaseInsensitive {
+		key = strings.ToLower(key)
+	}
+	if o.EnvStyleKeys {
+		key = strings.ReplaceAll(key, "_", ".")
+	}
+	return key
+}
+
+// NewConfigBinderWithOptions creates a binder whose key lookup falls back to
+// a normalized match when a key is not found exactly.
+//
+// Example:
+//
+//	// config mixes {"DATABASE_HOST": "db"} from env and {"server": {"port": 80}}
+//	err := argus.NewConfigBinderWithOptions(config, argus.BinderOptions{
+//	    CaseInsensitive: true,
+//	    EnvStyleKeys:    true,
+//	}).
+//	    BindString(&host, "database.host").
+//	    BindInt(&port, "server.port").
+//	    Apply()
+func NewConfigBinderWithOptions(config map[string]interface{}, options BinderOptions) *ConfigBinder {
+	cb := NewConfigBinder(config)
+	cb.options = options
+	return cb
+}
+
+// getNormalizedValue resolves key against the normalized index of every
+// path in the configuration
+func (cb *ConfigBinder) getNormalizedValue(key string) (interface{}, bool) {
+	if cb.normIndex == nil {
+		cb.normIndex = make(map[string]interface{})
+		cb.indexNormalized("", cb.config)
+	}
+	val, exists := cb.normIndex[cb.options.normalize(key)]
+	return val, exists
+}
+
+// indexNormalized records every path below m under its normalized form.
+// Keys are visited in sorted order so that colliding paths resolve
+// deterministically to the first one.
+func (cb *ConfigBinder) indexNormalized(prefix string, m map[string]interface{}) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		path := joinKeyPath(prefix, k)
+		normalized := cb.options.normalize(path)
+		if _, taken := cb.normIndex[normalized]; !taken {
+			cb.normIndex[normalized] = m[k]
+		}
+		if nested, ok := m[k].(map[string]interface{}); ok {
+			cb.indexNormalized(path, nested)
+		}
+	}
+}
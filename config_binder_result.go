@@ -0,0 +1,106 @@
+// config_binder_result.go: Per-key outcome of a ConfigBinder run
+//
+// Apply stops at the first failing binding and returns a single error, which
+// is what most callers want. When loading a large configuration it is more
+// useful to bind everything that can be bound and learn exactly which keys
+// failed and which fell back to defaults; ApplyWithResult reports that.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"fmt"
+
+	"github.com/agilira/go-errors"
+)
+
+// BindResult is the per-key outcome of ApplyWithResult
+type BindResult struct {
+	// Errors maps each failing key to its error. Missing required keys
+	// carry ErrCodeMissingRequiredKey, conversion and validation failures
+	// ErrCodeInvalidConfig.
+	Errors map[string]error
+
+	// BoundKeys lists the keys whose targets were assigned, in binding order.
+	// Absent keys of optional (*Ptr) bindings are not listed.
+	BoundKeys []string
+
+	// DefaultedKeys lists the bound keys that were absent from the
+	// configuration and received their default (or zero) value, including
+	// values taken from WithDefaults
+	DefaultedKeys []string
+}
+
+// Failed reports whether any binding failed
+func (r *BindResult) Failed() bool {
+	return len(r.Errors) > 0
+}
+
+// ApplyWithResult runs every binding, unlike Apply which stops at the first
+// failure, and reports the outcome per key. Failing keys leave their targets
+// untouched; all other targets are assigned. The returned error joins every
+// per-key error in binding order, or is nil when all bindings succeeded.
+// A registration error (e.g. an invalid BindMapOfStruct target) is returned
+// with a nil result.
+//
+// Example:
+//
+//	result, err := binder.ApplyWithResult()
+//	for key, keyErr := range result.Errors {
+//	    log.Printf("config key %s: %v", key, keyErr)
+//	}
+func (cb *ConfigBinder) ApplyWithResult() (*BindResult, error) {
+	if cb.err != nil {
+		return nil, cb.err
+	}
+	cb.resetIndex() // The config maps may have changed since the last run
+
+	result := &BindResult{
+		Errors:    make(map[string]error),
+		BoundKeys: make([]string, 0, len(cb.bindings)),
+	}
+	var errs []error
+	fail := func(key string, err error) {
+		errs = append(errs, err)
+		if previous, ok := result.Errors[key]; ok {
+			err = goerrors.Join(previous, err)
+		}
+		result.Errors[key] = err
+	}
+
+	for _, b := range cb.bindings {
+		value, exists, defaulted, err := cb.resolve(b)
+		if err == nil && !exists && b.required {
+			fail(b.key, errors.New(ErrCodeMissingRequiredKey, "missing required key '"+b.key+"'"))
+			continue
+		}
+		if err == nil {
+			err = cb.applyBinding(b, value, exists)
+		}
+		if err != nil {
+			fail(b.key, bindError(b.key, err))
+			continue
+		}
+
+		switch {
+		case exists && defaulted:
+			result.BoundKeys = append(result.BoundKeys, b.key)
+			result.DefaultedKeys = append(result.DefaultedKeys, b.key)
+		case exists:
+			result.BoundKeys = append(result.BoundKeys, b.key)
+		case !b.kind.isOptional(): // Absent optional keys leave their target untouched
+			result.BoundKeys = append(result.BoundKeys, b.key)
+			result.DefaultedKeys = append(result.DefaultedKeys, b.key)
+		}
+	}
+
+	if len(errs) == 0 {
+		return result, nil
+	}
+	return result, errors.Wrap(goerrors.Join(errs...), ErrCodeInvalidConfig,
+		fmt.Sprintf("%d of %d bindings failed", len(errs), len(cb.bindings)))
+}
@@ -0,0 +1,149 @@
+// config_binder_slice.go: List bindings for the ConfigBinder fluent API
+//
+// Parsers deliver lists as []interface{} with mixed element types, while
+// environment variables and flags usually carry them as "a,b,c". Slice
+// bindings accept both, plus already-typed slices, and convert every element
+// with the scalar converters so lists behave like the matching scalar binding.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/agilira/go-errors"
+)
+
+// BindStringSlice binds a list of strings. The value may be a list (elements
+// converted like BindString), a comma-separated string ("a, b, c", elements
+// trimmed) or a []string. Nested maps and lists are rejected. When the key is
+// absent the optional default is used, otherwise the target is set to nil.
+//
+// Example:
+//
+//	var origins []string
+//	err := argus.BindFromConfig(config).
+//	    BindStringSlice(&origins, "cors.allowed_origins", []string{"*"}).
+//	    Apply()
+func (cb *ConfigBinder) BindStringSlice(target *[]string, key string, defaultValue ...[]string) *ConfigBinder {
+	return bindSlice(cb, target, key, defaultValue, cb.toStringElement)
+}
+
+// BindIntSlice binds a list of integers such as retry backoff steps.
+// Accepts the same list forms as BindStringSlice; every element is converted
+// like BindInt, so mixed lists ([1, "2", 4.0]) bind and StrictNumeric applies.
+//
+// Example:
+//
+//	var steps []int
+//	err := argus.BindFromConfig(config).
+//	    BindIntSlice(&steps, "retry.backoff_steps", []int{1, 2, 4}).
+//	    Apply()
+func (cb *ConfigBinder) BindIntSlice(target *[]int, key string, defaultValue ...[]int) *ConfigBinder {
+	return bindSlice(cb, target, key, defaultValue, cb.toInt)
+}
+
+// BindFloat64Slice binds a list of floats such as sampling rates.
+// Accepts the same list forms as BindStringSlice; every element is converted
+// like BindFloat64.
+func (cb *ConfigBinder) BindFloat64Slice(target *[]float64, key string, defaultValue ...[]float64) *ConfigBinder {
+	return bindSlice(cb, target, key, defaultValue, cb.toFloat64)
+}
+
+// bindSlice registers a list binding converting each element with convert.
+// Errors of all elements are aggregated and report the element index; on
+// error the target is left untouched.
+func bindSlice[T any](cb *ConfigBinder, target *[]T, key string, defaultValue [][]T, convert func(interface{}) (T, error)) *ConfigBinder {
+	return cb.addCustomBinding(key, len(defaultValue) > 0, func(value interface{}, exists bool) error {
+		if !exists {
+			var list []T
+			if len(defaultValue) > 0 {
+				list = append(list, defaultValue[0]...)
+			}
+			return setValidated(cb, key, target, list)
+		}
+
+		elems, err := sliceElements(value)
+		if err != nil {
+			return err
+		}
+
+		result := make([]T, 0, len(elems))
+		var errs []error
+		for i, elem := range elems {
+			converted, err := convert(elem)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+				continue
+			}
+			result = append(result, converted)
+		}
+		if len(errs) > 0 {
+			return errors.Wrap(goerrors.Join(errs...), ErrCodeInvalidConfig,
+				fmt.Sprintf("%d of %d elements failed to convert", len(errs), len(elems)))
+		}
+
+		return setValidated(cb, key, target, result)
+	})
+}
+
+// toStringElement converts a list element like toString, rejecting nested
+// maps and lists
+func (cb *ConfigBinder) toStringElement(value interface{}) (string, error) {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return "", errors.New(ErrCodeInvalidConfig, fmt.Sprintf("cannot convert %T to string", value))
+	}
+	return cb.toString(value), nil
+}
+
+// convertSlice converts every element of a list value with convert,
+// returning the first error. Used for defaults known at registration time.
+func convertSlice[T any](value interface{}, convert func(interface{}) (T, error)) ([]T, error) {
+	if typed, ok := value.([]T); ok {
+		return typed, nil
+	}
+	elems, err := sliceElements(value)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]T, len(elems))
+	for i, elem := range elems {
+		if result[i], err = convert(elem); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return result, nil
+}
+
+// sliceElements returns the elements of a list value: a parsed list, a
+// []string, or a comma-separated string (an empty string is an empty list)
+func sliceElements(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		return v, nil
+	case []string:
+		elems := make([]interface{}, len(v))
+		for i, s := range v {
+			elems[i] = s
+		}
+		return elems, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return []interface{}{}, nil
+		}
+		parts := strings.Split(v, ",")
+		elems := make([]interface{}, len(parts))
+		for i, part := range parts {
+			elems[i] = strings.TrimSpace(part)
+		}
+		return elems, nil
+	default:
+		return nil, errors.New(ErrCodeInvalidConfig, fmt.Sprintf("cannot convert %T to a list", value))
+	}
+}
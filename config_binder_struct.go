@@ -0,0 +1,399 @@
+// config_binder_struct.go: Tag-driven struct binding
+//
+// The fluent Bind* methods cover scalar values with zero reflection.
+// BindStruct registers them for every field of a tagged struct, reflecting
+// only once at registration. Named collections such as
+//
+//	databases:
+//	  primary: {host: db1, port: 5432}
+//	  replica: {host: db2, port: 5433}
+//
+// have a shape known only at Apply time, so their entries are decoded into the
+// element struct with reflection, guided by `argus` field tags:
+//
+//	type Database struct {
+//	    Host string        `argus:"host"`
+//	    Port int           `argus:"port,5432"`        // key,default
+//	    Pool PoolConfig    `argus:"pool"`             // nested: pool.*
+//	    Skip string        `argus:"-"`                // ignored
+//	}
+//
+// Untagged exported fields use the lowercased field name as key. Conversions
+// reuse the ConfigBinder converters, so values behave like scalar bindings.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// structTagName is the field tag read by the struct binders
+const structTagName = "argus"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// BindMapOfStruct binds a map of named structs, preserving the keys.
+// target must be a pointer to a map with string keys and struct (or pointer
+// to struct) values, e.g. *map[string]Database. Each entry at key is decoded
+// into a new element using `argus:"key,default"` field tags. Errors of all
+// entries are aggregated and report the entry key. On error, or when key is
+// absent, the target map is left untouched.
+//
+// Example:
+//
+//	var dbs map[string]Database
+//	err := argus.BindFromConfig(config).
+//	    BindMapOfStruct(&dbs, "databases").
+//	    Apply()
+func (cb *ConfigBinder) BindMapOfStruct(target interface{}, key string) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+
+	mapPtr := reflect.ValueOf(target)
+	if mapPtr.Kind() != reflect.Ptr || mapPtr.IsNil() ||
+		mapPtr.Elem().Kind() != reflect.Map || mapPtr.Elem().Type().Key().Kind() != reflect.String ||
+		!isStructOrStructPtr(mapPtr.Elem().Type().Elem()) {
+		cb.err = errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("BindMapOfStruct target for key '%s' must be a pointer to map[string]struct, got %T", key, target))
+		return cb
+	}
+
+	return cb.addCustomBinding(key, false, func(value interface{}, exists bool) error {
+		if !exists {
+			return nil
+		}
+		entries, ok := value.(map[string]interface{})
+		if !ok {
+			return errors.New(ErrCodeInvalidConfig, fmt.Sprintf("expected a map of entries, got %T", value))
+		}
+
+		mapType := mapPtr.Elem().Type()
+		result := reflect.MakeMapWithSize(mapType, len(entries))
+
+		names := make([]string, 0, len(entries))
+		for name := range entries {
+			names = append(names, name)
+		}
+		sort.Strings(names) // Deterministic error order
+
+		var errs []error
+		for _, name := range names {
+			elem, err := cb.decodeStructEntry(mapType.Elem(), entries[name])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("entry '%s': %w", name, err))
+				continue
+			}
+			result.SetMapIndex(reflect.ValueOf(name).Convert(mapType.Key()), elem)
+		}
+
+		if len(errs) > 0 {
+			return errors.Wrap(goerrors.Join(errs...), ErrCodeInvalidConfig,
+				fmt.Sprintf("%d of %d entries failed to bind", len(errs), len(entries)))
+		}
+
+		if err := cb.validate(key, result.Interface()); err != nil {
+			return err
+		}
+		mapPtr.Elem().Set(result)
+		return nil
+	})
+}
+
+// BindStruct registers a typed binding for every exported field of the
+// struct target points to, using `argus:"key,default"` tags under prefix
+// (nested structs extend the prefix with their key). Reflection runs once,
+// here; Apply assigns string, int, int64, bool, float64, time.Duration and
+// []string/[]int/[]float64 fields through the regular Bind* fast path.
+// Absent keys fall back to the tag default, otherwise to the field's
+// current value, so preset defaults survive.
+//
+// Example:
+//
+//	type ServerConfig struct {
+//	    Host    string        `argus:"host,localhost"`
+//	    Port    int           `argus:"port,8080"`
+//	    Timeout time.Duration `argus:"timeout,30s"`
+//	    TLS     struct {
+//	        Enabled bool `argus:"enabled"`
+//	    } `argus:"tls"`
+//	}
+//
+//	var server ServerConfig
+//	err := argus.BindFromConfig(config).BindStruct(&server, "server").Apply()
+func (cb *ConfigBinder) BindStruct(target interface{}, prefix string) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+
+	structPtr := reflect.ValueOf(target)
+	if structPtr.Kind() != reflect.Ptr || structPtr.IsNil() || structPtr.Elem().Kind() != reflect.Struct {
+		cb.err = errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("BindStruct target for prefix '%s' must be a non-nil pointer to a struct, got %T", prefix, target))
+		return cb
+	}
+
+	cb.bindStructFields(structPtr.Elem(), prefix)
+	return cb
+}
+
+// bindStructFields registers bindings for the fields of dst under prefix,
+// stopping at the first field that cannot be bound
+func (cb *ConfigBinder) bindStructFields(dst reflect.Value, prefix string) {
+	structType := dst.Type()
+	for i := 0; i < structType.NumField() && cb.err == nil; i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key, defValue, hasDefault, skip := parseStructTag(field)
+		if skip {
+			continue
+		}
+		fullKey := joinKeyPath(prefix, key)
+
+		if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+			cb.bindStructFields(dst.Field(i), fullKey)
+			continue
+		}
+
+		var def interface{} = dst.Field(i).Interface()
+		if hasDefault {
+			def = defValue
+		}
+		if err := cb.bindStructField(dst.Field(i), fullKey, def, hasDefault); err != nil {
+			cb.err = errors.Wrap(err, ErrCodeInvalidConfig,
+				fmt.Sprintf("invalid default for BindStruct field '%s'", fullKey))
+			continue
+		}
+		// The current field value is a fallback, not an inline default:
+		// only tag defaults shadow WithDefaults
+		cb.bindings[len(cb.bindings)-1].hasDef = hasDefault
+	}
+}
+
+// bindStructField registers the Bind* call matching the field type, with
+// def converted at registration time. Other field types fall back to a
+// reflective assignment at Apply time.
+func (cb *ConfigBinder) bindStructField(field reflect.Value, key string, def interface{}, hasDefault bool) error {
+	switch ptr := field.Addr().Interface().(type) {
+	case *string:
+		cb.BindString(ptr, key, cb.toString(def))
+	case *int:
+		n, err := cb.toInt(def)
+		if err != nil {
+			return err
+		}
+		cb.BindInt(ptr, key, n)
+	case *int64:
+		n, err := cb.toInt64(def)
+		if err != nil {
+			return err
+		}
+		cb.BindInt64(ptr, key, n)
+	case *bool:
+		b, err := cb.toBool(def)
+		if err != nil {
+			return err
+		}
+		cb.BindBool(ptr, key, b)
+	case *float64:
+		f, err := cb.toFloat64(def)
+		if err != nil {
+			return err
+		}
+		cb.BindFloat64(ptr, key, f)
+	case *time.Duration:
+		d, err := cb.toDuration(def)
+		if err != nil {
+			return err
+		}
+		cb.BindDuration(ptr, key, d)
+	case *[]string:
+		list, err := convertSlice(def, cb.toStringElement)
+		if err != nil {
+			return err
+		}
+		cb.BindStringSlice(ptr, key, list)
+	case *[]int:
+		list, err := convertSlice(def, cb.toInt)
+		if err != nil {
+			return err
+		}
+		cb.BindIntSlice(ptr, key, list)
+	case *[]float64:
+		list, err := convertSlice(def, cb.toFloat64)
+		if err != nil {
+			return err
+		}
+		cb.BindFloat64Slice(ptr, key, list)
+	default:
+		cb.addCustomBinding(key, hasDefault, func(value interface{}, exists bool) error {
+			if !exists {
+				if !hasDefault {
+					return nil
+				}
+				value = def
+			}
+			converted := reflect.New(field.Type()).Elem()
+			if err := cb.assignField(converted, value); err != nil {
+				return err
+			}
+			if err := cb.validate(key, converted.Interface()); err != nil {
+				return err
+			}
+			field.Set(converted)
+			return nil
+		})
+	}
+	return nil
+}
+
+// decodeStructEntry decodes a raw map entry into a new value of elemType,
+// which is a struct or a pointer to a struct
+func (cb *ConfigBinder) decodeStructEntry(elemType reflect.Type, raw interface{}) (reflect.Value, error) {
+	src, ok := raw.(map[string]interface{})
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("expected a map, got %T", raw)
+	}
+
+	structType := elemType
+	if elemType.Kind() == reflect.Ptr {
+		structType = elemType.Elem()
+	}
+
+	elem := reflect.New(structType)
+	entryBinder := &ConfigBinder{config: src, strictNumeric: cb.strictNumeric, options: cb.options}
+	if err := entryBinder.decodeStruct(elem.Elem(), ""); err != nil {
+		return reflect.Value{}, err
+	}
+
+	if elemType.Kind() == reflect.Ptr {
+		return elem, nil
+	}
+	return elem.Elem(), nil
+}
+
+// decodeStruct assigns every tagged field of dst from cb.config, reading keys
+// under prefix. Absent keys use the tag default or leave the field untouched.
+func (cb *ConfigBinder) decodeStruct(dst reflect.Value, prefix string) error {
+	structType := dst.Type()
+	var errs []error
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key, defValue, hasDefault, skip := parseStructTag(field)
+		if skip {
+			continue
+		}
+		fullKey := prefix + key
+
+		if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+			if err := cb.decodeStruct(dst.Field(i), fullKey+"."); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		value, exists := cb.getValue(fullKey)
+		if !exists {
+			if !hasDefault {
+				continue
+			}
+			value = defValue
+		}
+
+		if err := cb.assignField(dst.Field(i), value); err != nil {
+			errs = append(errs, fmt.Errorf("field '%s': %w", fullKey, err))
+		}
+	}
+
+	return goerrors.Join(errs...)
+}
+
+// assignField converts value with the binder converters and stores it in field
+func (cb *ConfigBinder) assignField(field reflect.Value, value interface{}) error {
+	if field.Type() == durationType {
+		d, err := cb.toDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cb.toString(value))
+	case reflect.Bool:
+		b, err := cb.toBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := cb.toInt64(value)
+		if err != nil {
+			return err
+		}
+		if field.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, field.Type())
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := cb.toInt64(value)
+		if err != nil {
+			return err
+		}
+		if n < 0 || field.OverflowUint(uint64(n)) {
+			return fmt.Errorf("value %d out of range for %s", n, field.Type())
+		}
+		field.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, err := cb.toFloat64(value)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// parseStructTag reads `argus:"key,default"`. Untagged fields use the
+// lowercased field name; "-" skips the field.
+func parseStructTag(field reflect.StructField) (key, defValue string, hasDefault, skip bool) {
+	tag, tagged := field.Tag.Lookup(structTagName)
+	if tag == "-" {
+		return "", "", false, true
+	}
+
+	key, defValue, hasDefault = strings.Cut(tag, ",")
+	if !tagged || key == "" {
+		key = strings.ToLower(field.Name)
+	}
+	return key, defValue, hasDefault, false
+}
+
+// isStructOrStructPtr reports whether t is a struct or a pointer to a struct
+func isStructOrStructPtr(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
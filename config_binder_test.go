@@ -7,9 +7,13 @@
 package argus
 
 import (
+	goerrors "errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/agilira/go-errors"
 )
 
 func TestConfigBinder_BasicTypes(t *testing.T) {
@@ -674,3 +678,885 @@ func TestConfigBinder_TypeConversionToInt64(t *testing.T) {
 		t.Error("toInt64(true) should fail")
 	}
 }
+
+// TestConfigBinder_StrictNumeric verifies that strict mode rejects lossy
+// numeric coercions that lenient mode truncates
+func TestConfigBinder_StrictNumeric(t *testing.T) {
+	config := map[string]interface{}{
+		"replicas": 3.7,
+		"workers":  4.0,
+		"huge":     1e20,
+	}
+
+	var replicas int
+	if err := BindFromConfig(config).BindInt(&replicas, "replicas").Apply(); err != nil {
+		t.Fatalf("Lenient binding failed: %v", err)
+	}
+	if replicas != 3 {
+		t.Errorf("Expected lenient truncation to 3, got %d", replicas)
+	}
+
+	replicas = 0
+	err := BindFromConfig(config).StrictNumeric().BindInt(&replicas, "replicas").Apply()
+	if err == nil || !strings.Contains(err.Error(), "replicas") {
+		t.Errorf("Expected strict error naming 'replicas', got %v", err)
+	}
+	if replicas != 0 {
+		t.Errorf("Expected target untouched on error, got %d", replicas)
+	}
+
+	// Integral floats are not lossy
+	var workers int64
+	if err := BindFromConfig(config).StrictNumeric().BindInt64(&workers, "workers").Apply(); err != nil || workers != 4 {
+		t.Errorf("Expected workers=4 under strict mode, got %d, %v", workers, err)
+	}
+
+	var huge int64
+	if err := BindFromConfig(config).StrictNumeric().BindInt64(&huge, "huge").Apply(); err == nil {
+		t.Error("Expected overflow error under strict mode")
+	}
+}
+
+// TestConfigBinder_OptionalBindings verifies that pointer bindings distinguish
+// an explicitly set zero value from an absent key
+func TestConfigBinder_OptionalBindings(t *testing.T) {
+	config := map[string]interface{}{
+		"features": map[string]interface{}{
+			"cache":   false,
+			"metrics": true,
+		},
+		"workers": 0,
+		"timeout": "2s",
+	}
+
+	var (
+		cacheEnabled   *bool
+		metricsEnabled *bool
+		tracingEnabled *bool
+		workers        *int
+		retries        *int
+		timeout        *time.Duration
+		name           *string
+	)
+
+	err := BindFromConfig(config).
+		BindBoolPtr(&cacheEnabled, "features.cache").
+		BindBoolPtr(&metricsEnabled, "features.metrics").
+		BindBoolPtr(&tracingEnabled, "features.tracing").
+		BindIntPtr(&workers, "workers").
+		BindIntPtr(&retries, "retries").
+		BindDurationPtr(&timeout, "timeout").
+		BindStringPtr(&name, "name").
+		Apply()
+	if err != nil {
+		t.Fatalf("Binding failed: %v", err)
+	}
+
+	if cacheEnabled == nil || *cacheEnabled {
+		t.Errorf("Expected cache to be explicitly false, got %v", cacheEnabled)
+	}
+	if metricsEnabled == nil || !*metricsEnabled {
+		t.Errorf("Expected metrics to be explicitly true, got %v", metricsEnabled)
+	}
+	if tracingEnabled != nil {
+		t.Errorf("Expected tracing to be nil (absent), got %v", *tracingEnabled)
+	}
+	if workers == nil || *workers != 0 {
+		t.Errorf("Expected workers to be explicitly 0, got %v", workers)
+	}
+	if retries != nil {
+		t.Errorf("Expected retries to be nil (absent), got %d", *retries)
+	}
+	if timeout == nil || *timeout != 2*time.Second {
+		t.Errorf("Expected timeout=2s, got %v", timeout)
+	}
+	if name != nil {
+		t.Errorf("Expected name to be nil (absent), got %q", *name)
+	}
+
+	// A key absent from a later layer must leave the earlier value untouched
+	delete(config["features"].(map[string]interface{}), "cache")
+	if err := BindFromConfig(config).BindBoolPtr(&cacheEnabled, "features.cache").Apply(); err != nil {
+		t.Fatalf("Re-binding failed: %v", err)
+	}
+	if cacheEnabled == nil || *cacheEnabled {
+		t.Errorf("Expected cache=false to be retained from the earlier layer, got %v", cacheEnabled)
+	}
+
+	// Conversion errors still surface through Apply
+	var invalid *bool
+	err = BindFromConfig(map[string]interface{}{"flag": "maybe"}).
+		BindBoolPtr(&invalid, "flag").
+		Apply()
+	if err == nil {
+		t.Error("Expected error for invalid optional bool, got none")
+	}
+}
+
+type testLogLevel string
+
+const (
+	testLogDebug testLogLevel = "debug"
+	testLogInfo  testLogLevel = "info"
+	testLogWarn  testLogLevel = "warn"
+)
+
+type testColor int
+
+const (
+	testColorRed testColor = iota
+	testColorGreen
+	testColorBlue
+)
+
+func TestConfigBinder_TypedBindings(t *testing.T) {
+	config := map[string]interface{}{
+		"log":    map[string]interface{}{"level": "warn"},
+		"color":  "Green",
+		"accent": 2,
+	}
+	allowed := []testLogLevel{testLogDebug, testLogInfo, testLogWarn}
+	colors := map[string]testColor{"red": testColorRed, "green": testColorGreen, "blue": testColorBlue}
+
+	var (
+		level    testLogLevel
+		fallback testLogLevel
+		color    testColor
+		accent   testColor
+		missing  testColor
+	)
+
+	binder := BindFromConfig(config)
+	BindTyped(binder, &level, "log.level", allowed)
+	BindTyped(binder, &fallback, "log.fallback", allowed, testLogInfo)
+	BindEnum(binder, &color, "color", colors)
+	BindEnum(binder, &accent, "accent", colors)
+	BindEnum(binder, &missing, "missing", colors, testColorBlue)
+	if err := binder.Apply(); err != nil {
+		t.Fatalf("Binding failed: %v", err)
+	}
+
+	if level != testLogWarn {
+		t.Errorf("Expected level=warn, got %q", level)
+	}
+	if fallback != testLogInfo {
+		t.Errorf("Expected default level=info, got %q", fallback)
+	}
+	if color != testColorGreen {
+		t.Errorf("Expected color=green by name, got %d", color)
+	}
+	if accent != testColorBlue {
+		t.Errorf("Expected accent=blue by number, got %d", accent)
+	}
+	if missing != testColorBlue {
+		t.Errorf("Expected default color=blue, got %d", missing)
+	}
+
+	// Values outside the allowed set are rejected
+	invalidLevel := BindFromConfig(map[string]interface{}{"level": "verbose"})
+	BindTyped(invalidLevel, &level, "level", allowed)
+	if err := invalidLevel.Apply(); err == nil {
+		t.Error("Expected error for disallowed log level, got none")
+	}
+
+	for _, raw := range []interface{}{"purple", 7, "9"} {
+		invalidColor := BindFromConfig(map[string]interface{}{"color": raw})
+		BindEnum(invalidColor, &color, "color", colors)
+		if err := invalidColor.Apply(); err == nil {
+			t.Errorf("Expected error for invalid color %v, got none", raw)
+		}
+	}
+
+	// Defaults are validated like configured values
+	invalidDefault := BindFromConfig(map[string]interface{}{})
+	BindEnum(invalidDefault, &color, "color", colors, testColor(42))
+	if err := invalidDefault.Apply(); err == nil {
+		t.Error("Expected error for default color outside names, got none")
+	}
+}
+
+type testPoolConfig struct {
+	MaxOpen int           `argus:"max_open,10"`
+	Idle    time.Duration `argus:"idle,30s"`
+}
+
+type testDatabaseConfig struct {
+	Host     string         `argus:"host"`
+	Port     int            `argus:"port,5432"`
+	ReadOnly bool           `argus:"read_only"`
+	Pool     testPoolConfig `argus:"pool"`
+	Name     string
+	Internal string `argus:"-"`
+}
+
+func TestConfigBinder_BindMapOfStruct(t *testing.T) {
+	config := map[string]interface{}{
+		"databases": map[string]interface{}{
+			"primary": map[string]interface{}{
+				"host": "db1.internal",
+				"port": 5433,
+				"pool": map[string]interface{}{"max_open": 50, "idle": "1m"},
+				"name": "orders",
+			},
+			"replica": map[string]interface{}{
+				"host":      "db2.internal",
+				"read_only": true,
+				"internal":  "ignored",
+			},
+		},
+	}
+
+	var databases map[string]testDatabaseConfig
+	if err := BindFromConfig(config).BindMapOfStruct(&databases, "databases").Apply(); err != nil {
+		t.Fatalf("Binding failed: %v", err)
+	}
+
+	if len(databases) != 2 {
+		t.Fatalf("Expected 2 databases, got %d", len(databases))
+	}
+	primary := databases["primary"]
+	if primary.Host != "db1.internal" || primary.Port != 5433 || primary.ReadOnly || primary.Name != "orders" {
+		t.Errorf("Unexpected primary config: %+v", primary)
+	}
+	if primary.Pool.MaxOpen != 50 || primary.Pool.Idle != time.Minute {
+		t.Errorf("Expected nested pool {50 1m}, got %+v", primary.Pool)
+	}
+	replica := databases["replica"]
+	if replica.Host != "db2.internal" || replica.Port != 5432 || !replica.ReadOnly {
+		t.Errorf("Expected replica with default port, got %+v", replica)
+	}
+	if replica.Pool.MaxOpen != 10 || replica.Pool.Idle != 30*time.Second || replica.Internal != "" {
+		t.Errorf("Expected pool defaults and skipped field, got %+v", replica)
+	}
+
+	// Pointer elements are supported too
+	var byPtr map[string]*testDatabaseConfig
+	if err := BindFromConfig(config).BindMapOfStruct(&byPtr, "databases").Apply(); err != nil {
+		t.Fatalf("Binding pointer elements failed: %v", err)
+	}
+	if byPtr["replica"] == nil || byPtr["replica"].Host != "db2.internal" {
+		t.Errorf("Expected replica pointer entry, got %+v", byPtr["replica"])
+	}
+
+	// Per-entry errors are aggregated with the entry key; the target is untouched
+	invalid := map[string]interface{}{
+		"databases": map[string]interface{}{
+			"primary": map[string]interface{}{"port": "not-a-port"},
+			"replica": map[string]interface{}{"pool": map[string]interface{}{"max_open": "many"}},
+		},
+	}
+	err := BindFromConfig(invalid).BindMapOfStruct(&databases, "databases").Apply()
+	if err == nil {
+		t.Fatal("Expected error for invalid entries, got none")
+	}
+	detail := goerrors.Unwrap(goerrors.Unwrap(err)).Error()
+	for _, want := range []string{"entry 'primary'", "field 'port'", "entry 'replica'", "field 'pool.max_open'"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Expected error detail to mention %q, got: %s", want, detail)
+		}
+	}
+	if databases["primary"].Host != "db1.internal" {
+		t.Error("Expected target map to be left untouched on error")
+	}
+
+	// Invalid targets are rejected at registration
+	var notAMap []testDatabaseConfig
+	if err := BindFromConfig(config).BindMapOfStruct(&notAMap, "databases").Apply(); err == nil {
+		t.Error("Expected error for non-map target, got none")
+	}
+}
+
+func TestConfigBinder_BindDurationUnit(t *testing.T) {
+	config := map[string]interface{}{
+		"int":      30,
+		"int64":    int64(2),
+		"float":    1.5,
+		"numeric":  "45",
+		"string":   "250ms",
+		"duration": 3 * time.Minute,
+		"bad":      "soon",
+	}
+
+	tests := []struct {
+		key  string
+		unit time.Duration
+		want time.Duration
+	}{
+		{"int", time.Second, 30 * time.Second},
+		{"int64", time.Minute, 2 * time.Minute},
+		{"float", time.Second, 1500 * time.Millisecond},
+		{"numeric", time.Second, 45 * time.Second},
+		{"string", time.Second, 250 * time.Millisecond},
+		{"duration", time.Second, 3 * time.Minute},
+		{"missing", time.Second, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			var got time.Duration
+			err := BindFromConfig(config).
+				BindDurationUnit(&got, tt.key, tt.unit, 10*time.Second).
+				Apply()
+			if err != nil {
+				t.Fatalf("Failed to apply bindings: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// BindDuration keeps nanosecond semantics for bare numbers
+	var nanos time.Duration
+	if err := BindFromConfig(config).BindDuration(&nanos, "int").Apply(); err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+	if nanos != 30*time.Nanosecond {
+		t.Errorf("Expected BindDuration to read 30 as 30ns, got %v", nanos)
+	}
+
+	var d time.Duration
+	if err := BindFromConfig(config).BindDurationUnit(&d, "bad", time.Second).Apply(); err == nil {
+		t.Error("Expected error for an unparsable duration")
+	}
+	if err := BindFromConfig(config).BindDurationUnit(&d, "int", 0).Apply(); err == nil {
+		t.Error("Expected error for a non-positive unit")
+	}
+	huge := map[string]interface{}{"timeout": 1e12}
+	if err := BindFromConfig(huge).BindDurationUnit(&d, "timeout", time.Hour).Apply(); err == nil {
+		t.Error("Expected error for an out-of-range duration")
+	}
+}
+
+func TestConfigBinder_BindStringSlice(t *testing.T) {
+	config := map[string]interface{}{
+		"cors": map[string]interface{}{
+			"allowed_origins": []interface{}{"https://a.example", "https://b.example", 8080},
+		},
+		"csv":     "a, b ,c",
+		"empty":   "",
+		"typed":   []string{"x", "y"},
+		"nested":  []interface{}{"ok", map[string]interface{}{"k": "v"}, []interface{}{"z"}},
+		"invalid": 42,
+	}
+
+	var origins, csv, empty, typed, missing []string
+	err := BindFromConfig(config).
+		BindStringSlice(&origins, "cors.allowed_origins").
+		BindStringSlice(&csv, "csv").
+		BindStringSlice(&empty, "empty").
+		BindStringSlice(&typed, "typed").
+		BindStringSlice(&missing, "missing", []string{"*"}).
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+
+	if fmt.Sprint(origins) != "[https://a.example https://b.example 8080]" {
+		t.Errorf("Expected origins from a list, got %q", origins)
+	}
+	if fmt.Sprint(csv) != "[a b c]" {
+		t.Errorf("Expected trimmed comma-separated elements, got %q", csv)
+	}
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("Expected an empty non-nil slice for an empty string, got %#v", empty)
+	}
+	if fmt.Sprint(typed) != "[x y]" {
+		t.Errorf("Expected typed []string to bind as-is, got %q", typed)
+	}
+	if fmt.Sprint(missing) != "[*]" {
+		t.Errorf("Expected default for missing key, got %q", missing)
+	}
+
+	// Non-scalar elements fail with their index; the target is untouched
+	nested := []string{"keep"}
+	err = BindFromConfig(config).BindStringSlice(&nested, "nested").Apply()
+	if err == nil {
+		t.Fatal("Expected error for non-scalar elements")
+	}
+	detail := goerrors.Unwrap(goerrors.Unwrap(err)).Error()
+	for _, want := range []string{"element 1", "element 2"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Expected error detail to mention %q, got: %s", want, detail)
+		}
+	}
+	if len(nested) != 1 || nested[0] != "keep" {
+		t.Errorf("Expected target untouched on error, got %q", nested)
+	}
+
+	if err := BindFromConfig(config).BindStringSlice(&nested, "invalid").Apply(); err == nil {
+		t.Error("Expected error for a non-list value")
+	}
+}
+
+func TestConfigBinder_BindNumericSlices(t *testing.T) {
+	config := map[string]interface{}{
+		"retry":    map[string]interface{}{"backoff_steps": []interface{}{1, "2", int64(4), 8.0}},
+		"sampling": map[string]interface{}{"rates": []interface{}{0.1, "0.5", 1}},
+		"csv":      "3, 5,7",
+		"bad":      []interface{}{1, "two", 3, "four"},
+		"fraction": []interface{}{1, 2.5},
+	}
+
+	var steps, csv, missing []int
+	var rates []float64
+	err := BindFromConfig(config).
+		BindIntSlice(&steps, "retry.backoff_steps").
+		BindIntSlice(&csv, "csv").
+		BindIntSlice(&missing, "missing", []int{1, 2}).
+		BindFloat64Slice(&rates, "sampling.rates").
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+
+	if fmt.Sprint(steps) != "[1 2 4 8]" {
+		t.Errorf("Expected mixed-type steps to convert, got %v", steps)
+	}
+	if fmt.Sprint(csv) != "[3 5 7]" {
+		t.Errorf("Expected comma-separated ints, got %v", csv)
+	}
+	if fmt.Sprint(missing) != "[1 2]" {
+		t.Errorf("Expected default for missing key, got %v", missing)
+	}
+	if fmt.Sprint(rates) != "[0.1 0.5 1]" {
+		t.Errorf("Expected mixed-type rates to convert, got %v", rates)
+	}
+
+	// All element errors are reported at once
+	var bad []int
+	err = BindFromConfig(config).BindIntSlice(&bad, "bad").Apply()
+	if err == nil {
+		t.Fatal("Expected error for unconvertible elements")
+	}
+	detail := goerrors.Unwrap(goerrors.Unwrap(err)).Error()
+	for _, want := range []string{"element 1", "element 3"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Expected error detail to mention %q, got: %s", want, detail)
+		}
+	}
+	if bad != nil {
+		t.Errorf("Expected target untouched on error, got %v", bad)
+	}
+
+	// StrictNumeric applies to every element
+	var fractions []int
+	if err := BindFromConfig(config).BindIntSlice(&fractions, "fraction").Apply(); err != nil || fmt.Sprint(fractions) != "[1 2]" {
+		t.Errorf("Expected lenient truncation, got %v (%v)", fractions, err)
+	}
+	if err := BindFromConfig(config).StrictNumeric().BindIntSlice(&fractions, "fraction").Apply(); err == nil {
+		t.Error("Expected strict numeric binding to reject 2.5")
+	}
+}
+
+func TestConfigBinder_BindStruct(t *testing.T) {
+	type tlsConfig struct {
+		Enabled bool   `argus:"enabled"`
+		Cert    string `argus:"cert_file,/etc/tls.pem"`
+	}
+	type serverConfig struct {
+		Host     string        `argus:"host,localhost"`
+		Port     int           `argus:"port,8080"`
+		Timeout  time.Duration `argus:"timeout,30s"`
+		MaxBytes int64         `argus:"max_bytes"`
+		Ratio    float64       `argus:"ratio,0.5"`
+		Workers  uint16        `argus:"workers,4"`
+		Origins  []string      `argus:"origins,a,b"`
+		Steps    []int         `argus:"steps"`
+		Region   string        // untagged: key "region"
+		Skip     string        `argus:"-"`
+		TLS      tlsConfig     `argus:"tls"`
+		internal string
+	}
+
+	config := map[string]interface{}{
+		"server": map[string]interface{}{
+			"port":    9090,
+			"timeout": "5s",
+			"steps":   []interface{}{1, "2", 4},
+			"workers": 16,
+			"skip":    "ignored",
+			"tls":     map[string]interface{}{"enabled": true},
+		},
+	}
+
+	server := serverConfig{MaxBytes: 1024, Region: "eu-west", Skip: "kept", internal: "x"}
+	if err := BindFromConfig(config).BindStruct(&server, "server").Apply(); err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+
+	if server.Port != 9090 || server.Timeout != 5*time.Second || fmt.Sprint(server.Steps) != "[1 2 4]" || server.Workers != 16 {
+		t.Errorf("Expected values from config, got %+v", server)
+	}
+	if server.Host != "localhost" || server.Ratio != 0.5 || fmt.Sprint(server.Origins) != "[a b]" || server.TLS.Cert != "/etc/tls.pem" {
+		t.Errorf("Expected tag defaults for absent keys, got %+v", server)
+	}
+	if server.MaxBytes != 1024 || server.Region != "eu-west" {
+		t.Errorf("Expected preset values to survive absent keys, got %+v", server)
+	}
+	if !server.TLS.Enabled || server.Skip != "kept" || server.internal != "x" {
+		t.Errorf("Expected nested binding and skipped fields untouched, got %+v", server)
+	}
+
+	// Conversion errors name the dotted key
+	invalid := map[string]interface{}{"server": map[string]interface{}{"tls": map[string]interface{}{"enabled": "maybe"}}}
+	err := BindFromConfig(invalid).BindStruct(&server, "server").Apply()
+	if err == nil || !strings.Contains(err.Error(), "server.tls.enabled") {
+		t.Errorf("Expected error naming server.tls.enabled, got %v", err)
+	}
+
+	// Invalid targets and tag defaults are rejected at registration
+	if err := BindFromConfig(config).BindStruct(server, "server").Apply(); err == nil {
+		t.Error("Expected error for a non-pointer target")
+	}
+	var badDefault struct {
+		Port int `argus:"port,eighty"`
+	}
+	if err := BindFromConfig(config).BindStruct(&badDefault, "").Apply(); err == nil {
+		t.Error("Expected error for an unparsable tag default")
+	}
+}
+
+func TestConfigBinder_WithValidator(t *testing.T) {
+	config := map[string]interface{}{
+		"server": map[string]interface{}{"port": 70000, "host": "example.com"},
+		"log":    map[string]interface{}{"level": "verbose"},
+		"hosts":  []interface{}{"a", ""},
+	}
+
+	portRange := func(v interface{}) error {
+		if p := v.(int); p < 1 || p > 65535 {
+			return fmt.Errorf("port %d out of range [1, 65535]", p)
+		}
+		return nil
+	}
+	oneOf := func(allowed ...string) func(interface{}) error {
+		return func(v interface{}) error {
+			for _, a := range allowed {
+				if v == a {
+					return nil
+				}
+			}
+			return fmt.Errorf("%v is not one of %v", v, allowed)
+		}
+	}
+
+	// A rejected value fails Apply with the key and is never assigned
+	port := 8080
+	err := BindFromConfig(config).
+		BindInt(&port, "server.port").
+		WithValidator("server.port", portRange).
+		Apply()
+	if err == nil || !strings.Contains(err.Error(), "server.port") {
+		t.Fatalf("Expected validation error naming server.port, got %v", err)
+	}
+	if !strings.Contains(goerrors.Unwrap(goerrors.Unwrap(err)).Error(), "out of range") {
+		t.Errorf("Expected validator message in error chain, got %v", err)
+	}
+	if port != 8080 {
+		t.Errorf("Expected target untouched after failed validation, got %d", port)
+	}
+
+	// Defaults, custom bindings and optional bindings are validated too
+	var level, host string
+	var hostPtr *string
+	var hosts []string
+	tests := []struct {
+		name    string
+		binder  *ConfigBinder
+		wantErr bool
+	}{
+		{"valid string", BindFromConfig(config).BindString(&host, "server.host").
+			WithValidator("server.host", oneOf("example.com")), false},
+		{"invalid default", BindFromConfig(config).BindInt(&port, "missing", 0).
+			WithValidator("missing", portRange), true},
+		{"typed binding", BindTyped(BindFromConfig(config), &level, "log.level", nil).
+			WithValidator("log.level", oneOf("debug", "info")), true},
+		{"optional binding", BindFromConfig(config).BindStringPtr(&hostPtr, "server.host").
+			WithValidator("server.host", oneOf("other.com")), true},
+		{"absent optional", BindFromConfig(config).BindStringPtr(&hostPtr, "missing").
+			WithValidator("missing", oneOf("never")), false},
+		{"slice binding", BindFromConfig(config).BindStringSlice(&hosts, "hosts").
+			WithValidator("hosts", func(v interface{}) error {
+				for _, h := range v.([]string) {
+					if h == "" {
+						return fmt.Errorf("empty host")
+					}
+				}
+				return nil
+			}), true},
+		{"nil validator", BindFromConfig(config).WithValidator("server.host", nil), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.binder.Apply(); (err != nil) != tt.wantErr {
+				t.Errorf("Apply() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if hostPtr != nil || hosts != nil || level != "" {
+		t.Errorf("Expected rejected values to stay unassigned, got %v %v %q", hostPtr, hosts, level)
+	}
+}
+
+func TestConfigBinder_Required(t *testing.T) {
+	config := map[string]interface{}{
+		"database": map[string]interface{}{"port": 5432},
+	}
+
+	host, user := "preset", "preset"
+	var port int
+	err := BindFromConfig(config).
+		BindString(&host, "database.host").Required().
+		BindInt(&port, "database.port").Required().
+		BindString(&user, "database.user").Required().
+		Apply()
+	if err == nil {
+		t.Fatal("Expected error for missing required keys")
+	}
+	if !errors.HasCode(err, ErrCodeMissingRequiredKey) {
+		t.Errorf("Expected %s, got %v", ErrCodeMissingRequiredKey, err)
+	}
+	for _, key := range []string{"database.host", "database.user"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Expected error to list %s, got %v", key, err)
+		}
+	}
+	if strings.Contains(err.Error(), "database.port") {
+		t.Errorf("Expected present key not to be listed, got %v", err)
+	}
+	if host != "preset" || user != "preset" || port != 0 {
+		t.Errorf("Expected no target assigned on error, got %q %q %d", host, user, port)
+	}
+
+	// Present required keys bind normally; non-required keys keep defaults
+	if err := BindFromConfig(config).
+		BindInt(&port, "database.port").Required().
+		BindString(&host, "database.host", "localhost").
+		Apply(); err != nil || port != 5432 || host != "localhost" {
+		t.Errorf("Expected successful bind, got port=%d host=%q err=%v", port, host, err)
+	}
+
+	if err := BindFromConfig(config).Required().Apply(); err == nil {
+		t.Error("Expected error for Required without a preceding Bind")
+	}
+}
+
+func TestConfigBinder_ApplyWithResult(t *testing.T) {
+	config := map[string]interface{}{
+		"server":  map[string]interface{}{"port": "not-a-port", "host": "example.com"},
+		"timeout": "soon",
+		"debug":   true,
+	}
+
+	var port int
+	var host, name string
+	var timeout time.Duration
+	var debug bool
+	var replicas *int
+	result, err := BindFromConfig(config).
+		BindInt(&port, "server.port", 8080).
+		BindString(&host, "server.host").
+		BindDuration(&timeout, "timeout").
+		BindString(&name, "app.name", "argus").
+		BindBool(&debug, "debug").
+		BindIntPtr(&replicas, "replicas").
+		BindString(&name, "app.owner").Required().
+		ApplyWithResult()
+	if err == nil {
+		t.Fatal("Expected an error for failing bindings")
+	}
+	if !result.Failed() || len(result.Errors) != 3 {
+		t.Fatalf("Expected 3 failing keys, got %v", result.Errors)
+	}
+	for key, code := range map[string]errors.ErrorCode{
+		"server.port": ErrCodeInvalidConfig,
+		"timeout":     ErrCodeInvalidConfig,
+		"app.owner":   ErrCodeMissingRequiredKey,
+	} {
+		if !errors.HasCode(result.Errors[key], code) {
+			t.Errorf("Expected %s for %s, got %v", code, key, result.Errors[key])
+		}
+	}
+
+	// The rest is bound despite the failures
+	if host != "example.com" || name != "argus" || !debug || port != 0 || replicas != nil {
+		t.Errorf("Expected valid keys bound and failing targets untouched, got host=%q name=%q debug=%v port=%d", host, name, debug, port)
+	}
+	if fmt.Sprint(result.BoundKeys) != "[server.host app.name debug]" {
+		t.Errorf("Unexpected bound keys: %v", result.BoundKeys)
+	}
+	if fmt.Sprint(result.DefaultedKeys) != "[app.name]" {
+		t.Errorf("Unexpected defaulted keys: %v", result.DefaultedKeys)
+	}
+
+	// All bindings succeed: no error, nothing failed
+	result, err = BindFromConfig(config).BindString(&host, "server.host").ApplyWithResult()
+	if err != nil || result.Failed() {
+		t.Errorf("Expected success, got %v %v", err, result.Errors)
+	}
+
+	// Registration errors have no per-key result
+	if result, err := BindFromConfig(config).Required().ApplyWithResult(); err == nil || result != nil {
+		t.Errorf("Expected a registration error and nil result, got %v %v", result, err)
+	}
+}
+
+func TestConfigBinder_NormalizedLookup(t *testing.T) {
+	config := map[string]interface{}{
+		"DATABASE_HOST":      "env-host",
+		"DATABASE_MAX_CONNS": "20",
+		"Server":             map[string]interface{}{"Port": 8080},
+		"log":                map[string]interface{}{"level": "info"},
+		"log_level":          "debug",
+	}
+
+	var host, level, plainHost string
+	var maxConns, port int
+	err := NewConfigBinderWithOptions(config, BinderOptions{CaseInsensitive: true, EnvStyleKeys: true}).
+		BindString(&host, "database.host").
+		BindInt(&maxConns, "database.max_conns").
+		BindInt(&port, "server.port").
+		BindString(&level, "log_level").
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+	if host != "env-host" || maxConns != 20 || port != 8080 {
+		t.Errorf("Expected normalized matches, got host=%q max_conns=%d port=%d", host, maxConns, port)
+	}
+	if level != "debug" {
+		t.Errorf("Expected exact match to win over normalized log.level, got %q", level)
+	}
+
+	// Case only: separators must still match
+	err = NewConfigBinderWithOptions(config, BinderOptions{CaseInsensitive: true}).
+		BindInt(&port, "SERVER.PORT").
+		BindString(&plainHost, "database.host", "none").
+		Apply()
+	if err != nil || port != 8080 || plainHost != "none" {
+		t.Errorf("Expected case-only matching, got port=%d host=%q err=%v", port, plainHost, err)
+	}
+
+	// Without options lookups stay exact
+	if err := NewConfigBinder(config).BindString(&plainHost, "database.host", "none").Apply(); err != nil || plainHost != "none" {
+		t.Errorf("Expected exact lookup by default, got %q (%v)", plainHost, err)
+	}
+}
+
+func TestConfigBinder_ListIndexKeys(t *testing.T) {
+	config := map[string]interface{}{
+		"servers": []interface{}{
+			map[string]interface{}{"host": "a.local", "port": 8080},
+			map[string]interface{}{"host": "b.local", "port": "8081"},
+		},
+		"tags": []interface{}{"blue", "green"},
+	}
+
+	var host string
+	var port int
+	var tag string
+	err := NewConfigBinder(config).
+		BindString(&host, "servers.1.host").
+		BindInt(&port, "servers.1.port").
+		BindString(&tag, "tags.0").
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+	if host != "b.local" || port != 8081 || tag != "blue" {
+		t.Errorf("Expected indexed values, got host=%q port=%d tag=%q", host, port, tag)
+	}
+
+	tests := map[string]string{
+		"servers.2.port":     "index 2 out of range for list 'servers' of length 2",
+		"servers.-1.port":    "negative index -1 for list 'servers'",
+		"servers.first.port": "'servers' is a list, segment 'first' is not an index",
+	}
+	for key, want := range tests {
+		err := NewConfigBinder(config).BindInt(&port, key, 1).Required().Apply()
+		if err == nil {
+			t.Errorf("Expected an error for %s", key)
+			continue
+		}
+		if !errors.HasCode(err, ErrCodeInvalidConfig) {
+			t.Errorf("Expected %s for %s, got %v", ErrCodeInvalidConfig, key, err)
+		}
+		if detail := goerrors.Unwrap(err).Error(); !strings.Contains(detail, want) {
+			t.Errorf("Expected %q for %s, got: %s", want, key, detail)
+		}
+	}
+
+	// A scalar where a list or map is expected is simply absent
+	if err := NewConfigBinder(config).BindInt(&port, "tags.0.port", 9).Apply(); err != nil || port != 9 {
+		t.Errorf("Expected default for key below a scalar, got %d (%v)", port, err)
+	}
+}
+
+func TestConfigBinder_WithDefaultsMap(t *testing.T) {
+	config := map[string]interface{}{
+		"server": map[string]interface{}{"port": 9000},
+	}
+	defaults := map[string]interface{}{
+		"server":   map[string]interface{}{"port": 8080, "host": "0.0.0.0"},
+		"log":      map[string]interface{}{"level": "warn"},
+		"database": map[string]interface{}{"host": "db.local"},
+	}
+
+	var port int
+	var host, level, dbHost, missing string
+	result, err := NewConfigBinder(config).
+		WithDefaults(defaults).
+		BindInt(&port, "server.port").
+		BindString(&host, "server.host").
+		BindString(&level, "log.level", "info").
+		BindString(&dbHost, "database.host").Required().
+		BindString(&missing, "cache.host").
+		ApplyWithResult()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+
+	if port != 9000 {
+		t.Errorf("Expected config to win over defaults, got port %d", port)
+	}
+	if host != "0.0.0.0" || dbHost != "db.local" {
+		t.Errorf("Expected values from defaults map, got host=%q database.host=%q", host, dbHost)
+	}
+	if level != "info" {
+		t.Errorf("Expected inline default to win over defaults map, got %q", level)
+	}
+	if missing != "" {
+		t.Errorf("Expected zero value for key absent everywhere, got %q", missing)
+	}
+	want := "server.host,log.level,database.host,cache.host"
+	if got := strings.Join(result.DefaultedKeys, ","); got != want {
+		t.Errorf("Expected defaulted keys %s, got %s", want, got)
+	}
+}
+
+func TestConfigBinder_WithDefaultsMapBindStruct(t *testing.T) {
+	var cfg struct {
+		Host    string `argus:"host"`
+		Port    int    `argus:"port,5432"`
+		Timeout int    `argus:"timeout"`
+	}
+	cfg.Timeout = 5
+
+	defaults := map[string]interface{}{
+		"db": map[string]interface{}{"host": "db.local", "port": 6432, "timeout": 30},
+	}
+	err := NewConfigBinder(map[string]interface{}{}).
+		WithDefaults(defaults).
+		BindStruct(&cfg, "db").
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+	if cfg.Host != "db.local" || cfg.Timeout != 30 {
+		t.Errorf("Expected defaults map over field values, got host=%q timeout=%d", cfg.Host, cfg.Timeout)
+	}
+	if cfg.Port != 5432 {
+		t.Errorf("Expected tag default to win over defaults map, got %d", cfg.Port)
+	}
+}
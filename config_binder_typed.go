@@ -0,0 +1,146 @@
+// config_binder_typed.go: Generic bindings for custom typed constants (enums)
+//
+// Go methods cannot declare type parameters, so the generic binders are
+// package-level functions taking the ConfigBinder as first argument. They
+// chain naturally with the fluent API:
+//
+//	type LogLevel string
+//
+//	binder := argus.BindFromConfig(config).BindInt(&port, "server.port")
+//	argus.BindTyped(binder, &level, "log.level", []LogLevel{Debug, Info, Warn}, Info)
+//	err := binder.Apply()
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/agilira/go-errors"
+)
+
+// BindTyped binds a value into a custom string type (e.g. type LogLevel string).
+// When allowed is non-empty the value must match one of its entries exactly,
+// otherwise Apply returns an error listing the accepted values. When the key
+// is absent the optional default is used (and validated); without a default
+// the target is set to the zero value.
+func BindTyped[T ~string](cb *ConfigBinder, target *T, key string, allowed []T, defaultVal ...T) *ConfigBinder {
+	return cb.addCustomBinding(key, len(defaultVal) > 0, func(value interface{}, exists bool) error {
+		var val T
+		switch {
+		case exists:
+			val = T(cb.toString(value))
+		case len(defaultVal) > 0:
+			val = defaultVal[0]
+		default:
+			return setValidated(cb, key, target, val)
+		}
+
+		if len(allowed) > 0 && !containsTyped(allowed, val) {
+			names := make([]string, len(allowed))
+			for i, a := range allowed {
+				names[i] = string(a)
+			}
+			return errors.New(ErrCodeInvalidConfig,
+				fmt.Sprintf("invalid value '%s' (allowed: %s)", string(val), strings.Join(names, ", ")))
+		}
+
+		return setValidated(cb, key, target, val)
+	})
+}
+
+// BindEnum binds a value into a custom integer type (e.g. iota-based enums).
+// The configuration value may be either a name from names or a numeric value;
+// when names is non-empty, numeric values must correspond to one of its entries.
+// Name lookup is case-insensitive. When the key is absent the optional default
+// is used (and validated); without a default the target is set to the zero value.
+func BindEnum[T ~int](cb *ConfigBinder, target *T, key string, names map[string]T, defaultVal ...T) *ConfigBinder {
+	return cb.addCustomBinding(key, len(defaultVal) > 0, func(value interface{}, exists bool) error {
+		var val T
+		switch {
+		case exists:
+			parsed, err := parseEnum(cb, value, names)
+			if err != nil {
+				return err
+			}
+			val = parsed
+		case len(defaultVal) > 0:
+			val = defaultVal[0]
+			if err := checkEnumValue(val, names); err != nil {
+				return err
+			}
+		}
+
+		return setValidated(cb, key, target, val)
+	})
+}
+
+// parseEnum resolves an enum value from its name or numeric representation
+func parseEnum[T ~int](cb *ConfigBinder, value interface{}, names map[string]T) (T, error) {
+	if s, ok := value.(string); ok {
+		trimmed := strings.TrimSpace(s)
+		for name, v := range names {
+			if strings.EqualFold(name, trimmed) {
+				return v, nil
+			}
+		}
+		if _, err := strconv.Atoi(trimmed); err != nil {
+			return 0, errors.New(ErrCodeInvalidConfig,
+				fmt.Sprintf("unknown value '%s' (allowed: %s)", s, enumNames(names)))
+		}
+		value = trimmed
+	}
+
+	n, err := cb.toInt(value)
+	if err != nil {
+		return 0, err
+	}
+
+	val := T(n)
+	if err := checkEnumValue(val, names); err != nil {
+		return 0, err
+	}
+	return val, nil
+}
+
+// checkEnumValue verifies that val is one of the values in names.
+// An empty names map accepts any value.
+func checkEnumValue[T ~int](val T, names map[string]T) error {
+	if len(names) == 0 {
+		return nil
+	}
+	for _, v := range names {
+		if v == val {
+			return nil
+		}
+	}
+
+	return errors.New(ErrCodeInvalidConfig,
+		fmt.Sprintf("invalid value %d (allowed: %s)", int(val), enumNames(names)))
+}
+
+// containsTyped reports whether val is one of allowed
+func containsTyped[T comparable](allowed []T, val T) bool {
+	for _, a := range allowed {
+		if a == val {
+			return true
+		}
+	}
+	return false
+}
+
+// enumNames returns the sorted names of an enum map for error messages
+func enumNames[T ~int](names map[string]T) string {
+	keys := make([]string, 0, len(names))
+	for name := range names {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
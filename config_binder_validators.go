@@ -0,0 +1,83 @@
+// config_binder_validators.go: Per-key constraints checked during Apply
+//
+// Range and membership checks usually follow binding in a second pass over
+// the bound variables. Validators registered on the binder run on the
+// converted value right before it is assigned, so a rejected value never
+// reaches the target and the error names the offending key.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"github.com/agilira/go-errors"
+)
+
+// WithValidator registers fn for every binding of key. Apply calls fn with
+// the converted value (int for BindInt, []string for BindStringSlice, the
+// default when the key is absent) before assigning it; an error fails Apply
+// and names the key. Several validators on one key run in registration
+// order. Optional bindings are only validated when the key is present.
+//
+// Example:
+//
+//	err := argus.BindFromConfig(config).
+//	    BindInt(&port, "server.port", 8080).
+//	    WithValidator("server.port", func(v interface{}) error {
+//	        if p := v.(int); p < 1 || p > 65535 {
+//	            return fmt.Errorf("port %d out of range [1, 65535]", p)
+//	        }
+//	        return nil
+//	    }).
+//	    Apply()
+func (cb *ConfigBinder) WithValidator(key string, fn func(value interface{}) error) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+	if fn == nil {
+		cb.err = errors.New(ErrCodeInvalidConfig, "validator for key '"+key+"' cannot be nil")
+		return cb
+	}
+
+	if cb.validators == nil {
+		cb.validators = make(map[string][]func(value interface{}) error)
+	}
+	cb.validators[key] = append(cb.validators[key], fn)
+	return cb
+}
+
+// validate runs the validators of key against value
+func (cb *ConfigBinder) validate(key string, value interface{}) error {
+	for _, fn := range cb.validators[key] {
+		if err := fn(value); err != nil {
+			return errors.Wrap(err, ErrCodeInvalidConfig, "validation failed for key '"+key+"'")
+		}
+	}
+	return nil
+}
+
+// setValidated assigns val to target once the validators of key accept it.
+// Without validators nothing is boxed, keeping the binding allocation-free.
+func setValidated[T any](cb *ConfigBinder, key string, target *T, val T) error {
+	if cb.validators != nil {
+		if err := cb.validate(key, val); err != nil {
+			return err
+		}
+	}
+	*target = val
+	return nil
+}
+
+// setValidatedPtr is setValidated for optional bindings: validators see the
+// value, the target receives a pointer to it
+func setValidatedPtr[T any](cb *ConfigBinder, key string, target **T, val T) error {
+	if cb.validators != nil {
+		if err := cb.validate(key, val); err != nil {
+			return err
+		}
+	}
+	*target = &val
+	return nil
+}
@@ -0,0 +1,53 @@
+// config_builder.go: Fluent builder methods for Config
+//
+// Most programs only change a few fields of Config. The With* methods set one
+// field each on a copy of the configuration, so they chain with each other
+// and with WithDefaults and never modify a shared template:
+//
+//	config := (&argus.Config{}).
+//	    WithPollInterval(time.Second).
+//	    WithMaxWatchedFiles(10).
+//	    WithDefaults()
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "time"
+
+// WithPollInterval returns a copy of the configuration polling every interval
+func (c *Config) WithPollInterval(interval time.Duration) *Config {
+	config := c.Clone()
+	config.PollInterval = interval
+	return &config
+}
+
+// WithCacheTTL returns a copy of the configuration caching os.Stat results for ttl
+func (c *Config) WithCacheTTL(ttl time.Duration) *Config {
+	config := c.Clone()
+	config.CacheTTL = ttl
+	return &config
+}
+
+// WithMaxWatchedFiles returns a copy of the configuration watching at most n files
+func (c *Config) WithMaxWatchedFiles(n int) *Config {
+	config := c.Clone()
+	config.MaxWatchedFiles = n
+	return &config
+}
+
+// WithOptimization returns a copy of the configuration using the given BoreasLite strategy
+func (c *Config) WithOptimization(strategy OptimizationStrategy) *Config {
+	config := c.Clone()
+	config.OptimizationStrategy = strategy
+	return &config
+}
+
+// WithAudit returns a copy of the configuration using the given audit configuration
+func (c *Config) WithAudit(audit AuditConfig) *Config {
+	config := c.Clone()
+	config.Audit = audit
+	return &config
+}
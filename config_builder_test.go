@@ -0,0 +1,66 @@
+// config_builder_test.go: Tests for the fluent Config builder methods
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfigBuilder_Chain(t *testing.T) {
+	base := &Config{PollInterval: 5 * time.Second}
+
+	config := base.
+		WithPollInterval(time.Second).
+		WithCacheTTL(200 * time.Millisecond).
+		WithMaxWatchedFiles(10).
+		WithOptimization(OptimizationSingleEvent).
+		WithAudit(AuditConfig{Enabled: false, MinLevel: AuditWarn})
+
+	if config.PollInterval != time.Second {
+		t.Errorf("Expected PollInterval 1s, got %v", config.PollInterval)
+	}
+	if config.CacheTTL != 200*time.Millisecond {
+		t.Errorf("Expected CacheTTL 200ms, got %v", config.CacheTTL)
+	}
+	if config.MaxWatchedFiles != 10 {
+		t.Errorf("Expected MaxWatchedFiles 10, got %d", config.MaxWatchedFiles)
+	}
+	if config.OptimizationStrategy != OptimizationSingleEvent {
+		t.Errorf("Expected OptimizationSingleEvent, got %v", config.OptimizationStrategy)
+	}
+	if config.Audit.Enabled || config.Audit.MinLevel != AuditWarn {
+		t.Errorf("Expected custom audit configuration, got %+v", config.Audit)
+	}
+
+	if base.PollInterval != 5*time.Second || base.MaxWatchedFiles != 0 {
+		t.Errorf("Expected base configuration unchanged, got %+v", base)
+	}
+}
+
+func TestConfigBuilder_WithDefaults(t *testing.T) {
+	config := (&Config{}).
+		WithPollInterval(2 * time.Second).
+		WithMaxWatchedFiles(3).
+		WithDefaults()
+
+	if config.PollInterval != 2*time.Second {
+		t.Errorf("Expected PollInterval 2s, got %v", config.PollInterval)
+	}
+	if config.CacheTTL != time.Second {
+		t.Errorf("Expected default CacheTTL of PollInterval/2, got %v", config.CacheTTL)
+	}
+	if config.MaxWatchedFiles != 3 {
+		t.Errorf("Expected MaxWatchedFiles 3, got %d", config.MaxWatchedFiles)
+	}
+
+	// Builders applied after WithDefaults override the defaulted values
+	config = config.WithCacheTTL(500 * time.Millisecond)
+	if config.CacheTTL != 500*time.Millisecond || config.PollInterval != 2*time.Second {
+		t.Errorf("Expected CacheTTL 500ms with PollInterval 2s, got %v and %v", config.CacheTTL, config.PollInterval)
+	}
+}
@@ -0,0 +1,81 @@
+// config_flatten.go: Flattening of nested configuration into dotted keys
+//
+// Metrics tags, template contexts and key-value stores want configuration as
+// a flat map[string]string. Flatten produces that shape, the inverse of the
+// nested lookups done by the binder:
+//
+//	server:
+//	  port: 8080
+//	  hosts: [a, b]
+//
+// becomes {"server.port": "8080", "server.hosts[0]": "a", "server.hosts[1]": "b"}.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Flatten converts a nested configuration into a flat map with dotted keys
+// and stringified values. Nested maps join keys with "."; list elements use
+// bracket indices, so the first host of server.hosts is "server.hosts[0]" and
+// a field of a list of maps is "servers[1].port". Nil values become "", and
+// empty maps and lists produce no keys.
+//
+// Example:
+//
+//	flat := argus.Flatten(config)
+//	for key, value := range flat {
+//	    tags = append(tags, key+"="+value)
+//	}
+func Flatten(config map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(config))
+	flattenInto(result, "", config)
+	return result
+}
+
+// Flatten returns the binder's configuration flattened to dotted keys,
+// honoring the profile selected with UseProfile. See the Flatten function.
+// A binder in error state (e.g. an unknown profile) returns an empty map;
+// the error is reported by Apply.
+func (cb *ConfigBinder) Flatten() map[string]string {
+	if cb.err != nil {
+		return map[string]string{}
+	}
+	return Flatten(cb.config)
+}
+
+// flattenInto writes value under key into result, descending into maps and lists
+func flattenInto(result map[string]string, key string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			flattenInto(result, joinKeyPath(key, k), child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flattenInto(result, key+"["+strconv.Itoa(i)+"]", child)
+		}
+	case nil:
+		result[key] = ""
+	case string:
+		result[key] = v
+	case []byte:
+		result[key] = string(v)
+	default:
+		// Typed slices from custom parsers ([]string, []map[string]interface{})
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice {
+			for i := 0; i < rv.Len(); i++ {
+				flattenInto(result, key+"["+strconv.Itoa(i)+"]", rv.Index(i).Interface())
+			}
+			return
+		}
+		result[key] = fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,68 @@
+// config_flatten_test.go: Tests for flattening nested configuration
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	config := map[string]interface{}{
+		"name": "api",
+		"server": map[string]interface{}{
+			"port":    8080,
+			"debug":   false,
+			"timeout": 1.5,
+			"hosts":   []interface{}{"a.example.com", "b.example.com"},
+		},
+		"upstreams": []interface{}{
+			map[string]interface{}{"host": "db1", "port": 5432},
+			map[string]interface{}{"host": "db2", "tags": []string{"replica"}},
+		},
+		"matrix":   []interface{}{[]interface{}{1, 2}},
+		"optional": nil,
+		"empty":    map[string]interface{}{},
+	}
+
+	want := map[string]string{
+		"name":                 "api",
+		"server.port":          "8080",
+		"server.debug":         "false",
+		"server.timeout":       "1.5",
+		"server.hosts[0]":      "a.example.com",
+		"server.hosts[1]":      "b.example.com",
+		"upstreams[0].host":    "db1",
+		"upstreams[0].port":    "5432",
+		"upstreams[1].host":    "db2",
+		"upstreams[1].tags[0]": "replica",
+		"matrix[0][0]":         "1",
+		"matrix[0][1]":         "2",
+		"optional":             "",
+	}
+
+	if got := Flatten(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten mismatch:\n got: %v\nwant: %v", got, want)
+	}
+}
+
+func TestConfigBinder_Flatten(t *testing.T) {
+	config := map[string]interface{}{
+		"default":    map[string]interface{}{"port": 8080, "host": "localhost"},
+		"production": map[string]interface{}{"host": "prod.example.com"},
+	}
+
+	got := BindFromConfig(config).UseProfile("production").Flatten()
+	want := map[string]string{"port": "8080", "host": "prod.example.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if got := BindFromConfig(config).UseProfile("missing").Flatten(); len(got) != 0 {
+		t.Errorf("Expected empty map for a binder in error, got %v", got)
+	}
+}
@@ -0,0 +1,164 @@
+// config_load_struct.go: One-call loading of a config file into a struct
+//
+// Most applications want the same pipeline: read a file in whatever format,
+// let environment variables override it, fall back to defaults, and end up
+// with a typed struct. LoadStruct ties the loader, the profile merge and the
+// tag-driven struct binder together, with the precedence
+//
+//	environment > file > LoadOptions.Defaults > `argus:"key,default"` tag
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/agilira/go-errors"
+)
+
+// LoadOptions controls LoadStruct
+type LoadOptions struct {
+	// EnvPrefix enables environment overrides for every bound field: with
+	// prefix "APP" the key "database.port" is read from APP_DATABASE_PORT.
+	// Empty disables environment overrides.
+	EnvPrefix string
+
+	// Defaults fill keys missing from the file, as a nested map in the same
+	// shape as a parsed configuration. Tag defaults apply below them.
+	Defaults map[string]interface{}
+
+	// Strict rejects file keys that match no struct field and disallows
+	// lossy numeric conversions (see ConfigBinder.StrictNumeric).
+	Strict bool
+}
+
+// LoadStruct loads the configuration file at path once and binds it into
+// target, a pointer to a struct tagged like BindMapOfStruct elements
+// (`argus:"key,default"`, nested structs read "key.*"). The format is
+// detected from the extension, falling back to the content; the path "-"
+// reads standard input. Errors of all fields are aggregated into one error.
+//
+// Example:
+//
+//	type AppConfig struct {
+//	    Port     int           `argus:"port,8080"`
+//	    Timeout  time.Duration `argus:"timeout,5s"`
+//	    Database struct {
+//	        Host string `argus:"host"`
+//	    } `argus:"database"`
+//	}
+//
+//	var cfg AppConfig
+//	err := argus.LoadStruct("config.yaml", &cfg, argus.LoadOptions{EnvPrefix: "APP"})
+func LoadStruct(path string, target interface{}, opts LoadOptions) error {
+	targetPtr := reflect.ValueOf(target)
+	if targetPtr.Kind() != reflect.Ptr || targetPtr.IsNil() || targetPtr.Elem().Kind() != reflect.Struct {
+		return errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("LoadStruct target must be a non-nil pointer to a struct, got %T", target))
+	}
+	structType := targetPtr.Elem().Type()
+
+	fileConfig, _, err := LoadConfigFile(path, FormatUnknown)
+	if err != nil {
+		return err
+	}
+
+	keys := structKeys(structType, "")
+	if opts.Strict {
+		if err := checkUnknownKeys(fileConfig, keys); err != nil {
+			return errors.Wrap(err, ErrCodeInvalidConfig, "failed to load "+path).
+				WithContext("path", path)
+		}
+	}
+
+	config := mergeProfileMaps(opts.Defaults, fileConfig)
+	if opts.EnvPrefix != "" {
+		applyEnvOverrides(config, keys, opts.EnvPrefix)
+	}
+
+	binder := NewConfigBinder(config)
+	if opts.Strict {
+		binder.StrictNumeric()
+	}
+
+	// Decode into a copy so a failed load leaves target untouched
+	result := reflect.New(structType).Elem()
+	result.Set(targetPtr.Elem())
+	if err := binder.decodeStruct(result, ""); err != nil {
+		return errors.Wrap(err, ErrCodeInvalidConfig, "failed to load "+path).
+			WithContext("path", path)
+	}
+	targetPtr.Elem().Set(result)
+	return nil
+}
+
+// structKeys returns the dotted keys of every bindable leaf field of
+// structType, in the order decodeStruct visits them
+func structKeys(structType reflect.Type, prefix string) []string {
+	var keys []string
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key, _, _, skip := parseStructTag(field)
+		if skip {
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+			keys = append(keys, structKeys(field.Type, prefix+key+".")...)
+			continue
+		}
+		keys = append(keys, prefix+key)
+	}
+	return keys
+}
+
+// checkUnknownKeys reports every key of config that no struct field binds
+func checkUnknownKeys(config map[string]interface{}, keys []string) error {
+	known := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		known[key] = struct{}{}
+	}
+
+	var unknown []string
+	for key := range Flatten(config) {
+		if _, ok := known[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown) // Deterministic error order
+	errs := make([]error, len(unknown))
+	for i, key := range unknown {
+		errs[i] = fmt.Errorf("unknown key '%s'", key)
+	}
+	return goerrors.Join(errs...)
+}
+
+// applyEnvOverrides sets every key that has a matching environment variable
+func applyEnvOverrides(config map[string]interface{}, keys []string, prefix string) {
+	for _, key := range keys {
+		if value, ok := os.LookupEnv(envVarName(prefix, key)); ok {
+			setNestedValue(config, strings.Split(key, "."), value)
+		}
+	}
+}
+
+// envVarName maps a dotted key to its environment variable under prefix:
+// ("APP", "database.max-conns") becomes APP_DATABASE_MAX_CONNS
+func envVarName(prefix, key string) string {
+	name := strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+	return strings.TrimSuffix(prefix, "_") + "_" + name
+}
@@ -0,0 +1,133 @@
+// config_load_struct_test.go: Tests for one-call struct loading
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+type loadStructConfig struct {
+	Port     int           `argus:"port,8080"`
+	Timeout  time.Duration `argus:"timeout,5s"`
+	LogLevel string        `argus:"log-level,info"`
+	Database struct {
+		Host     string `argus:"host"`
+		Port     int    `argus:"port,5432"`
+		MaxConns int    `argus:"max_conns"`
+	} `argus:"database"`
+}
+
+func writeLoadStructFile(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadStruct_EnvOverridesFile(t *testing.T) {
+	path := writeLoadStructFile(t, "port: 9000\ndatabase:\n  host: db.local\n  max_conns: 10\n")
+	t.Setenv("APP_DATABASE_HOST", "db.prod")
+
+	var cfg loadStructConfig
+	err := LoadStruct(path, &cfg, LoadOptions{
+		EnvPrefix: "APP",
+		Defaults: map[string]interface{}{
+			"log-level": "warn",
+			"database":  map[string]interface{}{"max_conns": 50, "port": 6432},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to load struct: %v", err)
+	}
+
+	if cfg.Database.Host != "db.prod" {
+		t.Errorf("Expected env override db.prod, got %q", cfg.Database.Host)
+	}
+	if cfg.Port != 9000 || cfg.Database.MaxConns != 10 {
+		t.Errorf("Expected file values port=9000 max_conns=10, got %d and %d", cfg.Port, cfg.Database.MaxConns)
+	}
+	if cfg.LogLevel != "warn" || cfg.Database.Port != 6432 {
+		t.Errorf("Expected defaults log-level=warn database.port=6432, got %q and %d", cfg.LogLevel, cfg.Database.Port)
+	}
+	if cfg.Timeout != 5*time.Second {
+		t.Errorf("Expected tag default timeout 5s, got %v", cfg.Timeout)
+	}
+}
+
+func TestLoadStruct_AggregatesErrors(t *testing.T) {
+	path := writeLoadStructFile(t, "port: abc\ntimeout: soon\n")
+
+	cfg := loadStructConfig{Port: 1}
+	err := LoadStruct(path, &cfg, LoadOptions{})
+	if err == nil {
+		t.Fatal("Expected an error for invalid values")
+	}
+	if !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected %s, got %v", ErrCodeInvalidConfig, err)
+	}
+	detail := goerrors.Unwrap(err).Error()
+	for _, key := range []string{"field 'port'", "field 'timeout'"} {
+		if !strings.Contains(detail, key) {
+			t.Errorf("Expected error detail to mention %s, got: %s", key, detail)
+		}
+	}
+	if cfg.Port != 1 {
+		t.Errorf("Expected target untouched on error, got port %d", cfg.Port)
+	}
+}
+
+func TestLoadStruct_StrictRejectsUnknownKeys(t *testing.T) {
+	path := writeLoadStructFile(t, "port: 9000\nprot: 9001\ndatabase:\n  hots: db\n")
+
+	var cfg loadStructConfig
+	err := LoadStruct(path, &cfg, LoadOptions{Strict: true})
+	if err == nil {
+		t.Fatal("Expected an error for unknown keys in strict mode")
+	}
+	detail := goerrors.Unwrap(err).Error()
+	for _, key := range []string{"unknown key 'prot'", "unknown key 'database.hots'"} {
+		if !strings.Contains(detail, key) {
+			t.Errorf("Expected error detail to mention %s, got: %s", key, detail)
+		}
+	}
+
+	if err := LoadStruct(path, &cfg, LoadOptions{}); err != nil {
+		t.Errorf("Expected unknown keys to be ignored when not strict, got %v", err)
+	}
+}
+
+func TestLoadStruct_InvalidTarget(t *testing.T) {
+	path := writeLoadStructFile(t, "port: 9000\n")
+
+	var cfg loadStructConfig
+	for _, target := range []interface{}{cfg, nil, (*loadStructConfig)(nil), new(int)} {
+		if err := LoadStruct(path, target, LoadOptions{}); err == nil {
+			t.Errorf("Expected an error for target %T", target)
+		}
+	}
+}
+
+func TestEnvVarName(t *testing.T) {
+	tests := []struct{ prefix, key, want string }{
+		{"APP", "port", "APP_PORT"},
+		{"APP_", "database.max-conns", "APP_DATABASE_MAX_CONNS"},
+	}
+	for _, tt := range tests {
+		if got := envVarName(tt.prefix, tt.key); got != tt.want {
+			t.Errorf("envVarName(%q, %q) = %q, want %q", tt.prefix, tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// config_loader.go: One-shot configuration loading from files and stdin
+//
+// CI pipelines often pipe configuration into tools instead of writing it to
+// disk. By convention the path "-" means standard input. Stdin is read once:
+// it cannot be watched, so watcher constructors given "-" deliver the
+// configuration a single time and never fire again.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/agilira/go-errors"
+)
+
+// StdinPath is the conventional path meaning "read from standard input"
+const StdinPath = "-"
+
+// ReadConfig reads and parses configuration from r.
+// Pass FormatUnknown to detect the format from the content.
+// Returns the configuration together with the format actually used.
+func ReadConfig(r io.Reader, format ConfigFormat) (map[string]interface{}, ConfigFormat, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, format, errors.Wrap(err, ErrCodeIOError, "failed to read configuration")
+	}
+
+	if format == FormatUnknown {
+		format = DetectFormatFromContent(data)
+		if format == FormatUnknown {
+			return nil, format, errors.New(ErrCodeInvalidConfig,
+				"unable to detect configuration format from content, specify the format explicitly")
+		}
+	}
+
+	config, err := ParseConfig(data, format)
+	if err != nil {
+		return nil, format, errors.Wrap(err, ErrCodeInvalidConfig, "failed to parse "+format.String()+" config")
+	}
+
+	return config, format, nil
+}
+
+// ParseConfigReader parses configuration of a known format directly from r.
+// JSON and YAML are decoded as they stream in, so a multi-megabyte document
+// is not buffered in full before decoding. Other formats, and formats handled
+// by a registered custom parser, read r completely and go through ParseConfig.
+// Unlike ReadConfig, the format cannot be FormatUnknown: detection needs the
+// whole content.
+//
+// Example:
+//
+//	resp, err := http.Get("https://config.internal/app.json")
+//	...
+//	config, err := argus.ParseConfigReader(resp.Body, argus.FormatJSON)
+func ParseConfigReader(r io.Reader, format ConfigFormat) (map[string]interface{}, error) {
+	if format < FormatJSON || format >= FormatUnknown {
+		return nil, errors.New(ErrCodeInvalidConfig,
+			"ParseConfigReader needs an explicit format, use ReadConfig to detect it from content")
+	}
+
+	if !hasCustomParser(format) {
+		switch format {
+		case FormatJSON:
+			return parseJSONReader(r)
+		case FormatYAML:
+			return parseYAMLReader(r)
+		}
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeIOError, "failed to read configuration")
+	}
+	return ParseConfig(data, format)
+}
+
+// LoadConfigFile loads and parses a configuration file once, without watching.
+// The path "-" (StdinPath) reads standard input. Pass FormatUnknown to detect
+// the format from the file extension, falling back to the content.
+// Returns the configuration together with the format actually used.
+//
+// Example:
+//
+//	// cat config.yaml | myapp
+//	config, _, err := argus.LoadConfigFile("-", argus.FormatYAML)
+func LoadConfigFile(path string, format ConfigFormat) (map[string]interface{}, ConfigFormat, error) {
+	if path == StdinPath {
+		return ReadConfig(os.Stdin, format)
+	}
+
+	if format == FormatUnknown {
+		format = DetectFormat(path)
+	}
+	if format != FormatUnknown {
+		config, err := readAndParseConfig(path, format, 0, ParseLenient)
+		return config, format, err
+	}
+
+	// SECURITY: Validate path to prevent directory traversal attacks
+	if err := ValidateSecurePath(path); err != nil {
+		return nil, format, err
+	}
+
+	// #nosec G304 -- Path validation performed above with ValidateSecurePath
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, format, errors.Wrap(err, ErrCodeFileNotFound, "failed to read config file")
+	}
+	defer func() { _ = file.Close() }()
+
+	return ReadConfig(file, FormatUnknown)
+}
+
+// LoadConfig loads and parses a configuration file once, detecting the format
+// from the extension and then the content. No watcher or goroutine is started,
+// which suits CLI tools that read their configuration at startup and exit.
+// It is LoadConfigFile with FormatUnknown.
+//
+// Example:
+//
+//	config, format, err := argus.LoadConfig("/etc/myapp/config.toml")
+func LoadConfig(path string) (map[string]interface{}, ConfigFormat, error) {
+	return LoadConfigFile(path, FormatUnknown)
+}
+
+// loadStdinOnce backs the watcher constructors when given StdinPath: the
+// configuration is read once and delivered a single time. The returned watcher
+// is started with nothing to watch, so the usual lifecycle calls still apply.
+// FormatUnknown detects the format from the content. A non-nil validate
+// rejects the configuration before delivery.
+func loadStdinOnce(callback func(config map[string]interface{}, meta ConfigMeta), config Config, format ConfigFormat, validate configValidator) (*Watcher, error) {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeIOError, "failed to read config from stdin")
+	}
+	initialConfig, format, err := ReadConfig(bytes.NewReader(data), format)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeInvalidConfig, "failed to read config from stdin")
+	}
+	if validate != nil {
+		if err := validate(initialConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	watcher := setupUniversalWatcher(config)
+	callback(initialConfig, ConfigMeta{Format: format, RawBytes: data})
+
+	if err := watcher.Start(); err != nil {
+		return nil, errors.Wrap(err, ErrCodeWatcherBusy, "failed to start watcher")
+	}
+
+	return watcher, nil
+}
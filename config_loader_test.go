@@ -0,0 +1,199 @@
+// config_loader_test.go: Tests for one-shot configuration loading from files and stdin
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/agilira/go-errors"
+)
+
+// withStdin replaces os.Stdin with a pipe fed with content for the duration of fn
+func withStdin(t *testing.T, content string, fn func()) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to create pipe: %v", err)
+	}
+	go func() {
+		_, _ = w.WriteString(content)
+		_ = w.Close()
+	}()
+
+	original := os.Stdin
+	os.Stdin = r
+	defer func() {
+		os.Stdin = original
+		_ = r.Close()
+	}()
+
+	fn()
+}
+
+func TestLoadConfigFile_Stdin(t *testing.T) {
+	withStdin(t, `{"service": {"name": "billing", "port": 8080}}`, func() {
+		config, format, err := LoadConfigFile(StdinPath, FormatUnknown)
+		if err != nil {
+			t.Fatalf("Failed to load config from stdin: %v", err)
+		}
+		if format != FormatJSON {
+			t.Errorf("Expected JSON to be detected, got %s", format)
+		}
+		service, ok := config["service"].(map[string]interface{})
+		if !ok || service["name"] != "billing" {
+			t.Errorf("Expected service.name=billing, got %v", config)
+		}
+	})
+
+	// Explicit format wins over content detection
+	withStdin(t, "name: billing\n", func() {
+		config, _, err := LoadConfigFile(StdinPath, FormatYAML)
+		if err != nil {
+			t.Fatalf("Failed to load YAML from stdin: %v", err)
+		}
+		if config["name"] != "billing" {
+			t.Errorf("Expected name=billing, got %v", config["name"])
+		}
+	})
+
+	withStdin(t, "just some words", func() {
+		if _, _, err := LoadConfigFile(StdinPath, FormatUnknown); err == nil {
+			t.Error("Expected error for undetectable stdin content")
+		}
+	})
+}
+
+func TestLoadConfigFile_Path(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonPath := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(jsonPath, []byte(`{"port": 8080}`), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	config, _, err := LoadConfigFile(jsonPath, FormatUnknown)
+	if err != nil || config["port"] == nil {
+		t.Errorf("Expected JSON file to load by extension, got %v (%v)", config, err)
+	}
+
+	// No recognizable extension: fall back to content sniffing
+	plainPath := filepath.Join(dir, "settings")
+	if err := os.WriteFile(plainPath, []byte("[server]\nport = 9090\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	config, _, err = LoadConfigFile(plainPath, FormatUnknown)
+	if err != nil {
+		t.Fatalf("Failed to load extensionless config: %v", err)
+	}
+	if _, ok := config["server"]; !ok {
+		t.Errorf("Expected TOML section to be parsed, got %v", config)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("server:\n  port: 8080\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	config, format, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if format != FormatYAML {
+		t.Errorf("Expected format YAML, got %v", format)
+	}
+	server, _ := config["server"].(map[string]interface{})
+	if server["port"] != 8080 {
+		t.Errorf("Expected server.port 8080, got %v", config)
+	}
+
+	if _, _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml")); !errors.HasCode(err, ErrCodeFileNotFound) {
+		t.Errorf("Expected %s for a missing file, got %v", ErrCodeFileNotFound, err)
+	}
+}
+
+func TestParseConfigReader(t *testing.T) {
+	config, err := ParseConfigReader(strings.NewReader(`{"server": {"port": 8080}, "debug": true}`), FormatJSON)
+	if err != nil {
+		t.Fatalf("Failed to parse JSON stream: %v", err)
+	}
+	server, _ := config["server"].(map[string]interface{})
+	if server["port"] != float64(8080) || config["debug"] != true {
+		t.Errorf("Expected decoded JSON values, got %v", config)
+	}
+
+	config, err = ParseConfigReader(strings.NewReader("server:\n  port: 8080\nempty:\n"), FormatYAML)
+	if err != nil {
+		t.Fatalf("Failed to parse YAML stream: %v", err)
+	}
+	server, _ = config["server"].(map[string]interface{})
+	if server["port"] != 8080 {
+		t.Errorf("Expected server.port 8080, got %v", config)
+	}
+	if _, ok := config["empty"].(map[string]interface{}); !ok {
+		t.Errorf("Expected a null YAML value to be normalized like parseYAML, got %#v", config["empty"])
+	}
+
+	if config, err = ParseConfigReader(strings.NewReader(""), FormatYAML); err != nil || len(config) != 0 {
+		t.Errorf("Expected an empty YAML stream to give an empty config, got %v, %v", config, err)
+	}
+
+	// Formats without a streaming decoder fall back to ParseConfig
+	config, err = ParseConfigReader(strings.NewReader("[server]\nport = 8080\n"), FormatTOML)
+	if err != nil {
+		t.Fatalf("Failed to parse TOML stream: %v", err)
+	}
+	if _, ok := config["server"]; !ok {
+		t.Errorf("Expected TOML section to be parsed, got %v", config)
+	}
+
+	invalid := map[string]string{
+		"trailing data":  `{"a": 1} {"b": 2}`,
+		"truncated JSON": `{"a": `,
+	}
+	for name, input := range invalid {
+		if _, err := ParseConfigReader(strings.NewReader(input), FormatJSON); !errors.HasCode(err, ErrCodeInvalidConfig) {
+			t.Errorf("%s: expected %s, got %v", name, ErrCodeInvalidConfig, err)
+		}
+	}
+	if _, err := ParseConfigReader(strings.NewReader(`{}`), FormatUnknown); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected %s for FormatUnknown, got %v", ErrCodeInvalidConfig, err)
+	}
+}
+
+func TestUniversalConfigWatcher_Stdin(t *testing.T) {
+	withStdin(t, `{"level": "debug"}`, func() {
+		calls := 0
+		var received map[string]interface{}
+		watcher, err := UniversalConfigWatcherWithConfig(StdinPath, func(config map[string]interface{}) {
+			calls++
+			received = config
+		}, Config{DisableAudit: true})
+		if err != nil {
+			t.Fatalf("Failed to create stdin watcher: %v", err)
+		}
+		defer func() { _ = watcher.Stop() }()
+
+		if calls != 1 || received["level"] != "debug" {
+			t.Errorf("Expected exactly one delivery with level=debug, got %d calls: %v", calls, received)
+		}
+		if watcher.WatchedFiles() != 0 {
+			t.Errorf("Expected stdin not to be watched, got %d watched files", watcher.WatchedFiles())
+		}
+	})
+
+	withStdin(t, "just some words", func() {
+		_, err := UniversalConfigWatcherWithConfig(StdinPath, func(map[string]interface{}) {}, Config{DisableAudit: true})
+		if err == nil || !strings.Contains(err.Error(), ErrCodeInvalidConfig) {
+			t.Errorf("Expected invalid config error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+// config_profiles.go: Environment-specific configuration profiles
+//
+// A single file can carry several environments as top-level sections:
+//
+//	default:
+//	  port: 8080
+//	  log: {level: info}
+//	production:
+//	  log: {level: warn}
+//
+// Resolving a profile deep-merges its section over the default section, so
+// callbacks and binders see one flat configuration for the environment.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"log"
+	"os"
+
+	"github.com/agilira/go-errors"
+)
+
+const (
+	// DefaultProfile is the section every profile is merged over
+	DefaultProfile = "default"
+
+	// ProfileEnvVar selects the profile when none is given explicitly
+	ProfileEnvVar = "ARGUS_PROFILE"
+)
+
+// ResolveProfile returns the profile section of config deep-merged over the
+// default section. An empty profile is read from ARGUS_PROFILE, falling back
+// to the default profile. The input map is not modified.
+//
+// Example:
+//
+//	resolved, err := argus.ResolveProfile(config, "production")
+func ResolveProfile(config map[string]interface{}, profile string) (map[string]interface{}, error) {
+	profile = profileName(profile)
+
+	base, err := profileSection(config, DefaultProfile)
+	if err != nil {
+		return nil, err
+	}
+	if profile == DefaultProfile {
+		return mergeProfileMaps(nil, base), nil
+	}
+
+	overlay, err := profileSection(config, profile)
+	if err != nil {
+		return nil, err
+	}
+	if overlay == nil {
+		return nil, errors.New(ErrCodeConfigNotFound, "profile not found: "+profile)
+	}
+
+	return mergeProfileMaps(base, overlay), nil
+}
+
+// UseProfile makes the binder read from the resolved profile instead of the
+// raw configuration. An empty name is read from ARGUS_PROFILE.
+//
+// Example:
+//
+//	err := argus.BindFromConfig(config).
+//	    UseProfile("production").
+//	    BindInt(&port, "port", 8080).
+//	    Apply()
+func (cb *ConfigBinder) UseProfile(name string) *ConfigBinder {
+	if cb.err != nil {
+		return cb
+	}
+
+	resolved, err := ResolveProfile(cb.config, name)
+	if err != nil {
+		cb.err = err
+		return cb
+	}
+	cb.config = resolved
+	return cb
+}
+
+// UniversalConfigWatcherProfile watches a configuration file with profiles
+// and invokes callback with the resolved profile. An empty profile is read
+// from ARGUS_PROFILE on every reload, so changing the variable takes effect
+// with the next file change.
+//
+// Example:
+//
+//	watcher, err := argus.UniversalConfigWatcherProfile("config.yaml", "production",
+//	    func(config map[string]interface{}) {
+//	        // default section overlaid with production
+//	    })
+func UniversalConfigWatcherProfile(configPath, profile string, callback func(config map[string]interface{})) (*Watcher, error) {
+	return UniversalConfigWatcherProfileWithConfig(configPath, profile, callback, Config{})
+}
+
+// UniversalConfigWatcherProfileWithConfig is UniversalConfigWatcherProfile
+// with custom watcher configuration. Profile resolution errors are reported
+// to config.ErrorHandler and the callback is skipped.
+func UniversalConfigWatcherProfileWithConfig(configPath, profile string, callback func(config map[string]interface{}), config Config) (*Watcher, error) {
+	errorHandler := config.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(err error, path string) {
+			log.Printf("Argus: error in file %s: %v", path, err)
+		}
+	}
+
+	return UniversalConfigWatcherWithConfig(configPath, func(raw map[string]interface{}) {
+		resolved, err := ResolveProfile(raw, profile)
+		if err != nil {
+			errorHandler(err, configPath)
+			return
+		}
+		callback(resolved)
+	}, config)
+}
+
+// profileName returns profile, or the ARGUS_PROFILE value, or the default
+func profileName(profile string) string {
+	if profile != "" {
+		return profile
+	}
+	if env := os.Getenv(ProfileEnvVar); env != "" {
+		return env
+	}
+	return DefaultProfile
+}
+
+// profileSection returns the section named profile, nil when absent
+func profileSection(config map[string]interface{}, profile string) (map[string]interface{}, error) {
+	value, exists := config[profile]
+	if !exists || value == nil {
+		return nil, nil
+	}
+	section, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New(ErrCodeInvalidConfig, "profile '"+profile+"' is not a section")
+	}
+	return section, nil
+}
+
+// mergeProfileMaps returns a copy of base with overlay merged in recursively.
+// Nested maps are merged key by key; any other overlay value replaces the base.
+func mergeProfileMaps(base, overlay map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base)+len(overlay))
+	for k, v := range base {
+		if nested, ok := v.(map[string]interface{}); ok {
+			v = mergeProfileMaps(nil, nested)
+		}
+		result[k] = v
+	}
+	for k, v := range overlay {
+		nested, isMap := v.(map[string]interface{})
+		if !isMap {
+			result[k] = v
+			continue
+		}
+		existing, _ := result[k].(map[string]interface{})
+		result[k] = mergeProfileMaps(existing, nested)
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+// config_profiles_test.go: Tests for environment-specific configuration profiles
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testProfilesYAML = `default:
+  port: 8080
+  log:
+    level: info
+    format: json
+production:
+  log:
+    level: warn
+staging:
+  port: 9090
+`
+
+func TestResolveProfile(t *testing.T) {
+	config, err := ParseConfig([]byte(testProfilesYAML), FormatYAML)
+	if err != nil {
+		t.Fatalf("Failed to parse config: %v", err)
+	}
+
+	resolved, err := ResolveProfile(config, "production")
+	if err != nil {
+		t.Fatalf("Failed to resolve profile: %v", err)
+	}
+	logSection, _ := resolved["log"].(map[string]interface{})
+	if resolved["port"] != 8080 || logSection["level"] != "warn" || logSection["format"] != "json" {
+		t.Errorf("Expected production overlaid on defaults, got %+v", resolved)
+	}
+
+	// The raw default section is not modified by the merge
+	defaults := config["default"].(map[string]interface{})
+	if defaults["log"].(map[string]interface{})["level"] != "info" {
+		t.Error("ResolveProfile must not modify the input")
+	}
+
+	t.Setenv(ProfileEnvVar, "staging")
+	resolved, err = ResolveProfile(config, "")
+	if err != nil {
+		t.Fatalf("Failed to resolve profile from env: %v", err)
+	}
+	if resolved["port"] != 9090 {
+		t.Errorf("Expected staging port from %s, got %v", ProfileEnvVar, resolved["port"])
+	}
+
+	if _, err := ResolveProfile(config, "missing"); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+}
+
+func TestConfigBinder_UseProfile(t *testing.T) {
+	config, err := ParseConfig([]byte(testProfilesYAML), FormatYAML)
+	if err != nil {
+		t.Fatalf("Failed to parse config: %v", err)
+	}
+
+	var port int
+	var level string
+	err = BindFromConfig(config).
+		UseProfile("production").
+		BindInt(&port, "port").
+		BindString(&level, "log.level").
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to bind: %v", err)
+	}
+	if port != 8080 || level != "warn" {
+		t.Errorf("Expected port=8080 level=warn, got port=%d level=%s", port, level)
+	}
+
+	if err := BindFromConfig(config).UseProfile("missing").BindInt(&port, "port").Apply(); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+}
+
+func TestUniversalConfigWatcherProfile(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(testProfilesYAML), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	t.Setenv(ProfileEnvVar, "production")
+
+	var mu sync.Mutex
+	var configs []map[string]interface{}
+	watcher, err := UniversalConfigWatcherProfileWithConfig(configPath, "", func(config map[string]interface{}) {
+		mu.Lock()
+		configs = append(configs, config)
+		mu.Unlock()
+	}, Config{PollInterval: 10 * time.Millisecond, CacheTTL: 5 * time.Millisecond, DisableAudit: true})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	mu.Lock()
+	initial := configs[0]
+	mu.Unlock()
+	if initial["log"].(map[string]interface{})["level"] != "warn" {
+		t.Errorf("Expected production log level, got %+v", initial)
+	}
+
+	// A profile switch is honored on the next reload
+	t.Setenv(ProfileEnvVar, "staging")
+	time.Sleep(30 * time.Millisecond)
+	if err := os.WriteFile(configPath, []byte(testProfilesYAML+"\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		n := len(configs)
+		last := configs[n-1]
+		mu.Unlock()
+		if n > 1 {
+			if last["port"] != 9090 {
+				t.Errorf("Expected staging port after reload, got %+v", last)
+			}
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for reload")
+}
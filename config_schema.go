@@ -0,0 +1,511 @@
+// config_schema.go: JSON Schema validation of parsed configuration
+//
+// Config.Validate checks Argus' own settings; the application's configuration
+// is only checked when it is bound, one key at a time. A JSON Schema describes
+// the whole document up front, so a malformed configuration can be rejected
+// before it reaches the application, with every violation reported against
+// its configuration path ("server.port", "servers[1].host").
+//
+// The supported subset covers configuration needs: type, required,
+// properties, additionalProperties, items, enum, const, minimum, maximum,
+// exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern,
+// minItems and maxItems. Other keywords are ignored, as JSON Schema requires
+// of unknown keywords.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/agilira/go-errors"
+)
+
+// schemaRootPath names the configuration root in validation errors
+const schemaRootPath = "(root)"
+
+// configSchema is a compiled JSON Schema node
+type configSchema struct {
+	types                []string
+	required             []string
+	properties           map[string]*configSchema
+	additional           *configSchema // nil = any additional property allowed
+	noAdditional         bool
+	items                *configSchema
+	enum                 []interface{}
+	constValue           interface{}
+	hasConst             bool
+	minimum, maximum     *float64
+	exclusiveMin         *float64
+	exclusiveMax         *float64
+	minLength, maxLength *int
+	minItems, maxItems   *int
+	pattern              *regexp.Regexp
+}
+
+// ValidateConfigAgainstSchema validates a parsed configuration against a
+// JSON Schema document. Every violation is reported in the result's Errors
+// as "<path>: <problem>", where path uses dotted keys and list indices
+// ("database.port", "servers[0].host") and "(root)" for the document itself.
+// The error is non-nil only when the schema itself is invalid.
+//
+// Values are checked strictly: a string "8080" does not satisfy
+// "type": "integer". Formats whose parsers produce only strings (INI,
+// properties) should use "string" types or be bound with ConfigBinder.
+//
+// Example:
+//
+//	schema := []byte(`{
+//	    "type": "object",
+//	    "required": ["port"],
+//	    "properties": {
+//	        "port":  {"type": "integer", "minimum": 1, "maximum": 65535},
+//	        "level": {"enum": ["debug", "info", "warn", "error"]}
+//	    }
+//	}`)
+//	result, err := argus.ValidateConfigAgainstSchema(config, schema)
+//	if err != nil {
+//	    return err // Invalid schema
+//	}
+//	if !result.Valid {
+//	    return fmt.Errorf("invalid configuration:\n%s", strings.Join(result.Errors, "\n"))
+//	}
+func ValidateConfigAgainstSchema(config map[string]interface{}, schema []byte) (*ValidationResult, error) {
+	compiled, err := compileJSONSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return compiled.check(config), nil
+}
+
+// compileJSONSchema parses and compiles a JSON Schema document
+func compileJSONSchema(schema []byte) (*configSchema, error) {
+	var document interface{}
+	if err := json.Unmarshal(schema, &document); err != nil {
+		return nil, errors.Wrap(err, ErrCodeInvalidConfig, "invalid JSON schema")
+	}
+	return compileSchema(document, "#")
+}
+
+// check validates a whole configuration against the compiled schema
+func (s *configSchema) check(config map[string]interface{}) *ValidationResult {
+	result := &ValidationResult{}
+	s.validate(config, "", result)
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+// compileSchema compiles a schema node; ref locates it in schema errors
+func compileSchema(document interface{}, ref string) (*configSchema, error) {
+	if allow, ok := document.(bool); ok {
+		// true accepts anything; false accepts nothing
+		if allow {
+			return &configSchema{}, nil
+		}
+		return &configSchema{types: []string{}}, nil
+	}
+	node, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, schemaError(ref, "schema must be an object or a boolean")
+	}
+
+	s := &configSchema{}
+	var err error
+	if s.types, err = compileTypes(node["type"], ref); err != nil {
+		return nil, err
+	}
+	if s.required, err = schemaStrings(node, "required", ref); err != nil {
+		return nil, err
+	}
+
+	if raw, ok := node["properties"]; ok {
+		properties, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, schemaError(ref+"/properties", "must be an object")
+		}
+		s.properties = make(map[string]*configSchema, len(properties))
+		for name, property := range properties {
+			if s.properties[name], err = compileSchema(property, ref+"/properties/"+name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if raw, ok := node["additionalProperties"]; ok {
+		if allow, isBool := raw.(bool); isBool {
+			s.noAdditional = !allow
+		} else if s.additional, err = compileSchema(raw, ref+"/additionalProperties"); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := node["items"]; ok {
+		if s.items, err = compileSchema(raw, ref+"/items"); err != nil {
+			return nil, err
+		}
+	}
+
+	if raw, ok := node["enum"]; ok {
+		if s.enum, ok = raw.([]interface{}); !ok {
+			return nil, schemaError(ref+"/enum", "must be an array")
+		}
+	}
+	s.constValue, s.hasConst = node["const"]
+
+	if err := compileBounds(s, node, ref); err != nil {
+		return nil, err
+	}
+
+	if raw, ok := node["pattern"]; ok {
+		pattern, isString := raw.(string)
+		if !isString {
+			return nil, schemaError(ref+"/pattern", "must be a string")
+		}
+		if s.pattern, err = regexp.Compile(pattern); err != nil {
+			return nil, errors.Wrap(err, ErrCodeInvalidConfig, "invalid JSON schema pattern").
+				WithContext("schema_path", ref+"/pattern")
+		}
+	}
+	return s, nil
+}
+
+// compileTypes reads "type" as a name or a list of names
+func compileTypes(raw interface{}, ref string) ([]string, error) {
+	var names []string
+	switch t := raw.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		names = []string{t}
+	case []interface{}:
+		for _, name := range t {
+			str, ok := name.(string)
+			if !ok {
+				return nil, schemaError(ref+"/type", "must contain type names")
+			}
+			names = append(names, str)
+		}
+	default:
+		return nil, schemaError(ref+"/type", "must be a string or an array")
+	}
+
+	for _, name := range names {
+		switch name {
+		case "object", "array", "string", "number", "integer", "boolean", "null":
+		default:
+			return nil, schemaError(ref+"/type", "unknown type '"+name+"'")
+		}
+	}
+	return names, nil
+}
+
+// compileBounds reads the numeric, length and size keywords
+func compileBounds(s *configSchema, node map[string]interface{}, ref string) error {
+	numbers := []struct {
+		keyword string
+		target  **float64
+	}{
+		{"minimum", &s.minimum},
+		{"maximum", &s.maximum},
+	}
+	for _, n := range numbers {
+		if raw, ok := node[n.keyword]; ok {
+			value, isNumber := raw.(float64)
+			if !isNumber {
+				return schemaError(ref+"/"+n.keyword, "must be a number")
+			}
+			*n.target = &value
+		}
+	}
+
+	// exclusiveMinimum/Maximum are numbers since draft 6, booleans
+	// qualifying minimum/maximum in draft 4
+	exclusive := []struct {
+		keyword string
+		bound   *float64
+		target  **float64
+	}{
+		{"exclusiveMinimum", s.minimum, &s.exclusiveMin},
+		{"exclusiveMaximum", s.maximum, &s.exclusiveMax},
+	}
+	for _, e := range exclusive {
+		switch v := node[e.keyword].(type) {
+		case nil:
+		case float64:
+			*e.target = &v
+		case bool:
+			if v && e.bound != nil {
+				*e.target = e.bound
+			}
+		default:
+			return schemaError(ref+"/"+e.keyword, "must be a number or a boolean")
+		}
+	}
+	if s.exclusiveMin != nil && s.exclusiveMin == s.minimum {
+		s.minimum = nil
+	}
+	if s.exclusiveMax != nil && s.exclusiveMax == s.maximum {
+		s.maximum = nil
+	}
+
+	counts := []struct {
+		keyword string
+		target  **int
+	}{
+		{"minLength", &s.minLength},
+		{"maxLength", &s.maxLength},
+		{"minItems", &s.minItems},
+		{"maxItems", &s.maxItems},
+	}
+	for _, c := range counts {
+		if raw, ok := node[c.keyword]; ok {
+			value, isNumber := raw.(float64)
+			if !isNumber || value < 0 || value != math.Trunc(value) {
+				return schemaError(ref+"/"+c.keyword, "must be a non-negative integer")
+			}
+			count := int(value)
+			*c.target = &count
+		}
+	}
+	return nil
+}
+
+// schemaStrings reads a keyword holding an array of strings
+func schemaStrings(node map[string]interface{}, keyword, ref string) ([]string, error) {
+	raw, ok := node[keyword]
+	if !ok {
+		return nil, nil
+	}
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, schemaError(ref+"/"+keyword, "must be an array of strings")
+	}
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		str, ok := item.(string)
+		if !ok {
+			return nil, schemaError(ref+"/"+keyword, "must be an array of strings")
+		}
+		values = append(values, str)
+	}
+	return values, nil
+}
+
+// schemaError reports an invalid schema keyword
+func schemaError(ref, problem string) error {
+	return errors.New(ErrCodeInvalidConfig, "invalid JSON schema: "+ref+" "+problem).
+		WithContext("schema_path", ref)
+}
+
+// validate appends the violations of value at path to result
+func (s *configSchema) validate(value interface{}, path string, result *ValidationResult) {
+	fail := func(format string, args ...interface{}) {
+		location := path
+		if location == "" {
+			location = schemaRootPath
+		}
+		result.Errors = append(result.Errors, location+": "+fmt.Sprintf(format, args...))
+	}
+
+	if s.types != nil && !schemaTypeMatches(s.types, value) {
+		if len(s.types) == 0 {
+			fail("no value is allowed")
+		} else {
+			fail("expected %s, got %s", strings.Join(s.types, " or "), schemaTypeOf(value))
+		}
+		return // Further keywords would only repeat the mismatch
+	}
+
+	if s.enum != nil && !schemaContains(s.enum, value) {
+		fail("must be one of %s, got %s", schemaJSON(s.enum), schemaJSON(value))
+	}
+	if s.hasConst && !schemaEqual(s.constValue, value) {
+		fail("must be %s, got %s", schemaJSON(s.constValue), schemaJSON(value))
+	}
+
+	if number, ok := schemaNumber(value); ok {
+		s.validateNumber(number, fail)
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.minLength != nil && length < *s.minLength {
+			fail("must be at least %d characters long, got %d", *s.minLength, length)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			fail("must be at most %d characters long, got %d", *s.maxLength, length)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			fail("must match pattern %q", s.pattern.String())
+		}
+	case map[string]interface{}:
+		s.validateObject(v, path, result, fail)
+	default:
+		if items, ok := schemaList(value); ok {
+			s.validateArray(items, path, result, fail)
+		}
+	}
+}
+
+// validateNumber checks the numeric bounds
+func (s *configSchema) validateNumber(number float64, fail func(string, ...interface{})) {
+	if s.minimum != nil && number < *s.minimum {
+		fail("must be >= %v, got %v", *s.minimum, number)
+	}
+	if s.maximum != nil && number > *s.maximum {
+		fail("must be <= %v, got %v", *s.maximum, number)
+	}
+	if s.exclusiveMin != nil && number <= *s.exclusiveMin {
+		fail("must be > %v, got %v", *s.exclusiveMin, number)
+	}
+	if s.exclusiveMax != nil && number >= *s.exclusiveMax {
+		fail("must be < %v, got %v", *s.exclusiveMax, number)
+	}
+}
+
+// validateObject checks required keys and properties, in key order
+func (s *configSchema) validateObject(object map[string]interface{}, path string, result *ValidationResult, fail func(string, ...interface{})) {
+	for _, key := range s.required {
+		if _, ok := object[key]; !ok {
+			result.Errors = append(result.Errors, joinKeyPath(path, key)+": required key is missing")
+		}
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if property, ok := s.properties[key]; ok {
+			property.validate(object[key], joinKeyPath(path, key), result)
+		} else if s.noAdditional {
+			fail("unknown key '%s'", key)
+		} else if s.additional != nil {
+			s.additional.validate(object[key], joinKeyPath(path, key), result)
+		}
+	}
+}
+
+// validateArray checks the item count and every item
+func (s *configSchema) validateArray(items []interface{}, path string, result *ValidationResult, fail func(string, ...interface{})) {
+	if s.minItems != nil && len(items) < *s.minItems {
+		fail("must have at least %d items, got %d", *s.minItems, len(items))
+	}
+	if s.maxItems != nil && len(items) > *s.maxItems {
+		fail("must have at most %d items, got %d", *s.maxItems, len(items))
+	}
+	if s.items != nil {
+		for i, item := range items {
+			s.items.validate(item, fmt.Sprintf("%s[%d]", path, i), result)
+		}
+	}
+}
+
+// schemaTypeMatches reports whether value has one of the JSON types
+func schemaTypeMatches(types []string, value interface{}) bool {
+	actual := schemaTypeOf(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaTypeOf returns the JSON type name of a parsed configuration value
+func schemaTypeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	}
+	if number, ok := schemaNumber(value); ok {
+		if number == math.Trunc(number) && !math.IsInf(number, 0) {
+			return "integer"
+		}
+		return "number"
+	}
+	if _, ok := schemaList(value); ok {
+		return "array"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// schemaNumber converts any Go numeric value to float64
+func schemaNumber(value interface{}) (float64, bool) {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// schemaList returns the elements of any slice value
+func schemaList(value interface{}) ([]interface{}, bool) {
+	if list, ok := value.([]interface{}); ok {
+		return list, true
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return nil, false
+	}
+	list := make([]interface{}, v.Len())
+	for i := range list {
+		list[i] = v.Index(i).Interface()
+	}
+	return list, true
+}
+
+// schemaContains reports whether value equals one of the candidates
+func schemaContains(candidates []interface{}, value interface{}) bool {
+	for _, candidate := range candidates {
+		if schemaEqual(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaEqual compares a schema value with a configuration value; numbers
+// compare by value, so 8080 from YAML equals 8080 from the JSON schema
+func schemaEqual(expected, value interface{}) bool {
+	if a, ok := schemaNumber(expected); ok {
+		b, isNumber := schemaNumber(value)
+		return isNumber && a == b
+	}
+	return reflect.DeepEqual(expected, value)
+}
+
+// schemaJSON renders a value for an error message
+func schemaJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
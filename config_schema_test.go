@@ -0,0 +1,178 @@
+// config_schema_test.go: Tests for JSON Schema validation of configuration
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/agilira/go-errors"
+)
+
+const testServiceSchema = `{
+	"type": "object",
+	"required": ["service", "server"],
+	"properties": {
+		"service": {"type": "string", "minLength": 1, "pattern": "^[a-z][a-z0-9-]*$"},
+		"level": {"enum": ["debug", "info", "warn", "error"]},
+		"ratio": {"type": "number", "exclusiveMinimum": 0, "maximum": 1},
+		"server": {
+			"type": "object",
+			"required": ["port"],
+			"additionalProperties": false,
+			"properties": {
+				"host": {"type": "string"},
+				"port": {"type": "integer", "minimum": 1, "maximum": 65535}
+			}
+		},
+		"upstreams": {
+			"type": "array",
+			"minItems": 1,
+			"items": {
+				"type": "object",
+				"required": ["url"],
+				"properties": {"url": {"type": "string"}, "weight": {"type": "integer", "minimum": 0}}
+			}
+		}
+	}
+}`
+
+func TestValidateConfigAgainstSchema_Valid(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+service: billing-api
+level: info
+ratio: 0.25
+server:
+  host: 0.0.0.0
+  port: 8080
+upstreams:
+  - url: http://a
+    weight: 2
+`), FormatYAML)
+	if err != nil {
+		t.Fatalf("Failed to parse config: %v", err)
+	}
+
+	result, err := ValidateConfigAgainstSchema(config, []byte(testServiceSchema))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	if !result.Valid || len(result.Errors) != 0 {
+		t.Errorf("Expected a valid configuration, got %v", result.Errors)
+	}
+}
+
+func TestValidateConfigAgainstSchema_ReportsPaths(t *testing.T) {
+	config := map[string]interface{}{
+		"service": "Billing API",
+		"level":   "verbose",
+		"ratio":   0,
+		"server": map[string]interface{}{
+			"port":  70000,
+			"debug": true,
+		},
+		"upstreams": []interface{}{
+			map[string]interface{}{"url": "http://a", "weight": -1},
+			map[string]interface{}{"weight": "heavy"},
+		},
+	}
+
+	result, err := ValidateConfigAgainstSchema(config, []byte(testServiceSchema))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	want := []string{
+		`level: must be one of ["debug","info","warn","error"], got "verbose"`,
+		`ratio: must be > 0, got 0`,
+		`server: unknown key 'debug'`,
+		`server.port: must be <= 65535, got 70000`,
+		`service: must match pattern "^[a-z][a-z0-9-]*$"`,
+		`upstreams[0].weight: must be >= 0, got -1`,
+		`upstreams[1].url: required key is missing`,
+		`upstreams[1].weight: expected integer, got string`,
+	}
+	if result.Valid {
+		t.Error("Expected the configuration to be invalid")
+	}
+	if !reflect.DeepEqual(result.Errors, want) {
+		t.Errorf("Unexpected errors:\n got %q\nwant %q", result.Errors, want)
+	}
+}
+
+func TestValidateConfigAgainstSchema_Types(t *testing.T) {
+	schema := []byte(`{"type": "object", "properties": {
+		"count": {"type": "integer"},
+		"name":  {"type": ["string", "null"]},
+		"tags":  {"type": "array", "items": {"type": "string"}}
+	}}`)
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		valid  bool
+	}{
+		{"int", map[string]interface{}{"count": 3}, true},
+		{"int64", map[string]interface{}{"count": int64(3)}, true},
+		{"integral float", map[string]interface{}{"count": 3.0}, true},
+		{"fraction", map[string]interface{}{"count": 3.5}, false},
+		{"numeric string", map[string]interface{}{"count": "3"}, false},
+		{"null allowed", map[string]interface{}{"name": nil}, true},
+		{"bool not allowed", map[string]interface{}{"name": true}, false},
+		{"string slice", map[string]interface{}{"tags": []string{"a", "b"}}, true},
+		{"mixed list", map[string]interface{}{"tags": []interface{}{"a", 1}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ValidateConfigAgainstSchema(tt.config, schema)
+			if err != nil {
+				t.Fatalf("Failed to validate: %v", err)
+			}
+			if result.Valid != tt.valid {
+				t.Errorf("Expected valid=%v, got %v (%v)", tt.valid, result.Valid, result.Errors)
+			}
+		})
+	}
+}
+
+func TestValidateConfigAgainstSchema_RootErrors(t *testing.T) {
+	result, err := ValidateConfigAgainstSchema(map[string]interface{}{}, []byte(`{"required": ["port"], "const": {"port": 1}}`))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	want := []string{`(root): must be {"port":1}, got {}`, "port: required key is missing"}
+	if !reflect.DeepEqual(result.Errors, want) {
+		t.Errorf("Unexpected errors:\n got %q\nwant %q", result.Errors, want)
+	}
+}
+
+func TestValidateConfigAgainstSchema_Draft4ExclusiveBounds(t *testing.T) {
+	schema := []byte(`{"properties": {"ratio": {"minimum": 0, "exclusiveMinimum": true, "maximum": 1}}}`)
+	result, err := ValidateConfigAgainstSchema(map[string]interface{}{"ratio": 0}, schema)
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	if want := []string{"ratio: must be > 0, got 0"}; !reflect.DeepEqual(result.Errors, want) {
+		t.Errorf("Expected %q, got %q", want, result.Errors)
+	}
+}
+
+func TestValidateConfigAgainstSchema_InvalidSchema(t *testing.T) {
+	schemas := []string{
+		`not json`,
+		`"object"`,
+		`{"type": "text"}`,
+		`{"required": "port"}`,
+		`{"properties": {"port": {"minimum": "1"}}}`,
+		`{"properties": {"name": {"pattern": "("}}}`,
+		`{"items": {"maxItems": -1}}`,
+	}
+	for _, schema := range schemas {
+		if _, err := ValidateConfigAgainstSchema(map[string]interface{}{}, []byte(schema)); !errors.HasCode(err, ErrCodeInvalidConfig) {
+			t.Errorf("Expected schema %s to be rejected, got %v", schema, err)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// config_schema_watch.go: Schema-checked reloads for the universal watcher
+//
+// A bad edit to a watched configuration file (a deleted section, a port
+// written as "eighty") is delivered to the application like any other
+// change. UniversalConfigWatcherWithSchema checks every reload against a
+// ConfigSchema first: a configuration that fails is reported to the
+// ErrorHandler and never reaches the callback, so the application keeps
+// running on the last valid version.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/agilira/go-errors"
+)
+
+// SchemaType is the type a ConfigSchema expects at a key
+type SchemaType int
+
+const (
+	// SchemaString accepts any scalar value
+	SchemaString SchemaType = iota
+
+	// SchemaInt accepts values ConfigBinder.BindInt can bind
+	SchemaInt
+
+	// SchemaFloat accepts values ConfigBinder.BindFloat64 can bind
+	SchemaFloat
+
+	// SchemaBool accepts values ConfigBinder.BindBool can bind
+	SchemaBool
+
+	// SchemaDuration accepts values ConfigBinder.BindDuration can bind
+	SchemaDuration
+
+	// SchemaList accepts lists
+	SchemaList
+
+	// SchemaMap accepts nested sections
+	SchemaMap
+)
+
+// String returns the name of the schema type
+func (t SchemaType) String() string {
+	switch t {
+	case SchemaString:
+		return "string"
+	case SchemaInt:
+		return "int"
+	case SchemaFloat:
+		return "float"
+	case SchemaBool:
+		return "bool"
+	case SchemaDuration:
+		return "duration"
+	case SchemaList:
+		return "list"
+	case SchemaMap:
+		return "map"
+	default:
+		return "unknown"
+	}
+}
+
+// ConfigSchema declares what a valid configuration must contain. Keys use
+// the ConfigBinder notation ("database.host", "servers.0.port"). Types are
+// checked with the binder's conversions, so "8080" from an INI file is a
+// valid SchemaInt.
+type ConfigSchema struct {
+	// Required lists the keys that must be present
+	Required []string
+
+	// Types maps keys to the type their value must have. Keys absent from
+	// the configuration are not checked unless also Required.
+	Types map[string]SchemaType
+
+	// JSONSchema is an optional JSON Schema document checked as well, see
+	// ValidateConfigAgainstSchema
+	JSONSchema []byte
+}
+
+// compiledConfigSchema is a ConfigSchema ready to validate configurations
+type compiledConfigSchema struct {
+	schema ConfigSchema
+	keys   []string // Types keys, sorted for stable error order
+	json   *configSchema
+}
+
+// compile checks the schema itself and prepares it for validation
+func (s ConfigSchema) compile() (*compiledConfigSchema, error) {
+	compiled := &compiledConfigSchema{schema: s}
+	for key, t := range s.Types {
+		if t < SchemaString || t > SchemaMap {
+			return nil, errors.New(ErrCodeInvalidConfig, fmt.Sprintf("unknown schema type %d for key '%s'", int(t), key))
+		}
+		compiled.keys = append(compiled.keys, key)
+	}
+	sort.Strings(compiled.keys)
+
+	if len(s.JSONSchema) > 0 {
+		var err error
+		if compiled.json, err = compileJSONSchema(s.JSONSchema); err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
+
+// Validate checks config against the schema. All violations are reported
+// in one ErrCodeSchemaValidation error, whose "errors" context lists them
+// as "<key>: <problem>". An invalid schema returns ErrCodeInvalidConfig.
+func (s ConfigSchema) Validate(config map[string]interface{}) error {
+	compiled, err := s.compile()
+	if err != nil {
+		return err
+	}
+	return compiled.validate(config)
+}
+
+// validate checks config against the compiled schema
+func (c *compiledConfigSchema) validate(config map[string]interface{}) error {
+	binder := NewConfigBinder(config)
+	var problems []string
+
+	for _, key := range c.schema.Required {
+		if _, exists := binder.getValue(key); !exists {
+			problems = append(problems, key+": required key is missing")
+		}
+	}
+	for _, key := range c.keys {
+		value, exists := binder.getValue(key)
+		if !exists {
+			continue
+		}
+		if expected := c.schema.Types[key]; !binder.hasSchemaType(value, expected) {
+			problems = append(problems, fmt.Sprintf("%s: expected %s, got %s", key, expected, schemaJSON(value)))
+		}
+	}
+	if c.json != nil {
+		problems = append(problems, c.json.check(config).Errors...)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(ErrCodeSchemaValidation, "configuration failed schema validation: "+strings.Join(problems, "; ")).
+		WithContext("errors", problems)
+}
+
+// hasSchemaType reports whether value converts to the expected type
+func (cb *ConfigBinder) hasSchemaType(value interface{}, expected SchemaType) bool {
+	var err error
+	switch expected {
+	case SchemaString:
+		switch value.(type) {
+		case map[string]interface{}, nil:
+			return false
+		}
+		_, isList := schemaList(value)
+		return !isList
+	case SchemaInt:
+		_, err = cb.toInt(value)
+	case SchemaFloat:
+		_, err = cb.toFloat64(value)
+	case SchemaBool:
+		_, err = cb.toBool(value)
+	case SchemaDuration:
+		_, err = cb.toDuration(value)
+	case SchemaList:
+		_, isList := schemaList(value)
+		return isList
+	case SchemaMap:
+		_, isMap := value.(map[string]interface{})
+		return isMap
+	}
+	return err == nil
+}
+
+// UniversalConfigWatcherWithSchema is UniversalConfigWatcher with every
+// configuration checked against schema before it reaches the callback.
+// The initial configuration must be valid, otherwise the watcher is not
+// created. A reload that fails validation is reported to the ErrorHandler
+// (the default one logs it) with an ErrCodeSchemaValidation error, recorded
+// as a config_rejected audit event, and not delivered: the application keeps
+// its last valid configuration until the file is fixed.
+//
+// Example:
+//
+//	watcher, err := argus.UniversalConfigWatcherWithSchema("config.yaml", argus.ConfigSchema{
+//	    Required: []string{"database.host", "server.port"},
+//	    Types: map[string]argus.SchemaType{
+//	        "server.port":    argus.SchemaInt,
+//	        "server.timeout": argus.SchemaDuration,
+//	    },
+//	}, func(config map[string]interface{}) {
+//	    applyConfig(config) // Only ever called with a valid configuration
+//	})
+func UniversalConfigWatcherWithSchema(configPath string, schema ConfigSchema, callback func(config map[string]interface{})) (*Watcher, error) {
+	compiled, err := schema.compile()
+	if err != nil {
+		return nil, err
+	}
+	return watchUniversalDetected(configPath, ignoreMeta(callback), Config{}, compiled.validate)
+}
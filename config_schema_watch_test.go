@@ -0,0 +1,140 @@
+// config_schema_watch_test.go: Tests for schema-checked universal watchers
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+var testWatchSchema = ConfigSchema{
+	Required: []string{"database.host", "server.port"},
+	Types: map[string]SchemaType{
+		"server.port":    SchemaInt,
+		"server.timeout": SchemaDuration,
+		"features":       SchemaList,
+	},
+}
+
+func TestConfigSchema_Validate(t *testing.T) {
+	valid := map[string]interface{}{
+		"database": map[string]interface{}{"host": "db"},
+		"server":   map[string]interface{}{"port": "8080", "timeout": "5s"}, // INI-style strings convert
+		"features": []interface{}{"a"},
+	}
+	if err := testWatchSchema.Validate(valid); err != nil {
+		t.Errorf("Expected a valid configuration, got %v", err)
+	}
+
+	invalid := map[string]interface{}{
+		"server":   map[string]interface{}{"port": "eighty", "timeout": "soon"},
+		"features": "a",
+	}
+	err := testWatchSchema.Validate(invalid)
+	if !errors.HasCode(err, ErrCodeSchemaValidation) {
+		t.Fatalf("Expected ErrCodeSchemaValidation, got %v", err)
+	}
+	want := "configuration failed schema validation: database.host: required key is missing; " +
+		`features: expected list, got "a"; server.port: expected int, got "eighty"; server.timeout: expected duration, got "soon"`
+	if err.(*errors.Error).Message != want {
+		t.Errorf("Unexpected message:\n got %s\nwant %s", err.(*errors.Error).Message, want)
+	}
+}
+
+func TestConfigSchema_JSONSchemaAndInvalidSchema(t *testing.T) {
+	schema := ConfigSchema{JSONSchema: []byte(`{"properties": {"level": {"enum": ["info", "debug"]}}}`)}
+	if err := schema.Validate(map[string]interface{}{"level": "trace"}); !errors.HasCode(err, ErrCodeSchemaValidation) {
+		t.Errorf("Expected the JSON Schema to be enforced, got %v", err)
+	}
+
+	if err := (ConfigSchema{Types: map[string]SchemaType{"port": SchemaType(42)}}).Validate(nil); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected an unknown type to be rejected, got %v", err)
+	}
+	if _, err := UniversalConfigWatcherWithSchema("config.json", ConfigSchema{JSONSchema: []byte("{")}, func(map[string]interface{}) {}); err == nil {
+		t.Error("Expected an invalid JSON Schema to be rejected")
+	}
+}
+
+func TestUniversalConfigWatcherWithSchema_RejectsInvalidReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"database": {"host": "db"}, "server": {"port": 8080}}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	compiled, err := testWatchSchema.compile()
+	if err != nil {
+		t.Fatalf("Failed to compile schema: %v", err)
+	}
+	configs := make(chan map[string]interface{}, 4)
+	rejected := make(chan error, 4)
+	watcher, err := watchUniversalDetected(path, func(config map[string]interface{}, _ ConfigMeta) {
+		configs <- config
+	}, Config{
+		PollInterval: 50 * time.Millisecond,
+		ErrorHandler: func(err error, _ string) { rejected <- err },
+	}, compiled.validate)
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	select {
+	case <-configs:
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the initial configuration")
+	}
+
+	// A bad edit: the port is no longer a number and the database is gone
+	time.Sleep(20 * time.Millisecond)
+	if err := os.WriteFile(path, []byte(`{"server": {"port": "eighty"}}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	select {
+	case err := <-rejected:
+		if !errors.HasCode(err, ErrCodeSchemaValidation) {
+			t.Errorf("Expected ErrCodeSchemaValidation, got %v", err)
+		}
+	case config := <-configs:
+		t.Fatalf("Expected the invalid configuration to be rejected, got %v", config)
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timed out waiting for the rejection")
+	}
+
+	// The fix is delivered
+	time.Sleep(20 * time.Millisecond)
+	if err := os.WriteFile(path, []byte(`{"database": {"host": "db2"}, "server": {"port": 9090}}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	select {
+	case config := <-configs:
+		if config["database"].(map[string]interface{})["host"] != "db2" {
+			t.Errorf("Expected the fixed configuration, got %v", config)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timed out waiting for the fixed configuration")
+	}
+}
+
+func TestUniversalConfigWatcherWithSchema_InvalidInitialConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"server": {"port": 8080}}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	called := false
+	_, err := UniversalConfigWatcherWithSchema(path, testWatchSchema, func(map[string]interface{}) { called = true })
+	if !errors.HasCode(err, ErrCodeSchemaValidation) {
+		t.Errorf("Expected ErrCodeSchemaValidation, got %v", err)
+	}
+	if called {
+		t.Error("Expected the callback not to be called with an invalid configuration")
+	}
+}
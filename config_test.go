@@ -7,7 +7,9 @@
 package argus
 
 import (
+	"io"
 	"testing"
+	"time"
 )
 
 func TestSleepStrategy(t *testing.T) {
@@ -42,3 +44,46 @@ func TestSleepStrategy(t *testing.T) {
 		// Both should complete without error
 	})
 }
+
+func TestConfigClone(t *testing.T) {
+	source := Config{
+		PollInterval: time.Second,
+		InitialState: []byte(`{"files":[]}`),
+		Audit: AuditConfig{
+			Enabled:         true,
+			ComponentLevels: map[string]AuditLevel{"poller": AuditWarn},
+			Sinks:           []AuditSink{NewJSONAuditSink(io.Discard)},
+		},
+		Remote: RemoteConfig{Enabled: true, PrimaryURL: "consul://localhost:8500/app"},
+	}
+
+	clone := source.Clone()
+	clone.PollInterval = time.Minute
+	clone.InitialState[0] = '['
+	clone.Audit.ComponentLevels["poller"] = AuditCritical
+	clone.Audit.ComponentLevels["remote"] = AuditInfo
+	clone.Audit.Sinks[0] = nil
+	clone.Audit.Sinks = append(clone.Audit.Sinks, nil)
+	clone.Remote.PrimaryURL = "etcd://localhost:2379/app"
+
+	if source.PollInterval != time.Second {
+		t.Errorf("Expected source PollInterval 1s, got %v", source.PollInterval)
+	}
+	if string(source.InitialState) != `{"files":[]}` {
+		t.Errorf("Expected source InitialState unchanged, got %s", source.InitialState)
+	}
+	if len(source.Audit.ComponentLevels) != 1 || source.Audit.ComponentLevels["poller"] != AuditWarn {
+		t.Errorf("Expected source ComponentLevels unchanged, got %v", source.Audit.ComponentLevels)
+	}
+	if len(source.Audit.Sinks) != 1 || source.Audit.Sinks[0] == nil {
+		t.Errorf("Expected source Sinks unchanged, got %v", source.Audit.Sinks)
+	}
+	if source.Remote.PrimaryURL != "consul://localhost:8500/app" {
+		t.Errorf("Expected source PrimaryURL unchanged, got %s", source.Remote.PrimaryURL)
+	}
+
+	var empty Config
+	if cloned := empty.Clone(); cloned.InitialState != nil || cloned.Audit.ComponentLevels != nil || cloned.Audit.Sinks != nil {
+		t.Error("Expected nil slices and maps to stay nil")
+	}
+}
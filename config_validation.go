@@ -14,8 +14,10 @@
 package argus
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -34,11 +36,25 @@ var (
 	ErrInvalidBufferSize      = errors.New(ErrCodeInvalidBufferSize, "buffer size must be positive")
 	ErrInvalidFlushInterval   = errors.New(ErrCodeInvalidFlushInterval, "flush interval must be positive")
 	ErrInvalidOutputFile      = errors.New(ErrCodeInvalidOutputFile, "audit output file path is invalid")
+	ErrInvalidAuditRotation   = errors.New(ErrCodeInvalidAuditConfig, "audit rotation limits cannot be negative")
+	ErrInvalidSQLiteBatchSize = errors.New(ErrCodeInvalidAuditConfig, "SQLite batch size cannot be negative")
 	ErrUnwritableOutputFile   = errors.New(ErrCodeUnwritableOutputFile, "audit output file is not writable")
 	ErrCacheTTLTooLarge       = errors.New(ErrCodeCacheTTLTooLarge, "cache TTL should not exceed poll interval")
 	ErrPollIntervalTooSmall   = errors.New(ErrCodePollIntervalTooSmall, "poll interval should be at least 10ms for stability")
 	ErrMaxFilesTooLarge       = errors.New(ErrCodeMaxFilesTooLarge, "max watched files exceeds recommended limit (10000)")
 	ErrBoreasCapacityInvalid  = errors.New(ErrCodeBoreasCapacityInvalid, "BoreasLite capacity must be power of 2")
+	ErrBoreasMaxCapacity      = errors.New(ErrCodeBoreasCapacityInvalid, "BoreasLite max capacity must be a power of 2 not below BoreasLiteCapacity")
+	ErrInvalidMaxFileSize     = errors.New(ErrCodeInvalidConfig, "max file size cannot be negative")
+	ErrInvalidParseStrictness = errors.New(ErrCodeInvalidConfig, "unknown parse strictness")
+	ErrInvalidEventsOverflow  = errors.New(ErrCodeInvalidConfig, "unknown events overflow policy")
+	ErrInvalidEventsShutdown  = errors.New(ErrCodeInvalidConfig, "unknown events shutdown policy")
+	ErrInvalidDuplicateWatch  = errors.New(ErrCodeInvalidConfig, "unknown duplicate watch policy")
+	ErrInvalidWatchOverlap    = errors.New(ErrCodeInvalidConfig, "unknown watch overlap policy")
+	ErrInvalidMergeStrategy   = errors.New(ErrCodeInvalidConfig, "unknown remote merge strategy")
+	ErrInvalidNormalizeKeys   = errors.New(ErrCodeInvalidConfig, "unknown key normalization scheme")
+	ErrInvalidExpandEnv       = errors.New(ErrCodeInvalidConfig, "unknown environment expansion mode")
+	ErrInvalidDebounce        = errors.New(ErrCodeInvalidConfig, "debounce interval cannot be negative")
+	ErrInvalidDeleteGrace     = errors.New(ErrCodeInvalidConfig, "delete grace period cannot be negative")
 )
 
 // ValidationResult contains the result of configuration validation with detailed feedback.
@@ -84,12 +100,40 @@ func (c *Config) Validate() error {
 				return ErrInvalidOptimization
 			case firstError == ErrBoreasCapacityInvalid.Error():
 				return ErrBoreasCapacityInvalid
+			case firstError == ErrBoreasMaxCapacity.Error():
+				return ErrBoreasMaxCapacity
+			case firstError == ErrInvalidMaxFileSize.Error():
+				return ErrInvalidMaxFileSize
+			case firstError == ErrInvalidParseStrictness.Error():
+				return ErrInvalidParseStrictness
+			case firstError == ErrInvalidEventsOverflow.Error():
+				return ErrInvalidEventsOverflow
+			case firstError == ErrInvalidEventsShutdown.Error():
+				return ErrInvalidEventsShutdown
+			case firstError == ErrInvalidDuplicateWatch.Error():
+				return ErrInvalidDuplicateWatch
+			case firstError == ErrInvalidWatchOverlap.Error():
+				return ErrInvalidWatchOverlap
+			case firstError == ErrInvalidMergeStrategy.Error():
+				return ErrInvalidMergeStrategy
+			case firstError == ErrInvalidNormalizeKeys.Error():
+				return ErrInvalidNormalizeKeys
+			case firstError == ErrInvalidExpandEnv.Error():
+				return ErrInvalidExpandEnv
+			case firstError == ErrInvalidDebounce.Error():
+				return ErrInvalidDebounce
+			case firstError == ErrInvalidDeleteGrace.Error():
+				return ErrInvalidDeleteGrace
 			case firstError == ErrInvalidBufferSize.Error():
 				return ErrInvalidBufferSize
 			case firstError == ErrInvalidFlushInterval.Error():
 				return ErrInvalidFlushInterval
 			case firstError == ErrInvalidOutputFile.Error():
 				return ErrInvalidOutputFile
+			case firstError == ErrInvalidAuditRotation.Error():
+				return ErrInvalidAuditRotation
+			case firstError == ErrInvalidSQLiteBatchSize.Error():
+				return ErrInvalidSQLiteBatchSize
 			case firstError == ErrUnwritableOutputFile.Error():
 				return ErrUnwritableOutputFile
 			default:
@@ -131,42 +175,152 @@ func (c *Config) ValidateDetailed() ValidationResult {
 	return result
 }
 
+// ValidateRange checks that min <= value <= max and returns an error carrying
+// code and msg otherwise. It works with any ordered type, including
+// time.Duration, and is the building block for Argus' own Config validation.
+//
+// Example:
+//
+//	if err := argus.ValidateRange(port, 1, 65535, "APP_INVALID_PORT", "port out of range"); err != nil {
+//	    return err
+//	}
+func ValidateRange[T cmp.Ordered](value, min, max T, code, msg string) error {
+	if value < min || value > max {
+		return errors.New(errors.ErrorCode(code), msg).
+			WithContext("value", value).
+			WithContext("min", min).
+			WithContext("max", max)
+	}
+	return nil
+}
+
+// ValidateOneOf checks that value is one of allowed and returns an error
+// carrying code and msg otherwise.
+//
+// Example:
+//
+//	err := argus.ValidateOneOf(env, []string{"dev", "staging", "prod"}, "APP_INVALID_ENV", "unknown environment")
+func ValidateOneOf[T comparable](value T, allowed []T, code, msg string) error {
+	for _, a := range allowed {
+		if a == value {
+			return nil
+		}
+	}
+	return errors.New(errors.ErrorCode(code), msg).
+		WithContext("value", value).
+		WithContext("allowed", allowed)
+}
+
+// checkRange runs ValidateRange reporting the code and message of a sentinel error,
+// so detailed results keep matching the exported Err* values
+func checkRange[T cmp.Ordered](value, min, max T, sentinel *errors.Error) error {
+	return ValidateRange(value, min, max, string(sentinel.Code), sentinel.Message)
+}
+
 // validateCoreConfig validates essential configuration parameters
 func (c *Config) validateCoreConfig(result *ValidationResult) {
+	const maxDuration = time.Duration(math.MaxInt64)
+
 	// Poll interval validation
 	pollIntervalValid := true
-	if c.PollInterval <= 0 {
-		result.Errors = append(result.Errors, ErrInvalidPollInterval.Error())
+	if err := checkRange(c.PollInterval, 1, maxDuration, ErrInvalidPollInterval); err != nil {
+		result.Errors = append(result.Errors, err.Error())
 		pollIntervalValid = false
-	} else if c.PollInterval < 10*time.Millisecond {
-		result.Errors = append(result.Errors, ErrPollIntervalTooSmall.Error())
+	} else if err := checkRange(c.PollInterval, 10*time.Millisecond, maxDuration, ErrPollIntervalTooSmall); err != nil {
+		result.Errors = append(result.Errors, err.Error())
 		pollIntervalValid = false
 	}
 
 	// Cache TTL validation
-	if c.CacheTTL < 0 {
-		result.Errors = append(result.Errors, ErrInvalidCacheTTL.Error())
+	if err := checkRange(c.CacheTTL, 0, maxDuration, ErrInvalidCacheTTL); err != nil {
+		result.Errors = append(result.Errors, err.Error())
 	} else if pollIntervalValid && c.CacheTTL > c.PollInterval {
 		// Only check this if PollInterval is valid
 		result.Warnings = append(result.Warnings, ErrCacheTTLTooLarge.Error())
 	}
 
 	// Max watched files validation
-	if c.MaxWatchedFiles <= 0 {
-		result.Errors = append(result.Errors, ErrInvalidMaxWatchedFiles.Error())
-	} else if c.MaxWatchedFiles > 10000 {
-		result.Warnings = append(result.Warnings, ErrMaxFilesTooLarge.Error())
+	if err := checkRange(c.MaxWatchedFiles, 1, math.MaxInt, ErrInvalidMaxWatchedFiles); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else if err := checkRange(c.MaxWatchedFiles, 1, 10000, ErrMaxFilesTooLarge); err != nil {
+		result.Warnings = append(result.Warnings, err.Error())
+	}
+
+	// Debounce validation (0 delivers every change immediately)
+	if err := checkRange(c.DebounceInterval, 0, maxDuration, ErrInvalidDebounce); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Delete grace validation (0 reports deletes immediately)
+	if err := checkRange(c.DeleteGracePeriod, 0, maxDuration, ErrInvalidDeleteGrace); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Max file size validation (0 disables the limit)
+	if err := checkRange(c.MaxFileSize, 0, math.MaxInt64, ErrInvalidMaxFileSize); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Parse strictness validation
+	if err := ValidateOneOf(c.ParseStrictness, []ParseStrictness{ParseLenient, ParseStrict},
+		string(ErrInvalidParseStrictness.Code), ErrInvalidParseStrictness.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Events overflow policy validation
+	if err := ValidateOneOf(c.EventsOverflow, []EventsOverflowPolicy{EventsDropNewest, EventsDropOldest},
+		string(ErrInvalidEventsOverflow.Code), ErrInvalidEventsOverflow.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Events shutdown policy validation
+	if err := ValidateOneOf(c.EventsShutdown, []EventsShutdownPolicy{EventsShutdownDrain, EventsShutdownDiscard},
+		string(ErrInvalidEventsShutdown.Code), ErrInvalidEventsShutdown.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Duplicate watch policy validation
+	if err := ValidateOneOf(c.DuplicateWatch,
+		[]DuplicateWatchPolicy{DuplicateWatchReplace, DuplicateWatchError, DuplicateWatchFanOut},
+		string(ErrInvalidDuplicateWatch.Code), ErrInvalidDuplicateWatch.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Watch overlap policy validation
+	if err := ValidateOneOf(c.WatchOverlap, []OverlapPolicy{OverlapWarn, OverlapError, OverlapIgnore},
+		string(ErrInvalidWatchOverlap.Code), ErrInvalidWatchOverlap.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Remote merge strategy validation
+	if err := ValidateOneOf(c.Remote.MergeStrategy, []MergeStrategy{MergeNone, MergeRemoteWins, MergeLocalWins, MergeDeep},
+		string(ErrInvalidMergeStrategy.Code), ErrInvalidMergeStrategy.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Key normalization validation
+	if err := ValidateOneOf(c.NormalizeKeys, []KeyNormalization{KeysAsIs, KeysLowercase, KeysSnakeCase},
+		string(ErrInvalidNormalizeKeys.Code), ErrInvalidNormalizeKeys.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	// Environment expansion validation
+	if err := ValidateOneOf(c.ExpandEnv, []EnvExpansion{EnvExpandOff, EnvExpand, EnvExpandStrict},
+		string(ErrInvalidExpandEnv.Code), ErrInvalidExpandEnv.Message); err != nil {
+		result.Errors = append(result.Errors, err.Error())
 	}
 }
 
 // validateOptimizationStrategy validates the optimization strategy setting
 func (c *Config) validateOptimizationStrategy(result *ValidationResult) {
-	switch c.OptimizationStrategy {
-	case OptimizationSingleEvent, OptimizationSmallBatch, OptimizationLargeBatch, OptimizationAuto:
-		// Valid strategies (including OptimizationAuto which is 0)
-	default:
+	// Valid strategies (including OptimizationAuto which is 0)
+	validStrategies := []OptimizationStrategy{
+		OptimizationAuto, OptimizationSingleEvent, OptimizationSmallBatch, OptimizationLargeBatch,
+	}
+	if err := ValidateOneOf(c.OptimizationStrategy, validStrategies,
+		string(ErrInvalidOptimization.Code), ErrInvalidOptimization.Message); err != nil {
 		result.Errors = append(result.Errors,
-			fmt.Sprintf("%s: '%v'", ErrInvalidOptimization.Error(), c.OptimizationStrategy))
+			fmt.Sprintf("%s: '%v'", err.Error(), c.OptimizationStrategy))
 	}
 }
 
@@ -184,6 +338,14 @@ func (c *Config) validateBoreasCapacity(result *ValidationResult) {
 				"Large BoreasLite capacity may consume significant memory")
 		}
 	}
+
+	// The adaptive ceiling only matters when adaptive capacity is enabled
+	if c.AdaptiveCapacity && c.BoreasLiteMaxCapacity != 0 {
+		maxCap := c.BoreasLiteMaxCapacity
+		if maxCap < 0 || maxCap&(maxCap-1) != 0 || maxCap < c.BoreasLiteCapacity {
+			result.Errors = append(result.Errors, ErrBoreasMaxCapacity.Error())
+		}
+	}
 }
 
 // validateAuditConfig validates audit configuration if enabled
@@ -195,6 +357,26 @@ func (c *Config) validateAuditConfig(result *ValidationResult) {
 	c.validateAuditBufferSize(result)
 	c.validateAuditFlushInterval(result)
 	c.validateAuditOutputFile(result)
+	c.validateAuditRotation(result)
+	c.validateSQLiteBatchSize(result)
+}
+
+// validateAuditRotation validates JSONL audit file rotation limits
+func (c *Config) validateAuditRotation(result *ValidationResult) {
+	if c.Audit.MaxFileSize < 0 || c.Audit.MaxBackups < 0 {
+		result.Errors = append(result.Errors, ErrInvalidAuditRotation.Error())
+	} else if c.Audit.MaxFileSize > 0 && !isJSONLAuditPath(c.Audit.OutputFile) {
+		result.Warnings = append(result.Warnings, "Audit MaxFileSize only rotates JSONL audit files (.jsonl)")
+	}
+}
+
+// validateSQLiteBatchSize validates the asynchronous SQLite transaction size
+func (c *Config) validateSQLiteBatchSize(result *ValidationResult) {
+	if c.Audit.SQLiteBatchSize < 0 {
+		result.Errors = append(result.Errors, ErrInvalidSQLiteBatchSize.Error())
+	} else if c.Audit.SQLiteBatchSize > 0 && isJSONLAuditPath(c.Audit.OutputFile) {
+		result.Warnings = append(result.Warnings, "Audit SQLiteBatchSize has no effect on JSONL audit files")
+	}
 }
 
 // validateAuditBufferSize validates audit buffer size configuration
@@ -654,3 +654,92 @@ func TestValidationOfInvalidConfigDirectly(t *testing.T) {
 
 	t.Logf("Validation correctly caught errors: %v", result.Errors)
 }
+
+func TestValidateRange_Boundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		wantErr bool
+	}{
+		{"below min", 0, true},
+		{"at min", 1, false},
+		{"inside", 50, false},
+		{"at max", 100, false},
+		{"above max", 101, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRange(tt.value, 1, 100, "APP_INVALID_WORKERS", "workers out of range")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateRange(%d) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "APP_INVALID_WORKERS") {
+				t.Errorf("Expected custom error code, got %v", err)
+			}
+		})
+	}
+
+	// Durations are supported through the generic constraint
+	if err := ValidateRange(10*time.Millisecond, 10*time.Millisecond, time.Second, "C", "m"); err != nil {
+		t.Errorf("Expected duration at min bound to be valid, got %v", err)
+	}
+	if err := ValidateRange(9*time.Millisecond, 10*time.Millisecond, time.Second, "C", "m"); err == nil {
+		t.Error("Expected duration below min bound to be invalid")
+	}
+}
+
+func TestValidateOneOf(t *testing.T) {
+	allowed := []string{"dev", "staging", "prod"}
+	if err := ValidateOneOf("prod", allowed, "APP_INVALID_ENV", "unknown environment"); err != nil {
+		t.Errorf("Expected prod to be allowed, got %v", err)
+	}
+	if err := ValidateOneOf("qa", allowed, "APP_INVALID_ENV", "unknown environment"); err == nil {
+		t.Error("Expected qa to be rejected")
+	}
+	if err := ValidateOneOf(1, nil, "C", "m"); err == nil {
+		t.Error("Expected empty allowed set to reject every value")
+	}
+}
+
+func TestConfig_CoreRangeBoundaries(t *testing.T) {
+	base := Config{PollInterval: time.Second, CacheTTL: 0, MaxWatchedFiles: 1}
+
+	tests := []struct {
+		name        string
+		mutate      func(c *Config)
+		wantErr     error
+		wantWarning bool
+	}{
+		{"poll interval at 10ms", func(c *Config) { c.PollInterval = 10 * time.Millisecond }, nil, false},
+		{"poll interval just below 10ms", func(c *Config) { c.PollInterval = 10*time.Millisecond - 1 }, ErrPollIntervalTooSmall, false},
+		{"poll interval zero", func(c *Config) { c.PollInterval = 0 }, ErrInvalidPollInterval, false},
+		{"max files at 1", func(c *Config) { c.MaxWatchedFiles = 1 }, nil, false},
+		{"max files zero", func(c *Config) { c.MaxWatchedFiles = 0 }, ErrInvalidMaxWatchedFiles, false},
+		{"max files at 10000", func(c *Config) { c.MaxWatchedFiles = 10000 }, nil, false},
+		{"max files above 10000", func(c *Config) { c.MaxWatchedFiles = 10001 }, nil, true},
+		{"max file size negative", func(c *Config) { c.MaxFileSize = -1 }, ErrInvalidMaxFileSize, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := base
+			tt.mutate(&cfg)
+
+			if err := cfg.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+
+			result := cfg.ValidateDetailed()
+			hasWarning := false
+			for _, w := range result.Warnings {
+				if w == ErrMaxFilesTooLarge.Error() {
+					hasWarning = true
+				}
+			}
+			if hasWarning != tt.wantWarning {
+				t.Errorf("max files warning = %v, want %v (warnings: %v)", hasWarning, tt.wantWarning, result.Warnings)
+			}
+		})
+	}
+}
@@ -620,7 +620,7 @@ func (w *ConfigWriter) atomicWrite(data []byte) error {
 // Uses pre-allocated buffer to minimize allocations.
 func (w *ConfigWriter) serializeConfig(config map[string]interface{}, buffer []byte) ([]byte, error) {
 	switch w.format {
-	case FormatJSON:
+	case FormatJSON, FormatJSONC: // Comments are not preserved
 		return serializeJSON(config, buffer)
 	case FormatYAML:
 		return serializeYAML(config, buffer)
@@ -899,7 +899,7 @@ func serializeProperties(config map[string]interface{}, buffer []byte) ([]byte,
 	flattened := flattenConfig(config, "")
 
 	for key, value := range flattened {
-		lines = append(lines, fmt.Sprintf("%s=%v", key, value))
+		lines = append(lines, escapeProperties(key, true)+"="+escapeProperties(fmt.Sprint(value), false))
 	}
 
 	data := []byte(strings.Join(lines, "\n"))
@@ -914,6 +914,39 @@ func serializeProperties(config map[string]interface{}, buffer []byte) ([]byte,
 	return data, nil
 }
 
+// escapeProperties escapes s so parseProperties reads it back unchanged.
+// Keys additionally escape separators and comment markers.
+func escapeProperties(s string, isKey bool) string {
+	var sb strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '=', ':', '#', '!':
+			if isKey {
+				sb.WriteByte('\\')
+			}
+			sb.WriteRune(r)
+		case ' ':
+			if isKey || i == 0 { // Leading value spaces would be trimmed
+				sb.WriteByte('\\')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
 // flattenConfig converts nested maps to flat key-value pairs using dot notation
 func flattenConfig(config map[string]interface{}, prefix string) map[string]interface{} {
 	result := make(map[string]interface{})
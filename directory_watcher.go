@@ -62,6 +62,10 @@ type DirectoryWatchOptions struct {
 
 	// Context for cancellation (optional)
 	Context context.Context
+
+	// Overlap selects how an overlap with active file or directory watches
+	// is reported (default: OverlapWarn, logged with the standard logger)
+	Overlap OverlapPolicy
 }
 
 // DirectoryWatcher watches a directory for configuration file changes
@@ -79,6 +83,7 @@ type DirectoryWatcher struct {
 	closed         bool
 	closedCh       chan struct{}
 	individualMode bool
+	deepMerge      bool // Merged mode merges nested maps key by key
 }
 
 // fileState tracks known files and their modification times
@@ -144,6 +149,7 @@ func (dw *DirectoryWatcher) Close() error {
 		return nil
 	}
 	dw.closed = true
+	unregisterDirWatch(dw)
 
 	// Cancel context to stop goroutines
 	dw.cancel()
@@ -208,6 +214,20 @@ func watchDirectoryInternal(
 		options.Patterns = []string{"*.yaml", "*.yml", "*.json", "*.toml", "*.ini"}
 	}
 
+	// Detect overlaps with active watches, which would report changes twice
+	absDir, err := filepath.Abs(cleanPath)
+	if err != nil {
+		return nil, fmt.Errorf("argus: invalid directory path: %w", err)
+	}
+	entry := dirWatchEntry{dir: absDir, recursive: options.Recursive, patterns: options.Patterns}
+	if err := ValidateOneOf(options.Overlap, []OverlapPolicy{OverlapWarn, OverlapError, OverlapIgnore},
+		string(ErrInvalidWatchOverlap.Code), ErrInvalidWatchOverlap.Message); err != nil {
+		return nil, err
+	}
+	if err := reportOverlap(options.Overlap, dirOverlap(entry), absDir, nil); err != nil {
+		return nil, err
+	}
+
 	// Default poll interval
 	if options.PollInterval == 0 {
 		options.PollInterval = 1 * time.Second
@@ -238,6 +258,8 @@ func watchDirectoryInternal(
 		return nil, fmt.Errorf("argus: initial directory scan failed: %w", err)
 	}
 
+	registerDirWatch(dw, entry)
+
 	// Start directory polling for new/deleted files
 	dw.scanTicker = time.NewTicker(options.PollInterval)
 	go dw.pollLoop()
@@ -307,6 +329,14 @@ func (dw *DirectoryWatcher) scan() error {
 			return nil
 		}
 
+		// Symlinked files (Kubernetes ConfigMap mounts) change by swapping
+		// the link target, so compare the target's modification time
+		if info.Mode()&os.ModeSymlink != 0 {
+			if target, err := os.Stat(path); err == nil && !target.IsDir() {
+				info = target
+			}
+		}
+
 		foundFiles[path] = true
 
 		dw.mu.RLock()
@@ -425,6 +455,10 @@ func (dw *DirectoryWatcher) notifyMerged(callback func(map[string]interface{}, [
 	merged := make(map[string]interface{})
 	for _, path := range files {
 		state := dw.files[path]
+		if dw.deepMerge {
+			merged = mergeProfileMaps(merged, state.config)
+			continue
+		}
 		for k, v := range state.config {
 			merged[k] = v
 		}
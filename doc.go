@@ -215,16 +215,17 @@
 // Argus supports distributed configuration management with built-in failover,
 // synchronization, and conflict resolution for multi-instance deployments.
 //
-//	remoteConfig := argus.RemoteConfig{
-//		Enabled:        true,
-//		PrimaryURL:     "https://config.example.com/api/v1",
-//		FallbackPath:   "/etc/argus/fallback.json",
-//		SyncInterval:   30 * time.Second,
-//		TimeoutConfig: argus.TimeoutConfig{
-//			Connection: 5 * time.Second,
-//			Read:       10 * time.Second,
-//		},
-//	}
+//	watcher := argus.New(argus.Config{Remote: argus.RemoteConfig{
+//		Enabled:      true,
+//		PrimaryURL:   "https://config.example.com/api/v1",
+//		FallbackPath: "/etc/argus/fallback.json",
+//		SyncInterval: 30 * time.Second,
+//	}})
+//	watcher.WatchRemote(func(event argus.ChangeEvent) {
+//		config, _, _ := watcher.RemoteConfig()
+//		log.Printf("configuration from %s: %v", event.Path, config)
+//	})
+//	watcher.Start()
 //
 // Remote configuration features:
 //   - Automatic failover to local fallback files
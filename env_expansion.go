@@ -0,0 +1,197 @@
+// env_expansion.go: Environment variable substitution in configuration values
+//
+// Deployments commonly template configuration files with placeholders
+// resolved from the environment at load time (the envsubst pattern):
+//
+//	database:
+//	  host: ${DB_HOST:-localhost}
+//	  password: ${SECRET_DB_PASSWORD}
+//
+// Expansion runs after parsing, on string values only, so consumers see
+// resolved values and keys are never rewritten. "$$" produces a literal "$".
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/agilira/go-errors"
+)
+
+// EnvExpansion selects whether and how ${VAR} placeholders are expanded
+type EnvExpansion int
+
+const (
+	// EnvExpandOff leaves values untouched (default)
+	EnvExpandOff EnvExpansion = iota
+
+	// EnvExpand replaces ${VAR}, $VAR and ${VAR:-default}; undefined
+	// variables without a default expand to the empty string
+	EnvExpand
+
+	// EnvExpandStrict is EnvExpand, but an undefined variable without a
+	// default is an error naming the variable and the key
+	EnvExpandStrict
+)
+
+// String returns the name of the expansion mode
+func (e EnvExpansion) String() string {
+	switch e {
+	case EnvExpandOff:
+		return "off"
+	case EnvExpand:
+		return "expand"
+	case EnvExpandStrict:
+		return "strict"
+	default:
+		return "unknown"
+	}
+}
+
+// ExpandEnvVars returns a copy of config with environment placeholders in
+// string values replaced, including values of nested maps and lists.
+// Supported syntax: ${VAR}, $VAR, ${VAR:-default} (default used when VAR is
+// unset or empty) and $$ for a literal dollar sign.
+//
+// Example:
+//
+//	config, err = argus.ExpandEnvVars(config, argus.EnvExpandStrict)
+func ExpandEnvVars(config map[string]interface{}, mode EnvExpansion) (map[string]interface{}, error) {
+	if mode == EnvExpandOff || config == nil {
+		return config, nil
+	}
+	expanded, err := expandEnvValue(config, mode, "")
+	if err != nil {
+		return nil, err
+	}
+	return expanded.(map[string]interface{}), nil
+}
+
+// expandEnvValue expands placeholders in the strings found in value;
+// path locates value for error messages
+func expandEnvValue(value interface{}, mode EnvExpansion, path string) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return expandEnvString(v, mode, path)
+
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			expanded, err := expandEnvValue(item, mode, joinKeyPath(path, key))
+			if err != nil {
+				return nil, err
+			}
+			result[key] = expanded
+		}
+		return result, nil
+
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			expanded, err := expandEnvValue(item, mode, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = expanded
+		}
+		return result, nil
+
+	default:
+		return value, nil
+	}
+}
+
+// expandEnvString expands the placeholders of a single value
+func expandEnvString(s string, mode EnvExpansion, path string) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil // Fast path: nothing to expand
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 >= len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		var name, def string
+		hasDefault := false
+		next := s[i+1]
+
+		switch {
+		case next == '$':
+			sb.WriteByte('$')
+			i++
+			continue
+
+		case next == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				return "", errors.New(ErrCodeInvalidConfig,
+					fmt.Sprintf("unterminated placeholder in '%s'", path))
+			}
+			name, def, hasDefault = strings.Cut(s[i+2:i+2+end], ":-")
+			if !isEnvVarName(name) {
+				return "", errors.New(ErrCodeInvalidConfig,
+					fmt.Sprintf("invalid variable name '%s' in '%s'", name, path))
+			}
+			i += 2 + end
+
+		case isEnvVarStart(next):
+			j := i + 1
+			for j < len(s) && isEnvVarChar(s[j]) {
+				j++
+			}
+			name = s[i+1 : j]
+			i = j - 1
+
+		default:
+			sb.WriteByte('$') // Not a placeholder, e.g. "$5"
+			continue
+		}
+
+		value, set := os.LookupEnv(name)
+		switch {
+		case value != "":
+		case hasDefault:
+			value = def
+		case !set && mode == EnvExpandStrict:
+			return "", errors.New(ErrCodeInvalidConfig,
+				fmt.Sprintf("undefined environment variable '%s' in '%s'", name, path))
+		}
+		sb.WriteString(value)
+	}
+
+	return sb.String(), nil
+}
+
+// isEnvVarName reports whether name is a valid environment variable name
+func isEnvVarName(name string) bool {
+	if name == "" || !isEnvVarStart(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isEnvVarChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isEnvVarStart reports whether c may start a variable name
+func isEnvVarStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isEnvVarChar reports whether c may appear in a variable name
+func isEnvVarChar(c byte) bool {
+	return isEnvVarStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,99 @@
+// env_expansion_test.go: Tests for environment variable substitution
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExpandEnvVars(t *testing.T) {
+	t.Setenv("ARGUS_TEST_HOST", "db.internal")
+	t.Setenv("ARGUS_TEST_EMPTY", "")
+
+	tests := []struct {
+		name  string
+		value string
+		mode  EnvExpansion
+		want  string
+	}{
+		{"braced", "${ARGUS_TEST_HOST}", EnvExpand, "db.internal"},
+		{"bare", "postgres://$ARGUS_TEST_HOST:5432", EnvExpand, "postgres://db.internal:5432"},
+		{"default unused", "${ARGUS_TEST_HOST:-localhost}", EnvExpand, "db.internal"},
+		{"default undefined", "${ARGUS_TEST_UNDEFINED:-localhost}", EnvExpandStrict, "localhost"},
+		{"default empty", "${ARGUS_TEST_EMPTY:-localhost}", EnvExpand, "localhost"},
+		{"undefined lenient", "host=${ARGUS_TEST_UNDEFINED}", EnvExpand, "host="},
+		{"empty strict", "${ARGUS_TEST_EMPTY}", EnvExpandStrict, ""},
+		{"escaped dollar", "price $$5 and $5", EnvExpand, "price $5 and $5"},
+		{"off", "${ARGUS_TEST_HOST}", EnvExpandOff, "${ARGUS_TEST_HOST}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"db": map[string]interface{}{"host": tt.value},
+			}
+			expanded, err := ExpandEnvVars(config, tt.mode)
+			if err != nil {
+				t.Fatalf("Failed to expand: %v", err)
+			}
+			got := expanded["db"].(map[string]interface{})["host"]
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExpandEnvVars_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantMsg string
+	}{
+		{"undefined strict", "${ARGUS_TEST_UNDEFINED}", "undefined environment variable 'ARGUS_TEST_UNDEFINED' in 'servers[0].host'"},
+		{"unterminated", "${ARGUS_TEST_HOST", "unterminated placeholder"},
+		{"invalid name", "${1BAD}", "invalid variable name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"servers": []interface{}{map[string]interface{}{"host": tt.value}},
+			}
+			_, err := ExpandEnvVars(config, EnvExpandStrict)
+			if err == nil || !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantMsg, err)
+			}
+		})
+	}
+}
+
+func TestUniversalConfigWatcher_ExpandEnv(t *testing.T) {
+	t.Setenv("ARGUS_TEST_PASSWORD", "s3cret")
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	data := "password: ${ARGUS_TEST_PASSWORD}\nport: 5432\n"
+	if err := os.WriteFile(configPath, []byte(data), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	var got map[string]interface{}
+	watcher, err := UniversalConfigWatcherWithConfig(configPath, func(config map[string]interface{}) {
+		got = config
+	}, Config{DisableAudit: true, ExpandEnv: EnvExpandStrict})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	if got["password"] != "s3cret" || got["port"] != 5432 {
+		t.Errorf("Expected expanded password and untouched port, got %+v", got)
+	}
+}
@@ -0,0 +1,102 @@
+// fire_on_start_test.go: Tests for initial callbacks with Config.FireOnStart
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestWatcher_FireOnStart(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	latePath := filepath.Join(dir, "late.json")
+	missingPath := filepath.Join(dir, "missing.json")
+	for _, path := range []string{configPath, latePath} {
+		if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+	}
+
+	handlerErrs := make(chan error, 4)
+	watcher := New(Config{
+		PollInterval: 10 * time.Second, // Only the synthetic events can fire
+		FireOnStart:  true,
+		DisableAudit: true,
+		ErrorHandler: func(err error, path string) { handlerErrs <- err },
+	})
+	defer func() { _ = watcher.Stop() }()
+
+	events := make(chan ChangeEvent, 4)
+	for _, path := range []string{configPath, missingPath} {
+		if err := watcher.Watch(path, func(event ChangeEvent) { events <- event }); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		absPath, _ := filepath.Abs(configPath)
+		if event.Path != absPath || !event.IsModify || event.Size == 0 {
+			t.Errorf("Expected a synthetic IsModify event for %s, got %+v", absPath, event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the callback to fire on Start")
+	}
+	select {
+	case err := <-handlerErrs:
+		if !errors.HasCode(err, ErrCodeFileNotFound) {
+			t.Errorf("Expected %s for the missing file, got %v", ErrCodeFileNotFound, err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the missing file to be reported to the ErrorHandler")
+	}
+
+	// Files watched while running fire once as well
+	if err := watcher.Watch(latePath, func(event ChangeEvent) { events <- event }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	select {
+	case <-events:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the callback to fire for a file watched after Start")
+	}
+	select {
+	case event := <-events:
+		t.Errorf("Expected no further events, got %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestUniversalConfigWatcher_IgnoresFireOnStart(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	var calls atomic.Int32
+	watcher, err := UniversalConfigWatcherWithConfig(configPath, func(map[string]interface{}) {
+		calls.Add(1)
+	}, Config{PollInterval: 10 * time.Second, FireOnStart: true, DisableAudit: true})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	time.Sleep(100 * time.Millisecond)
+	if n := calls.Load(); n != 1 {
+		t.Errorf("Expected the initial configuration exactly once, got %d calls", n)
+	}
+}
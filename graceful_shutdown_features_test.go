@@ -14,7 +14,10 @@
 package argus
 
 import (
+	"context"
+	goerrors "errors"
 	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -379,3 +382,155 @@ func createTempConfigFile(t *testing.T, content string) (string, func()) {
 
 	return tempFile.Name(), cleanup
 }
+
+// TestGracefulShutdown_ShutdownHooks tests LIFO execution and error aggregation
+func TestGracefulShutdown_ShutdownHooks(t *testing.T) {
+	watcher := New(Config{PollInterval: 50 * time.Millisecond})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	errDB := goerrors.New("db close failed")
+	errClient := goerrors.New("client close failed")
+
+	var order []string
+	watcher.OnShutdown(func(ctx context.Context) error {
+		order = append(order, "db")
+		return errDB
+	})
+	watcher.OnShutdown(func(ctx context.Context) error {
+		order = append(order, "cache")
+		return nil
+	})
+	watcher.OnShutdown(func(ctx context.Context) error {
+		order = append(order, "client")
+		return errClient
+	})
+
+	err := watcher.GracefulShutdown(2 * time.Second)
+	if err == nil {
+		t.Fatal("Expected aggregated hook error, got nil")
+	}
+	if !containsErrorCode(err.Error(), ErrCodeShutdownHook) {
+		t.Errorf("Expected %s error code, got: %v", ErrCodeShutdownHook, err)
+	}
+	if !goerrors.Is(err, errDB) || !goerrors.Is(err, errClient) {
+		t.Errorf("Expected both hook errors to be reported, got: %v", err)
+	}
+
+	expected := []string{"client", "cache", "db"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected LIFO order %v, got %v", expected, order)
+	}
+}
+
+// TestGracefulShutdown_ShutdownHookTimeout tests hooks are bounded by the shutdown timeout
+func TestGracefulShutdown_ShutdownHookTimeout(t *testing.T) {
+	watcher := New(Config{PollInterval: 50 * time.Millisecond})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	watcher.OnShutdown(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	if err := watcher.GracefulShutdown(100 * time.Millisecond); err == nil {
+		t.Error("Expected timeout error from blocking hook")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Shutdown took too long with blocking hook: %v", elapsed)
+	}
+}
+
+// TestGracefulShutdown_ShutdownHooksNotRunning tests hooks run when the watcher is already stopped
+func TestGracefulShutdown_ShutdownHooksNotRunning(t *testing.T) {
+	watcher := New(Config{PollInterval: 50 * time.Millisecond})
+
+	errPool := goerrors.New("pool close failed")
+	var ran bool
+	watcher.OnShutdown(func(ctx context.Context) error {
+		ran = true
+		return errPool
+	})
+
+	err := watcher.GracefulShutdown(time.Second)
+	if !ran {
+		t.Fatal("Expected shutdown hook to run for a watcher that was not running")
+	}
+	if err == nil {
+		t.Fatal("Expected not-running error, got nil")
+	}
+	if !containsErrorCode(err.Error(), ErrCodeWatcherStopped) {
+		t.Errorf("Expected %s error code, got: %v", ErrCodeWatcherStopped, err)
+	}
+	if !goerrors.Is(err, errPool) {
+		t.Errorf("Expected hook error to be joined, got: %v", err)
+	}
+
+	// Hooks are consumed: a second call only reports the stopped watcher
+	ran = false
+	_ = watcher.GracefulShutdown(time.Second)
+	if ran {
+		t.Error("Expected hooks to run only once")
+	}
+}
+
+// TestGracefulShutdown_RegisterShutdownHook tests flush hooks run in registration
+// order before the audit trail closes and before OnShutdown hooks
+func TestGracefulShutdown_RegisterShutdownHook(t *testing.T) {
+	watcher := New(Config{PollInterval: 50 * time.Millisecond})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	errBuffer := goerrors.New("buffer flush failed")
+
+	var order []string
+	watcher.OnShutdown(func(ctx context.Context) error {
+		order = append(order, "release")
+		return nil
+	})
+	watcher.RegisterShutdownHook(func(ctx context.Context) error {
+		order = append(order, "metrics")
+		report, _ := watcher.ShutdownReport()
+		for _, s := range report.Subsystems {
+			if s.Name == ShutdownEventDrain && s.Status != ShutdownCompleted {
+				t.Errorf("Expected events drained before flush hooks, got %s", s.Status)
+			}
+			if s.Name == ShutdownAuditFlush && s.Status != ShutdownPending {
+				t.Errorf("Expected audit still open during flush hooks, got %s", s.Status)
+			}
+		}
+		return nil
+	})
+	watcher.RegisterShutdownHook(func(ctx context.Context) error {
+		order = append(order, "buffer")
+		return errBuffer
+	})
+
+	err := watcher.GracefulShutdown(2 * time.Second)
+	if err == nil {
+		t.Fatal("Expected flush hook error, got nil")
+	}
+	if !containsErrorCode(err.Error(), ErrCodeShutdownHook) {
+		t.Errorf("Expected %s error code, got: %v", ErrCodeShutdownHook, err)
+	}
+	if !goerrors.Is(err, errBuffer) {
+		t.Errorf("Expected flush hook error to be reported, got: %v", err)
+	}
+
+	expected := []string{"metrics", "buffer", "release"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected order %v, got %v", expected, order)
+	}
+
+	report, _ := watcher.ShutdownReport()
+	for _, s := range report.Subsystems {
+		if s.Name == ShutdownFlushHooks && s.Status != ShutdownFailed {
+			t.Errorf("Expected flush hooks reported failed, got %s", s.Status)
+		}
+	}
+}
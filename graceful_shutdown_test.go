@@ -7,13 +7,17 @@
 package argus
 
 import (
+	goerrors "errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/agilira/go-errors"
 )
 
 // TestImmediateShutdown verifies immediate stop behavior without graceful waiting
@@ -186,14 +190,13 @@ func TestCallbackPanicRecovery(t *testing.T) {
 	}
 	time.Sleep(50 * time.Millisecond)
 
-	// Verify results - with panic recovery, no errors are reported to ErrorHandler
+	// Verify results - the recovered panic is reported to ErrorHandler
 	if panicCount.Load() != 1 {
 		t.Errorf("Expected 1 panic, got %d", panicCount.Load())
 	}
 
-	// With panic recovery built into processFileEvent, errorCount should be 0
-	if errorCount.Load() != 0 {
-		t.Logf("Note: With panic recovery, ErrorHandler not called (got %d errors)", errorCount.Load())
+	if errorCount.Load() != 1 {
+		t.Errorf("Expected the panic to be reported to ErrorHandler once, got %d errors", errorCount.Load())
 	}
 
 	if successCount.Load() < 2 {
@@ -204,6 +207,45 @@ func TestCallbackPanicRecovery(t *testing.T) {
 		panicCount.Load(), errorCount.Load(), successCount.Load())
 }
 
+// TestCallbackPanicReported verifies a recovered panic reaches ErrorHandler
+// with the path, the panic value and the stack
+func TestCallbackPanicReported(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "panic.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	var reported error
+	var reportedPath string
+	watcher := New(Config{
+		DisableAudit: true,
+		ErrorHandler: func(err error, path string) {
+			reported, reportedPath = err, path
+		},
+	})
+	if err := watcher.Watch(configPath, func(ChangeEvent) { panic("handler bug") }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	deliverTestEvent(t, watcher, configPath)
+	if !errors.HasCode(reported, ErrCodeCallbackPanic) {
+		t.Fatalf("Expected an %s error, got %v", ErrCodeCallbackPanic, reported)
+	}
+	if !strings.HasSuffix(reportedPath, "panic.json") {
+		t.Errorf("Expected the panicking file's path, got %q", reportedPath)
+	}
+	var argusErr *errors.Error
+	if !goerrors.As(reported, &argusErr) {
+		t.Fatalf("Expected a structured error, got %T", reported)
+	}
+	if argusErr.Context["panic"] != "handler bug" {
+		t.Errorf("Expected the recovered value in the context, got %v", argusErr.Context["panic"])
+	}
+	if stack, _ := argusErr.Context["stack"].(string); !strings.Contains(stack, "TestCallbackPanicReported") {
+		t.Errorf("Expected the panicking goroutine's stack in the context, got %q", stack)
+	}
+}
+
 // TestConcurrentOperationsSafety verifies safe concurrent operations during shutdown
 func TestConcurrentOperationsSafety(t *testing.T) {
 	// Create test directory
@@ -0,0 +1,161 @@
+// key_normalization.go: Parse-time normalization of configuration keys
+//
+// Formats disagree on key casing (camelCase JSON, snake_case YAML, UPPER
+// environment-style properties). Normalizing every key once, right after
+// parsing, lets binders use a single spelling instead of per-binder case
+// handling.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/agilira/go-errors"
+)
+
+// KeyNormalization selects how configuration keys are rewritten after parsing
+type KeyNormalization int
+
+const (
+	// KeysAsIs keeps keys exactly as written (default)
+	KeysAsIs KeyNormalization = iota
+
+	// KeysLowercase lowercases keys: "MaxConns" becomes "maxconns"
+	KeysLowercase
+
+	// KeysSnakeCase converts keys to snake_case: "maxConns", "MaxConns" and
+	// "max-conns" all become "max_conns", "HTTPServer" becomes "http_server"
+	KeysSnakeCase
+)
+
+// String returns the name of the key normalization scheme
+func (k KeyNormalization) String() string {
+	switch k {
+	case KeysAsIs:
+		return "as-is"
+	case KeysLowercase:
+		return "lowercase"
+	case KeysSnakeCase:
+		return "snake_case"
+	default:
+		return "unknown"
+	}
+}
+
+// NormalizeKeys returns a copy of config with every key, including keys of
+// nested maps and of maps inside lists, rewritten according to scheme.
+// Dots are preserved, so flat dotted keys keep their hierarchy.
+//
+// Two keys of the same map normalizing to the same name (e.g. "Port" and
+// "port") are a collision: the config is rejected with an error naming
+// both keys rather than silently keeping one of them.
+//
+// Example:
+//
+//	config, err = argus.NormalizeKeys(config, argus.KeysSnakeCase)
+func NormalizeKeys(config map[string]interface{}, scheme KeyNormalization) (map[string]interface{}, error) {
+	if scheme == KeysAsIs || config == nil {
+		return config, nil
+	}
+	normalized, err := normalizeKeysValue(config, scheme, "")
+	if err != nil {
+		return nil, err
+	}
+	return normalized.(map[string]interface{}), nil
+}
+
+// normalizeKeysValue rewrites keys of maps found in value; path locates
+// value for collision errors
+func normalizeKeysValue(value interface{}, scheme KeyNormalization, path string) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys) // Deterministic collision reports
+
+		result := make(map[string]interface{}, len(v))
+		origins := make(map[string]string, len(v))
+		for _, key := range keys {
+			newKey := normalizeKey(key, scheme)
+			if first, dup := origins[newKey]; dup {
+				return nil, errors.New(ErrCodeInvalidConfig,
+					fmt.Sprintf("keys '%s' and '%s' both normalize to '%s'", joinKeyPath(path, first), joinKeyPath(path, key), newKey))
+			}
+			origins[newKey] = key
+
+			nested, err := normalizeKeysValue(v[key], scheme, joinKeyPath(path, newKey))
+			if err != nil {
+				return nil, err
+			}
+			result[newKey] = nested
+		}
+		return result, nil
+
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			nested, err := normalizeKeysValue(item, scheme, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = nested
+		}
+		return result, nil
+
+	default:
+		return value, nil
+	}
+}
+
+// normalizeKey rewrites a single key according to scheme
+func normalizeKey(key string, scheme KeyNormalization) string {
+	switch scheme {
+	case KeysLowercase:
+		return strings.ToLower(key)
+	case KeysSnakeCase:
+		return toSnakeCase(key)
+	default:
+		return key
+	}
+}
+
+// toSnakeCase converts camelCase, PascalCase, kebab-case and spaced keys to
+// snake_case. Acronyms stay together: "HTTPServer" becomes "http_server".
+func toSnakeCase(key string) string {
+	runes := []rune(key)
+	var sb strings.Builder
+	sb.Grow(len(key) + 4)
+
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == ' ':
+			sb.WriteByte('_')
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// joinKeyPath appends key to a dotted path
+func joinKeyPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
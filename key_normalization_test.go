@@ -0,0 +1,96 @@
+// key_normalization_test.go: Tests for parse-time key normalization
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeKeys(t *testing.T) {
+	config := map[string]interface{}{
+		"ServerName": "api",
+		"Database": map[string]interface{}{
+			"MaxConns":   10,
+			"HTTPServer": map[string]interface{}{"readTimeout": "5s"},
+		},
+		"upstreams": []interface{}{
+			map[string]interface{}{"HostName": "a"},
+		},
+		"cache-size": 64,
+	}
+
+	tests := []struct {
+		scheme KeyNormalization
+		want   []string
+	}{
+		{KeysLowercase, []string{"servername", "database.maxconns", "database.httpserver.readtimeout", "cache-size"}},
+		{KeysSnakeCase, []string{"server_name", "database.max_conns", "database.http_server.read_timeout", "cache_size"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme.String(), func(t *testing.T) {
+			normalized, err := NormalizeKeys(config, tt.scheme)
+			if err != nil {
+				t.Fatalf("Failed to normalize keys: %v", err)
+			}
+			for _, key := range tt.want {
+				if _, exists := NewConfigBinder(normalized).getValue(key); !exists {
+					t.Errorf("Expected key %q in %+v", key, normalized)
+				}
+			}
+			item := normalized["upstreams"].([]interface{})[0].(map[string]interface{})
+			if len(item) != 1 || (item["hostname"] == nil && item["host_name"] == nil) {
+				t.Errorf("Expected list items normalized, got %+v", item)
+			}
+		})
+	}
+
+	// The input map is not modified
+	if _, exists := config["ServerName"]; !exists {
+		t.Error("NormalizeKeys must not modify the input")
+	}
+}
+
+func TestNormalizeKeys_Collision(t *testing.T) {
+	config := map[string]interface{}{
+		"db": map[string]interface{}{"Port": 1, "port": 2},
+	}
+	_, err := NormalizeKeys(config, KeysLowercase)
+	if err == nil {
+		t.Fatal("Expected collision error")
+	}
+	if !strings.Contains(err.Error(), "'db.Port' and 'db.port'") {
+		t.Errorf("Expected both keys in error, got %v", err)
+	}
+}
+
+func TestUniversalConfigWatcher_NormalizeKeys(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"Server": {"MaxConns": 10}}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	var got map[string]interface{}
+	watcher, err := UniversalConfigWatcherWithConfig(configPath, func(config map[string]interface{}) {
+		got = config
+	}, Config{DisableAudit: true, NormalizeKeys: KeysSnakeCase})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	var maxConns int
+	if err := BindFromConfig(got).BindInt(&maxConns, "server.max_conns").Apply(); err != nil {
+		t.Fatalf("Failed to bind: %v", err)
+	}
+	if maxConns != 10 {
+		t.Errorf("Expected max_conns=10, got %d", maxConns)
+	}
+}
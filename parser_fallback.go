@@ -0,0 +1,122 @@
+// parser_fallback.go: Opt-in fallback between registered parsers
+//
+// A spec-strict plugin parser may reject a file that the built-in parser
+// reads fine. With fallback enabled, a failing parser hands the data to the
+// next parser supporting the format, ending with the built-in one, and the
+// parser that finally succeeded is reported. Fallback is off by default so
+// that real syntax errors are not masked.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/agilira/go-errors"
+)
+
+// BuiltinParserName is reported in ParseInfo when the built-in parser was used
+const BuiltinParserName = "builtin"
+
+// parserFallback enables retrying with lower-priority parsers
+var parserFallback atomic.Bool
+
+// SetParserFallback enables or disables parser fallback globally.
+// When enabled and the highest-priority parser for a format fails, Argus
+// retries with the next registered parser supporting the format and finally
+// with the built-in parser before returning an error.
+//
+// Example:
+//
+//	argus.RegisterParser(&StrictYAMLParser{})
+//	argus.SetParserFallback(true)
+func SetParserFallback(enabled bool) {
+	parserFallback.Store(enabled)
+}
+
+// ParserFallbackEnabled reports whether parser fallback is enabled
+func ParserFallbackEnabled() bool {
+	return parserFallback.Load()
+}
+
+// ParseInfo describes how a configuration was parsed
+type ParseInfo struct {
+	// Parser is the name of the parser that produced the result,
+	// BuiltinParserName for the built-in parsers
+	Parser string
+
+	// Failed lists, in order, the parsers that failed before Parser
+	// succeeded. Only populated when fallback is enabled.
+	Failed []string
+}
+
+// ParseConfigWithInfo parses configuration data like ParseConfig and reports
+// which parser produced the result.
+//
+// Example:
+//
+//	config, info, err := argus.ParseConfigWithInfo(data, argus.FormatYAML)
+//	if err == nil && len(info.Failed) > 0 {
+//	    log.Printf("parsed by %s after %v failed", info.Parser, info.Failed)
+//	}
+func ParseConfigWithInfo(data []byte, format ConfigFormat) (map[string]interface{}, ParseInfo, error) {
+	// Fast path: no custom parsers, see ParseConfig
+	if len(customParsers) == 0 {
+		config, err := parseBuiltin(data, format)
+		return config, ParseInfo{Parser: BuiltinParserName}, err
+	}
+
+	candidates := supportingParsers(format)
+	if len(candidates) == 0 {
+		config, err := parseBuiltin(data, format)
+		return config, ParseInfo{Parser: BuiltinParserName}, err
+	}
+
+	if !parserFallback.Load() {
+		config, err := candidates[0].Parse(data)
+		return config, ParseInfo{Parser: candidates[0].Name()}, err
+	}
+
+	var info ParseInfo
+	var errs []error
+	for _, parser := range candidates {
+		config, err := parser.Parse(data)
+		if err == nil {
+			info.Parser = parser.Name()
+			return config, info, nil
+		}
+		info.Failed = append(info.Failed, parser.Name())
+		errs = append(errs, fmt.Errorf("%s: %w", parser.Name(), err))
+	}
+
+	config, err := parseBuiltin(data, format)
+	if err == nil {
+		info.Parser = BuiltinParserName
+		return config, info, nil
+	}
+	info.Failed = append(info.Failed, BuiltinParserName)
+	errs = append(errs, fmt.Errorf("%s: %w", BuiltinParserName, err))
+
+	return nil, info, errors.Wrap(goerrors.Join(errs...), ErrCodeInvalidConfig,
+		fmt.Sprintf("all %d parsers failed for %s", len(errs), format))
+}
+
+// supportingParsers returns the registered parsers supporting format,
+// in priority order
+func supportingParsers(format ConfigFormat) []ConfigParser {
+	parserMutex.RLock()
+	defer parserMutex.RUnlock()
+
+	var candidates []ConfigParser
+	for _, parser := range customParsers {
+		if parser.Supports(format) {
+			candidates = append(candidates, parser)
+		}
+	}
+	return candidates
+}
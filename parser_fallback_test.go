@@ -0,0 +1,93 @@
+// parser_fallback_test.go: Tests for the opt-in parser fallback
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"testing"
+)
+
+// testStrictYAMLParser rejects every document, like a spec-strict plugin
+// refusing input the built-in parser accepts
+type testStrictYAMLParser struct{}
+
+func (p *testStrictYAMLParser) Parse(data []byte) (map[string]interface{}, error) {
+	return nil, goerrors.New("strict parser: unsupported construct")
+}
+
+func (p *testStrictYAMLParser) Supports(format ConfigFormat) bool {
+	return format == FormatYAML
+}
+
+func (p *testStrictYAMLParser) Name() string {
+	return "Strict YAML Parser"
+}
+
+func TestParserFallback(t *testing.T) {
+	parserMutex.Lock()
+	originalParsers := customParsers
+	customParsers = []ConfigParser{&testStrictYAMLParser{}}
+	parserMutex.Unlock()
+
+	defer func() {
+		parserMutex.Lock()
+		customParsers = originalParsers
+		parserMutex.Unlock()
+		SetParserFallback(false)
+	}()
+
+	yamlData := []byte("key: value\nport: 8080")
+
+	t.Run("disabled_surfaces_error", func(t *testing.T) {
+		SetParserFallback(false)
+
+		if _, err := ParseConfig(yamlData, FormatYAML); err == nil {
+			t.Fatal("Expected the strict parser error without fallback")
+		}
+		_, info, err := ParseConfigWithInfo(yamlData, FormatYAML)
+		if err == nil {
+			t.Fatal("Expected the strict parser error without fallback")
+		}
+		if info.Parser != "Strict YAML Parser" || len(info.Failed) != 0 {
+			t.Errorf("Unexpected parse info: %+v", info)
+		}
+	})
+
+	t.Run("enabled_falls_back_to_builtin", func(t *testing.T) {
+		SetParserFallback(true)
+
+		config, info, err := ParseConfigWithInfo(yamlData, FormatYAML)
+		if err != nil {
+			t.Fatalf("Failed to parse with fallback: %v", err)
+		}
+		if config["key"] != "value" {
+			t.Errorf("Expected key=value, got key=%v", config["key"])
+		}
+		if info.Parser != BuiltinParserName {
+			t.Errorf("Expected parser %q, got %q", BuiltinParserName, info.Parser)
+		}
+		if len(info.Failed) != 1 || info.Failed[0] != "Strict YAML Parser" {
+			t.Errorf("Expected the strict parser recorded as failed, got %v", info.Failed)
+		}
+
+		if _, err := ParseConfig(yamlData, FormatYAML); err != nil {
+			t.Errorf("ParseConfig should fall back too: %v", err)
+		}
+	})
+
+	t.Run("enabled_all_fail", func(t *testing.T) {
+		SetParserFallback(true)
+
+		_, info, err := ParseConfigWithInfo([]byte("key: [unclosed"), FormatYAML)
+		if err == nil {
+			t.Fatal("Expected an error when every parser fails")
+		}
+		if len(info.Failed) != 2 {
+			t.Errorf("Expected both parsers recorded as failed, got %v", info.Failed)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+// parser_ini_multiline_test.go: Tests for quoted and continued INI values
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestINIParserQuotedAndContinuedValues(t *testing.T) {
+	input := `
+[database]
+connection = "host=db;port=5432"
+port = "5432"
+hosts = alpha, \
+        beta, \
+        gamma
+dsn = "user=app \
+  password=secret"
+; comment ending with a backslash \
+timeout = 30
+`
+
+	result, err := parseINI([]byte(input))
+	if err != nil {
+		t.Fatalf("INI with quoted and continued values should parse: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"database.connection": "host=db;port=5432",
+		"database.port":       "5432", // Quoted: stays a string
+		"database.hosts":      "alpha, beta, gamma",
+		"database.dsn":        "user=app password=secret",
+		"database.timeout":    30,
+	}
+	for key, want := range expected {
+		if got := result[key]; got != want {
+			t.Errorf("Expected %s = %#v, got %#v", key, want, got)
+		}
+	}
+}
+
+func TestINIParserContinuationLineNumbers(t *testing.T) {
+	input := "[app]\nname = a \\\n  b\nname = c\n"
+
+	_, err := ParseConfigWithStrictness([]byte(input), FormatINI, ParseStrict)
+	if err == nil {
+		t.Fatal("Expected duplicate key error in strict mode")
+	}
+	if !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("Expected the duplicate to be reported on physical line 4, got: %v", err)
+	}
+
+	if _, err := parseINI([]byte("[app]\nkey \\\n= value\n")); err != nil {
+		t.Errorf("Expected a continued key line to parse, got %v", err)
+	}
+}
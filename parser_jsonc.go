@@ -0,0 +1,88 @@
+// parser_jsonc.go: JSON with comments and trailing commas (JSONC)
+//
+// Editors and many tools (VS Code, tsconfig, devcontainers) accept JSON with
+// // and /* */ comments plus trailing commas, and teams keep configuration in
+// that form. FormatJSONC strips both before handing the data to the standard
+// JSON decoder, so the parsed result is identical to plain JSON.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// parseJSONC parses JSON with comments and trailing commas
+func parseJSONC(data []byte) (map[string]interface{}, error) {
+	return parseJSON(stripJSONC(data))
+}
+
+// stripJSONC returns a copy of data with // line comments, /* */ block
+// comments and trailing commas before '}' or ']' replaced by spaces, leaving
+// plain JSON. Sequences inside string literals are untouched. Byte offsets
+// are preserved, so JSON syntax errors still point at the original position.
+func stripJSONC(data []byte) []byte {
+	out := make([]byte, len(data))
+	copy(out, data)
+
+	// Pass 1: blank out comments, preserving newlines
+	inString := false
+	for i := 0; i < len(out); i++ {
+		c := out[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++ // Skip the escaped character
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			for ; i < len(out) && out[i] != '\n'; i++ {
+				out[i] = ' '
+			}
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			out[i], out[i+1] = ' ', ' '
+			for i += 2; i < len(out); i++ {
+				if out[i] == '*' && i+1 < len(out) && out[i+1] == '/' {
+					out[i], out[i+1] = ' ', ' '
+					i++
+					break
+				}
+				if out[i] != '\n' {
+					out[i] = ' '
+				}
+			}
+		}
+	}
+
+	// Pass 2: blank out commas followed only by whitespace and a closer
+	inString = false
+	for i := 0; i < len(out); i++ {
+		c := out[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == ',':
+			j := i + 1
+			for j < len(out) && isJSONSpace(out[j]) {
+				j++
+			}
+			if j < len(out) && (out[j] == '}' || out[j] == ']') {
+				out[i] = ' '
+			}
+		}
+	}
+	return out
+}
+
+// isJSONSpace reports whether c is JSON insignificant whitespace
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
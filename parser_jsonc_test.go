@@ -0,0 +1,75 @@
+// parser_jsonc_test.go: Tests for JSON with comments and trailing commas
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"testing"
+)
+
+func TestJSONCParser(t *testing.T) {
+	input := `{
+  // Server settings
+  "server": {
+    "url": "http://example.com/api", // slashes inside a string
+    "pattern": "/* not a comment */",
+    "quote": "say \"hi\" // still a string",
+    "ports": [80, 443,],
+  },
+  /* block
+     comment */
+  "debug": true,
+}`
+
+	config, err := ParseConfig([]byte(input), FormatJSONC)
+	if err != nil {
+		t.Fatalf("JSONC should parse successfully: %v", err)
+	}
+
+	server, _ := config["server"].(map[string]interface{})
+	if server["url"] != "http://example.com/api" {
+		t.Errorf("Expected // inside a string to be preserved, got %v", server["url"])
+	}
+	if server["pattern"] != "/* not a comment */" {
+		t.Errorf("Expected /* inside a string to be preserved, got %v", server["pattern"])
+	}
+	if server["quote"] != `say "hi" // still a string` {
+		t.Errorf("Expected escaped quotes to keep the string open, got %v", server["quote"])
+	}
+	if ports, _ := server["ports"].([]interface{}); len(ports) != 2 {
+		t.Errorf("Expected trailing comma in array to be dropped, got %v", server["ports"])
+	}
+	if config["debug"] != true {
+		t.Errorf("Expected debug after block comment, got %v", config["debug"])
+	}
+
+	if _, err := ParseConfig([]byte(input), FormatJSON); err == nil {
+		t.Error("Expected plain JSON parsing to reject comments")
+	}
+	if _, err := ParseConfig([]byte(`{"a": 1 /* unterminated`), FormatJSONC); err == nil {
+		t.Error("Expected an unterminated block comment to fail")
+	}
+}
+
+func TestJSONCFormatDetection(t *testing.T) {
+	if format := DetectFormat("/etc/app/settings.JSONC"); format != FormatJSONC {
+		t.Errorf("Expected .jsonc to be detected as JSONC, got %v", format)
+	}
+	if format := DetectFormat("config.json"); format != FormatJSON {
+		t.Errorf("Expected .json to stay JSON, got %v", format)
+	}
+	if format := formatFromName("jsonc"); format != FormatJSONC {
+		t.Errorf("Expected format name jsonc, got %v", format)
+	}
+	if FormatJSONC.String() != "JSONC" {
+		t.Errorf("Expected String() JSONC, got %s", FormatJSONC.String())
+	}
+
+	// Strict mode still reports duplicate keys after stripping comments
+	if _, err := ParseConfigWithStrictness([]byte("{\"a\": 1, // first\n\"a\": 2,}"), FormatJSONC, ParseStrict); err == nil {
+		t.Error("Expected duplicate key error in strict JSONC parsing")
+	}
+}
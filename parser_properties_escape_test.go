@@ -0,0 +1,85 @@
+// parser_properties_escape_test.go: Tests for Java Properties escapes
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"testing"
+)
+
+func TestPropertiesParserEscapes(t *testing.T) {
+	input := `# Java escapes
+message=Hello\nWorld
+columns = a\tb
+path=C:\\dir
+greeting=Caf\u00e9 \uD83D\uDE00
+colon:value
+spaced key value
+my\ key\=1 = escaped separators
+url = http://host:8080/path
+fruits = apple, \
+         banana, \
+         cherry
+trailing = backslash\\
+next = after
+`
+
+	result, err := parseProperties([]byte(input))
+	if err != nil {
+		t.Fatalf("Properties with escapes should parse successfully: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"message":  "Hello\nWorld",
+		"columns":  "a\tb",
+		"path":     `C:\dir`,
+		"greeting": "Café 😀",
+		"colon":    "value",
+		"spaced":   "key value",
+		"my key=1": "escaped separators",
+		"url":      "http://host:8080/path",
+		"fruits":   "apple, banana, cherry",
+		"trailing": `backslash\`,
+		"next":     "after",
+	}
+	for key, want := range expected {
+		if got := result[key]; got != want {
+			t.Errorf("Expected %q = %#v, got %#v", key, want, got)
+		}
+	}
+	if len(result) != len(expected) {
+		t.Errorf("Expected %d keys, got %d: %v", len(expected), len(result), result)
+	}
+
+	if _, err := parseProperties([]byte("bad=\\u12G4\n")); err == nil {
+		t.Error("Expected an error for a malformed \\u escape")
+	}
+	if _, err := parseProperties([]byte("short=\\u12\n")); err == nil {
+		t.Error("Expected an error for a truncated \\u escape")
+	}
+}
+
+func TestPropertiesWriterRoundTrip(t *testing.T) {
+	config := map[string]interface{}{
+		"message":  "Hello\nWorld",
+		"path":     `C:\dir`,
+		"my key=1": " padded",
+	}
+
+	data, err := serializeProperties(config, nil)
+	if err != nil {
+		t.Fatalf("Failed to serialize properties: %v", err)
+	}
+	parsed, err := parseProperties(data)
+	if err != nil {
+		t.Fatalf("Failed to parse serialized properties: %v\n%s", err, data)
+	}
+	for key, want := range config {
+		if got := parsed[key]; got != want {
+			t.Errorf("Expected %q to round-trip as %#v, got %#v", key, want, got)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// parser_registry.go: Parser priorities and unregistration
+//
+// Plugins that replace a built-in parser, or tests that swap parsers between
+// cases, need to decide which parser wins for a format and to remove one
+// again. Priorities order the registry; parsers registered with RegisterParser
+// have priority 0.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// prioritizedParser carries the priority of a parser registered with a
+// non-zero priority. It embeds the parser, so the registry keeps its
+// []ConfigParser type.
+type prioritizedParser struct {
+	ConfigParser
+	priority int
+}
+
+// parserPriority returns the priority a registry entry was registered with
+func parserPriority(parser ConfigParser) int {
+	if p, ok := parser.(prioritizedParser); ok {
+		return p.priority
+	}
+	return 0
+}
+
+// RegisterParserWithPriority registers a custom parser consulted before every
+// registered parser of lower priority. Parsers of equal priority keep their
+// registration order. RegisterParser is RegisterParserWithPriority with
+// priority 0; use a negative priority for a parser that should only handle
+// formats no other plugin claims.
+//
+// Example:
+//
+//	argus.RegisterParser(&GenericYAMLParser{})
+//	argus.RegisterParserWithPriority(&StrictYAMLParser{}, 10) // Wins for YAML
+func RegisterParserWithPriority(parser ConfigParser, priority int) {
+	parserMutex.Lock()
+	defer parserMutex.Unlock()
+
+	entry := parser
+	if priority != 0 {
+		entry = prioritizedParser{ConfigParser: parser, priority: priority}
+	}
+
+	// Insert after every parser of equal or higher priority. The registry is
+	// rebuilt rather than shifted in place: ParseConfig reads its length
+	// without locking.
+	pos := len(customParsers)
+	for i, existing := range customParsers {
+		if parserPriority(existing) < priority {
+			pos = i
+			break
+		}
+	}
+	parsers := make([]ConfigParser, 0, len(customParsers)+1)
+	parsers = append(parsers, customParsers[:pos]...)
+	parsers = append(parsers, entry)
+	customParsers = append(parsers, customParsers[pos:]...)
+}
+
+// UnregisterParser removes every registered parser whose Name is name and
+// reports whether any was removed. Formats they handled fall back to the next
+// registered parser or to the built-in parsers.
+//
+// Example:
+//
+//	argus.RegisterParser(&MockYAMLParser{})
+//	defer argus.UnregisterParser("mock-yaml")
+func UnregisterParser(name string) bool {
+	parserMutex.Lock()
+	defer parserMutex.Unlock()
+
+	var kept []ConfigParser
+	for _, parser := range customParsers {
+		if parser.Name() != name {
+			kept = append(kept, parser)
+		}
+	}
+	if len(kept) == len(customParsers) {
+		return false
+	}
+	customParsers = kept
+	return true
+}
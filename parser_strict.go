@@ -0,0 +1,369 @@
+// parser_strict.go: Strict parsing mode for the built-in parsers
+//
+// The built-in parsers are deliberately forgiving so that a running service
+// keeps reloading configuration that is slightly off. CI pipelines want the
+// opposite: reject anything questionable before it ships. ParseStrict runs a
+// format-specific syntax check before parsing, so the same file can pass in
+// production (lenient) while failing a strict CI gate.
+//
+// What strict mode rejects, per format:
+//   - JSON: duplicate keys in the same object
+//   - YAML: more than one document in the stream (duplicate keys are always rejected)
+//   - TOML: duplicate keys and tables, values that are not TOML literals
+//     (bare words, trailing garbage), unknown escapes in basic strings
+//   - HCL: duplicate attributes or blocks in the same scope, unbalanced braces
+//   - INI: duplicate keys within a section
+//   - Properties: duplicate keys, unknown escape sequences
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/agilira/go-errors"
+	"go.yaml.in/yaml/v3"
+)
+
+// ParseStrictness controls how tolerant parsing is of questionable input
+type ParseStrictness int
+
+const (
+	// ParseLenient parses on a best-effort basis (default).
+	// Duplicate keys resolve to the last value and malformed values are
+	// kept as strings, so a running service tolerates minor issues.
+	ParseLenient ParseStrictness = iota
+
+	// ParseStrict rejects duplicate keys, unknown escapes and trailing
+	// garbage before parsing. Intended for CI validation.
+	ParseStrict
+)
+
+// String returns the name of the strictness level
+func (s ParseStrictness) String() string {
+	switch s {
+	case ParseLenient:
+		return "lenient"
+	case ParseStrict:
+		return "strict"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseConfigWithStrictness parses configuration data like ParseConfig,
+// running the format's strict syntax check first when strictness is
+// ParseStrict. The check is applied to custom parsers too, since it only
+// looks at the raw syntax.
+//
+// Example:
+//
+//	// CI gate
+//	_, err := argus.ParseConfigWithStrictness(data, argus.FormatTOML, argus.ParseStrict)
+func ParseConfigWithStrictness(data []byte, format ConfigFormat, strictness ParseStrictness) (map[string]interface{}, error) {
+	if strictness == ParseStrict {
+		if err := checkStrictSyntax(data, format); err != nil {
+			return nil, err
+		}
+	}
+	return ParseConfig(data, format)
+}
+
+// checkStrictSyntax dispatches to the strict check of a format.
+// Syntax errors the parser itself reports are left to the parser.
+func checkStrictSyntax(data []byte, format ConfigFormat) error {
+	switch format {
+	case FormatJSON:
+		return checkStrictJSON(data)
+	case FormatJSONC:
+		return checkStrictJSON(stripJSONC(data))
+	case FormatYAML:
+		return checkStrictYAML(data)
+	case FormatTOML:
+		return checkStrictTOML(data)
+	case FormatHCL:
+		return checkStrictHCL(data)
+	case FormatINI:
+		return checkStrictINI(data)
+	case FormatProperties:
+		return checkStrictProperties(data)
+	default:
+		return nil
+	}
+}
+
+// strictError builds the error returned by strict checks
+func strictError(format ConfigFormat, lineNum int, reason string) error {
+	if lineNum > 0 {
+		return errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("invalid %s at line %d: %s (strict mode)", format, lineNum, reason))
+	}
+	return errors.New(ErrCodeInvalidConfig,
+		fmt.Sprintf("invalid %s: %s (strict mode)", format, reason))
+}
+
+// checkStrictJSON rejects duplicate keys in any object
+func checkStrictJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	err := checkJSONValue(dec, "")
+	if err != nil && !errors.HasCode(err, ErrCodeInvalidConfig) {
+		return nil // Malformed JSON: reported by the parser
+	}
+	return err
+}
+
+// checkJSONValue walks one JSON value, tracking keys of nested objects
+func checkJSONValue(dec *json.Decoder, path string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return nil
+	}
+
+	switch delim {
+	case '{':
+		seen := make(map[string]struct{})
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := keyTok.(string)
+			fullKey := key
+			if path != "" {
+				fullKey = path + "." + key
+			}
+			if _, dup := seen[key]; dup {
+				return strictError(FormatJSON, 0, fmt.Sprintf("duplicate key '%s'", fullKey))
+			}
+			seen[key] = struct{}{}
+			if err := checkJSONValue(dec, fullKey); err != nil {
+				return err
+			}
+		}
+	case '[':
+		for dec.More() {
+			if err := checkJSONValue(dec, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = dec.Token() // Closing delimiter
+	return err
+}
+
+// checkStrictYAML rejects streams with more than one document, which the
+// lenient parser silently truncates to the first
+func checkStrictYAML(data []byte) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil // Empty or malformed: handled by the parser
+	}
+	if err := dec.Decode(&doc); err != io.EOF {
+		return strictError(FormatYAML, 0, "multiple documents in stream")
+	}
+	return nil
+}
+
+// checkStrictTOML rejects duplicate keys and tables, non-literal values and
+// unknown escapes in basic strings
+func checkStrictTOML(data []byte) error {
+	tables := make(map[string]struct{})
+	keys := make(map[string]struct{})
+	table := ""
+
+	for i, line := range strings.Split(string(data), "\n") {
+		lineNum := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[[") && strings.HasSuffix(line, "]]") {
+			// Each [[element]] starts afresh: its keys and sub-tables may repeat
+			table = strings.TrimSpace(line[2 : len(line)-2])
+			forgetTOMLPrefix(tables, table+".")
+			forgetTOMLPrefix(keys, table+".")
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			table = strings.TrimSpace(line[1 : len(line)-1])
+			if _, dup := tables[table]; dup {
+				return strictError(FormatTOML, lineNum, fmt.Sprintf("duplicate table '%s'", table))
+			}
+			tables[table] = struct{}{}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue // Reported by the parser
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		fullKey := key
+		if table != "" {
+			fullKey = table + "." + key
+		}
+		if _, dup := keys[fullKey]; dup {
+			return strictError(FormatTOML, lineNum, fmt.Sprintf("duplicate key '%s'", fullKey))
+		}
+		keys[fullKey] = struct{}{}
+
+		if !isTOMLValue(value) {
+			return strictError(FormatTOML, lineNum, fmt.Sprintf("value of '%s' is not a TOML literal", key))
+		}
+		if strings.HasPrefix(value, "\"") {
+			if bad, ok := findUnknownEscape(value[1:len(value)-1], "btnfr\"\\uU"); ok {
+				return strictError(FormatTOML, lineNum, fmt.Sprintf("unknown escape '\\%c'", bad))
+			}
+		}
+	}
+	return nil
+}
+
+// forgetTOMLPrefix removes the names under prefix, scoped to the previous
+// element of an array of tables
+func forgetTOMLPrefix(names map[string]struct{}, prefix string) {
+	for name := range names {
+		if strings.HasPrefix(name, prefix) {
+			delete(names, name)
+		}
+	}
+}
+
+// checkStrictHCL rejects duplicate attributes or blocks within a scope and
+// unbalanced braces, which the lenient parser tolerates
+func checkStrictHCL(data []byte) error {
+	scopes := []map[string]struct{}{make(map[string]struct{})}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		lineNum := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		var name string
+		switch {
+		case strings.Contains(line, "{") && !strings.Contains(line, "="):
+			name = strings.TrimSpace(strings.Split(line, "{")[0])
+		case strings.Contains(line, "="):
+			name = strings.TrimSpace(strings.SplitN(line, "=", 2)[0])
+		}
+		if name != "" {
+			scope := scopes[len(scopes)-1]
+			if _, dup := scope[name]; dup {
+				return strictError(FormatHCL, lineNum, fmt.Sprintf("duplicate attribute or block '%s'", name))
+			}
+			scope[name] = struct{}{}
+		}
+
+		for _, c := range line {
+			switch c {
+			case '{':
+				scopes = append(scopes, make(map[string]struct{}))
+			case '}':
+				if len(scopes) == 1 {
+					return strictError(FormatHCL, lineNum, "unexpected closing brace")
+				}
+				scopes = scopes[:len(scopes)-1]
+			}
+		}
+	}
+
+	if len(scopes) > 1 {
+		return strictError(FormatHCL, 0, "unclosed block")
+	}
+	return nil
+}
+
+// checkStrictINI rejects duplicate keys within a section
+func checkStrictINI(data []byte) error {
+	keys := make(map[string]struct{})
+	section := ""
+
+	for _, logical := range iniLines(data) {
+		line := strings.TrimSpace(logical.text)
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.Trim(line, "[]") + "."
+			continue
+		}
+
+		key, _, ok := strings.Cut(line, "=")
+		if !ok {
+			continue // Reported by the parser
+		}
+		fullKey := section + strings.TrimSpace(key)
+		if _, dup := keys[fullKey]; dup {
+			return strictError(FormatINI, logical.num+1, fmt.Sprintf("duplicate key '%s'", fullKey))
+		}
+		keys[fullKey] = struct{}{}
+	}
+	return nil
+}
+
+// checkStrictProperties rejects duplicate keys and escape sequences that are
+// not defined by the Java properties format
+func checkStrictProperties(data []byte) error {
+	keys := make(map[string]struct{})
+
+	for _, logical := range propertiesLines(data) {
+		lineNum := logical.num + 1
+		line := strings.TrimSpace(logical.text)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		if bad, ok := findUnknownEscape(line, "tnrf\\u=: #!"); ok {
+			return strictError(FormatProperties, lineNum, fmt.Sprintf("unknown escape '\\%c'", bad))
+		}
+
+		rawKey, _, ok := splitPropertiesLine(line)
+		if !ok {
+			continue // Reported by the parser
+		}
+		key, err := unescapeProperties(rawKey, lineNum)
+		if err != nil {
+			continue // Reported by the parser
+		}
+		if _, dup := keys[key]; dup {
+			return strictError(FormatProperties, lineNum, fmt.Sprintf("duplicate key '%s'", key))
+		}
+		keys[key] = struct{}{}
+	}
+	return nil
+}
+
+// findUnknownEscape returns the first character following a backslash that
+// is not in allowed. A trailing lone backslash is reported as '\\'.
+func findUnknownEscape(s, allowed string) (rune, bool) {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			continue
+		}
+		if i+1 >= len(s) {
+			return '\\', true
+		}
+		if !strings.ContainsRune(allowed, rune(s[i+1])) {
+			return rune(s[i+1]), true
+		}
+		i++ // Skip the escaped character
+	}
+	return 0, false
+}
@@ -0,0 +1,100 @@
+// parser_strict_test.go: Testing strict vs lenient parsing
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseStrictness_LenientPassesStrictFails(t *testing.T) {
+	tests := []struct {
+		name   string
+		format ConfigFormat
+		data   string
+		reason string
+	}{
+		{"json duplicate key", FormatJSON, `{"port": 80, "port": 8080}`, "duplicate key 'port'"},
+		{"json nested duplicate", FormatJSON, `{"db": {"host": "a", "host": "b"}}`, "duplicate key 'db.host'"},
+		{"yaml multiple documents", FormatYAML, "port: 80\n---\nport: 8080\n", "multiple documents"},
+		{"toml duplicate key", FormatTOML, "port = 80\nport = 8080\n", "duplicate key 'port'"},
+		{"toml duplicate table", FormatTOML, "[db]\nhost = \"a\"\n[db]\nport = 1\n", "duplicate table 'db'"},
+		{"toml bare word", FormatTOML, "host = localhost\n", "not a TOML literal"},
+		{"toml trailing garbage", FormatTOML, "port = 8080 extra\n", "not a TOML literal"},
+		{"toml unknown escape", FormatTOML, "path = \"C:\\qdir\"\n", "unknown escape"},
+		{"hcl duplicate attribute", FormatHCL, "port = 80\nport = 8080\n", "duplicate attribute"},
+		{"hcl unclosed block", FormatHCL, "server {\n  port = 80\n", "unclosed block"},
+		{"ini duplicate key", FormatINI, "[db]\nhost=a\nhost=b\n", "duplicate key 'db.host'"},
+		{"properties duplicate key", FormatProperties, "db.host=a\ndb.host=b\n", "duplicate key 'db.host'"},
+		{"properties unknown escape", FormatProperties, "path=C:\\qdir\n", "unknown escape"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseConfigWithStrictness([]byte(tt.data), tt.format, ParseLenient); err != nil {
+				t.Fatalf("Expected lenient parsing to succeed, got: %v", err)
+			}
+
+			_, err := ParseConfigWithStrictness([]byte(tt.data), tt.format, ParseStrict)
+			if err == nil {
+				t.Fatal("Expected strict parsing to fail")
+			}
+			if !strings.Contains(err.Error(), tt.reason) {
+				t.Errorf("Expected error mentioning %q, got: %v", tt.reason, err)
+			}
+		})
+	}
+}
+
+func TestParseStrictness_CleanInputPassesStrict(t *testing.T) {
+	tests := []struct {
+		name   string
+		format ConfigFormat
+		data   string
+	}{
+		{"json", FormatJSON, `{"db": {"host": "a"}, "replicas": [{"host": "b"}, {"host": "c"}]}`},
+		{"yaml", FormatYAML, "---\ndb:\n  host: a\n"},
+		{"toml", FormatTOML, "title = \"app\\tv1\"\n[db]\nhost = \"a\"\nports = [80, 443]\n[cache]\nhost = \"b\"\n"},
+		{"hcl", FormatHCL, "port = 80\nserver {\n  port = 8080\n}\n"},
+		{"ini", FormatINI, "[db]\nhost=a\n[cache]\nhost=b\n"},
+		{"properties", FormatProperties, "path=C:\\\\dir\nmsg=line\\nbreak\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseConfigWithStrictness([]byte(tt.data), tt.format, ParseStrict); err != nil {
+				t.Errorf("Expected strict parsing to succeed, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestUniversalConfigWatcher_ParseStrict(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"port": 80, "port": 8080}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher, err := UniversalConfigWatcherWithConfig(configPath, func(map[string]interface{}) {},
+		Config{DisableAudit: true, ParseStrictness: ParseStrict})
+	if err == nil {
+		_ = watcher.Stop()
+		t.Fatal("Expected strict watcher to reject duplicate keys")
+	}
+	if !strings.Contains(err.Error(), ErrCodeInvalidConfig) {
+		t.Errorf("Expected %s, got: %v", ErrCodeInvalidConfig, err)
+	}
+}
+
+func TestConfig_InvalidParseStrictness(t *testing.T) {
+	config := (&Config{ParseStrictness: ParseStrictness(7)}).WithDefaults()
+	if err := config.Validate(); err != ErrInvalidParseStrictness {
+		t.Errorf("Expected ErrInvalidParseStrictness, got: %v", err)
+	}
+}
@@ -14,6 +14,7 @@ package argus
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"unicode"
 
@@ -45,6 +46,27 @@ func parseJSON(data []byte) (map[string]interface{}, error) {
 	return config, nil
 }
 
+// parseJSONReader decodes JSON straight from r with json.Decoder, so the raw
+// document is never held in memory alongside the decoded map. Like
+// json.Unmarshal it rejects anything but whitespace after the top-level value.
+func parseJSONReader(r io.Reader) (map[string]interface{}, error) {
+	dec := json.NewDecoder(r)
+	config := make(map[string]interface{})
+	if err := dec.Decode(&config); err != nil {
+		return nil, errors.Wrap(err, ErrCodeInvalidConfig, "invalid JSON")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New(ErrCodeInvalidConfig, "invalid JSON: unexpected data after top-level value")
+	}
+
+	for key := range config {
+		if err := validateJSONKey(key); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 // validateJSONKey validates JSON keys for security concerns while allowing JSON spec compliance.
 // JSON allows any Unicode character in keys, but we apply security policy restrictions.
 func validateJSONKey(key string) error {
@@ -81,6 +103,22 @@ func parseYAML(data []byte) (map[string]interface{}, error) {
 		return nil, errors.New(ErrCodeInvalidConfig,
 			fmt.Sprintf("invalid YAML: %v", err))
 	}
+	return normalizeYAMLRoot(raw)
+}
+
+// parseYAMLReader decodes the first YAML document straight from r with
+// yaml.Decoder. An empty stream yields an empty configuration, as in parseYAML.
+func parseYAMLReader(r io.Reader) (map[string]interface{}, error) {
+	var raw map[string]interface{}
+	if err := yaml.NewDecoder(r).Decode(&raw); err != nil && err != io.EOF {
+		return nil, errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("invalid YAML: %v", err))
+	}
+	return normalizeYAMLRoot(raw)
+}
+
+// normalizeYAMLRoot turns a decoded YAML root into the argus configuration map
+func normalizeYAMLRoot(raw map[string]interface{}) (map[string]interface{}, error) {
 	if raw == nil {
 		raw = make(map[string]interface{})
 	}
@@ -124,13 +162,14 @@ func normalizeYAMLTypes(v interface{}) interface{} {
 }
 
 // parseTOML parses TOML configuration with support for sections, nested tables, arrays, and basic types.
-// Covers 85% of real-world TOML usage: [sections], [nested.tables], arrays [1,2,3], and proper type inference.
+// Covers 85% of real-world TOML usage: [sections], [nested.tables], [[arrays.of.tables]],
+// arrays [1,2,3], and proper type inference.
 // Supports quoted strings, integers, floats, booleans, and basic arrays.
 func parseTOML(data []byte) (map[string]interface{}, error) {
 	config := getConfigMap()
 	lines := strings.Split(string(data), "\n")
 
-	var currentSection []string // Track current section path like ["app", "database"]
+	table := config // Table receiving key lines: root, [section] or last [[element]]
 
 	for lineNum, line := range lines {
 		originalLine := line
@@ -141,8 +180,14 @@ func parseTOML(data []byte) (map[string]interface{}, error) {
 			continue
 		}
 
-		// Handle section headers [section] or [nested.section]
+		// Handle section headers [section], [nested.section] or [[array.of.tables]]
 		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			// Array of tables: validate the inner [name] like a section
+			isArray := strings.HasPrefix(line, "[[") && strings.HasSuffix(line, "]]")
+			if isArray {
+				originalLine = line[1 : len(line)-1]
+			}
+
 			// Validate section header format
 			if err := validateTOMLSection(originalLine, lineNum+1); err != nil {
 				putConfigMap(config)
@@ -157,10 +202,8 @@ func parseTOML(data []byte) (map[string]interface{}, error) {
 						lineNum+1))
 			}
 
-			currentSection = strings.Split(sectionName, ".")
-
 			// Create nested structure for section
-			createNestedPath(config, currentSection)
+			table = tomlTable(config, strings.Split(sectionName, "."), isArray)
 			continue
 		}
 
@@ -189,14 +232,8 @@ func parseTOML(data []byte) (map[string]interface{}, error) {
 			return nil, err
 		}
 
-		// Set value in the correct section
-		if len(currentSection) == 0 {
-			// Root level
-			config[key] = parsedValue
-		} else {
-			// Inside a section
-			setNestedValue(config, append(currentSection, key), parsedValue)
-		}
+		// Set value in the current table (root level before any header)
+		table[key] = parsedValue
 	}
 
 	return config, nil
@@ -243,26 +280,41 @@ func parseTOMLArray(arrayStr string) interface{} {
 	return result
 }
 
-// createNestedPath ensures that nested path exists in the config map.
-// Creates intermediate maps as needed for paths like ["app", "database"].
-func createNestedPath(config map[string]interface{}, path []string) {
+// tomlTable returns the table at path, creating intermediate tables as
+// needed. A segment naming an array of tables resolves to its last element,
+// so [servers.tls] after [[servers]] extends the latest server. With
+// appendElement the final segment is an array of tables ([[path]]) and a new
+// element is appended and returned.
+func tomlTable(config map[string]interface{}, path []string, appendElement bool) map[string]interface{} {
 	current := config
-
-	for _, segment := range path {
-		if _, exists := current[segment]; !exists {
-			current[segment] = make(map[string]interface{})
+	last := len(path) - 1
+	for i, segment := range path {
+		if i == last && appendElement {
+			list, _ := current[segment].([]interface{})
+			element := make(map[string]interface{})
+			current[segment] = append(list, element)
+			return element
 		}
 
-		// Move deeper into the structure
-		if nested, ok := current[segment].(map[string]interface{}); ok {
-			current = nested
-		} else {
-			// If the path conflicts with existing data, create new map
-			newMap := make(map[string]interface{})
-			current[segment] = newMap
-			current = newMap
+		switch node := current[segment].(type) {
+		case map[string]interface{}:
+			current = node
+			continue
+		case []interface{}:
+			if len(node) > 0 {
+				if element, ok := node[len(node)-1].(map[string]interface{}); ok {
+					current = element
+					continue
+				}
+			}
 		}
+
+		// Missing or path conflict - create a table
+		newMap := make(map[string]interface{})
+		current[segment] = newMap
+		current = newMap
 	}
+	return current
 }
 
 // setNestedValue sets a value at the specified nested path in the config map.
@@ -12,9 +12,11 @@
 package argus
 
 import (
-	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf16"
 
 	"github.com/agilira/go-errors"
 )
@@ -193,14 +195,16 @@ func parseHCLArray(arrayStr string) interface{} {
 // Handles traditional INI format with [section] headers and key=value pairs.
 // Section names are prefixed to keys with dot notation (e.g., "database.host").
 // Supports both ; and # comment styles. Empty sections are handled gracefully.
+// Lines ending with a backslash continue on the next line, and double-quoted
+// values are taken verbatim as strings, so `dsn = "host=db;port=5432"` keeps
+// its inner '=' and ';'.
 func parseINI(data []byte) (map[string]interface{}, error) {
 	config := make(map[string]interface{})
-	lines := strings.Split(string(data), "\n")
 	currentSection := ""
 
-	for lineNum, line := range lines {
-		originalLine := line
-		line = strings.TrimSpace(line)
+	for _, logical := range iniLines(data) {
+		lineNum, originalLine := logical.num, logical.text
+		line := strings.TrimSpace(originalLine)
 
 		// Skip empty lines and comments
 		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
@@ -246,25 +250,59 @@ func parseINI(data []byte) (map[string]interface{}, error) {
 			key = currentSection + key
 		}
 
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			config[key] = value[1 : len(value)-1] // Quoted: no type inference
+			continue
+		}
 		config[key] = parseValue(value)
 	}
 
 	return config, nil
 }
 
+// sourceLine is a logical line of a line-based format and the 0-based index
+// of the physical line it starts on
+type sourceLine struct {
+	text string
+	num  int
+}
+
+// iniLines splits INI content into logical lines, joining a line that ends
+// with a backslash to the next one (leading whitespace of the continuation
+// is dropped). Comment lines never continue.
+func iniLines(data []byte) []sourceLine {
+	lines := strings.Split(string(data), "\n")
+	result := make([]sourceLine, 0, len(lines))
+
+	for i := 0; i < len(lines); i++ {
+		start, text := i, lines[i]
+		trimmed := strings.TrimSpace(text)
+		if strings.HasPrefix(trimmed, ";") || strings.HasPrefix(trimmed, "#") {
+			result = append(result, sourceLine{text: text, num: start})
+			continue
+		}
+		for strings.HasSuffix(strings.TrimRight(text, " \t\r"), "\\") && i+1 < len(lines) {
+			text = strings.TrimSuffix(strings.TrimRight(text, " \t\r"), "\\")
+			i++
+			text += strings.TrimLeft(lines[i], " \t")
+		}
+		result = append(result, sourceLine{text: text, num: start})
+	}
+	return result
+}
+
 // parseProperties parses Java-style properties files with line-based processing.
-// Supports key=value format with # and ! comment styles (Java standard).
-// Uses bufio.Scanner for efficient line processing of large property files.
-// Handles whitespace trimming and empty line skipping automatically.
+// Supports key=value, key:value and "key value" pairs with # and ! comment
+// styles (Java standard). Lines ending with a backslash continue on the next
+// line, and keys and values are unescaped like java.util.Properties:
+// \t, \n, \r, \f, \\, \uXXXX, and any other escaped character stands for
+// itself, so `my\ key\=1 = a` has the key "my key=1".
 func parseProperties(data []byte) (map[string]interface{}, error) {
 	config := make(map[string]interface{})
-	scanner := bufio.NewScanner(strings.NewReader(string(data)))
-	lineNum := 0
 
-	for scanner.Scan() {
-		lineNum++
-		originalLine := scanner.Text()
-		line := strings.TrimSpace(originalLine)
+	for _, logical := range propertiesLines(data) {
+		lineNum := logical.num + 1
+		line := strings.TrimSpace(logical.text)
 
 		// Skip empty lines and comments
 		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
@@ -272,28 +310,22 @@ func parseProperties(data []byte) (map[string]interface{}, error) {
 		}
 
 		// Handle key=value pairs with validation (Java Properties supports =, :, and space separators)
-		var key, value string
-		var found bool
-
-		// Try different separators in order of preference: =, :, space
-		for _, sep := range []string{"=", ":", " "} {
-			if strings.Contains(line, sep) {
-				parts := strings.SplitN(line, sep, 2)
-				if len(parts) == 2 {
-					key = strings.TrimSpace(parts[0])
-					value = strings.TrimSpace(parts[1])
-					found = true
-					break
-				}
-			}
-		}
-
+		rawKey, rawValue, found := splitPropertiesLine(line)
 		if !found {
 			return nil, errors.New(ErrCodeInvalidConfig,
 				fmt.Sprintf("invalid Properties syntax at line %d: missing key-value separator (=, :, or space)",
 					lineNum))
 		}
 
+		key, err := unescapeProperties(rawKey, lineNum)
+		if err != nil {
+			return nil, err
+		}
+		value, err := unescapeProperties(rawValue, lineNum)
+		if err != nil {
+			return nil, err
+		}
+
 		// Validate key format
 		if err := validatePropertiesKey(key, lineNum); err != nil {
 			return nil, err
@@ -302,11 +334,123 @@ func parseProperties(data []byte) (map[string]interface{}, error) {
 		config[key] = parseValue(value)
 	}
 
-	// Check for scanner errors
-	if err := scanner.Err(); err != nil {
-		return nil, errors.New(ErrCodeInvalidConfig,
-			fmt.Sprintf("error reading Properties file: %v", err))
+	return config, nil
+}
+
+// splitPropertiesLine splits a trimmed properties line at the first
+// unescaped '=', ':' or whitespace. Whitespace around the separator is
+// skipped, so "key = value", "key: value" and "key value" are equivalent.
+// Key and value are returned still escaped.
+func splitPropertiesLine(line string) (key, value string, found bool) {
+	end := -1
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if c == '\\' {
+			i++ // Escaped character belongs to the key
+			continue
+		}
+		if c == '=' || c == ':' || c == ' ' || c == '\t' || c == '\f' {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return "", "", false
+	}
+
+	key = line[:end]
+	rest := strings.TrimLeft(line[end:], " \t\f")
+	if line[end] == '=' || line[end] == ':' {
+		rest = strings.TrimLeft(line[end+1:], " \t\f")
+	} else if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
 	}
+	return key, rest, true
+}
 
-	return config, nil
+// unescapeProperties decodes the Java properties escapes in s
+func unescapeProperties(s string, lineNum int) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil // Fast path: nothing to decode
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", errors.New(ErrCodeInvalidConfig,
+					fmt.Sprintf("invalid Properties escape at line %d: malformed \\uXXXX encoding", lineNum))
+			}
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+			if err != nil {
+				return "", errors.New(ErrCodeInvalidConfig,
+					fmt.Sprintf("invalid Properties escape at line %d: malformed \\uXXXX encoding", lineNum))
+			}
+			i += 4
+
+			// Characters outside the BMP are written as UTF-16 surrogate pairs
+			if utf16.IsSurrogate(rune(r)) && i+6 < len(s) && s[i+1] == '\\' && s[i+2] == 'u' {
+				if low, err := strconv.ParseUint(s[i+3:i+7], 16, 32); err == nil {
+					if pair := utf16.DecodeRune(rune(r), rune(low)); pair != unicode.ReplacementChar {
+						sb.WriteRune(pair)
+						i += 6
+						continue
+					}
+				}
+			}
+			sb.WriteRune(rune(r))
+		default:
+			sb.WriteByte(s[i]) // Any other escaped character stands for itself
+		}
+	}
+	return sb.String(), nil
+}
+
+// propertiesLines splits properties content into logical lines. A line
+// ending with an odd number of backslashes continues on the next line, whose
+// leading whitespace is dropped. Comment lines never continue.
+func propertiesLines(data []byte) []sourceLine {
+	lines := strings.Split(string(data), "\n")
+	result := make([]sourceLine, 0, len(lines))
+
+	for i := 0; i < len(lines); i++ {
+		start, text := i, strings.TrimSuffix(lines[i], "\r")
+		trimmed := strings.TrimLeft(text, " \t\f")
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "!") {
+			result = append(result, sourceLine{text: text, num: start})
+			continue
+		}
+		for endsWithContinuation(text) && i+1 < len(lines) {
+			i++
+			text = text[:len(text)-1] + strings.TrimLeft(strings.TrimSuffix(lines[i], "\r"), " \t\f")
+		}
+		result = append(result, sourceLine{text: text, num: start})
+	}
+	return result
+}
+
+// endsWithContinuation reports whether line ends with an odd number of
+// backslashes, i.e. an unescaped line continuation
+func endsWithContinuation(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
 }
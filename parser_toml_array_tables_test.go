@@ -0,0 +1,88 @@
+// parser_toml_array_tables_test.go: Tests for TOML arrays of tables
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"testing"
+)
+
+func TestTOMLParserArrayOfTables(t *testing.T) {
+	input := `
+title = "infra"
+
+[[servers]]
+name = "alpha"
+port = 8080
+
+[servers.tls]
+enabled = true
+
+[[servers]]
+name = "beta"
+port = 8081
+
+[[servers.routes]]
+path = "/api"
+
+[[servers.routes]]
+path = "/health"
+
+[database]
+host = "db.local"
+`
+
+	result, err := parseTOML([]byte(input))
+	if err != nil {
+		t.Fatalf("Array of tables should parse successfully: %v", err)
+	}
+
+	servers, ok := result["servers"].([]interface{})
+	if !ok || len(servers) != 2 {
+		t.Fatalf("Expected 'servers' to be a list of 2 tables, got %T: %v", result["servers"], result["servers"])
+	}
+
+	alpha, _ := servers[0].(map[string]interface{})
+	if alpha["name"] != "alpha" || alpha["port"] != 8080 {
+		t.Errorf("Expected first server alpha:8080, got %v", alpha)
+	}
+	if tls, _ := alpha["tls"].(map[string]interface{}); tls["enabled"] != true {
+		t.Errorf("Expected [servers.tls] to extend the first server, got %v", alpha)
+	}
+
+	beta, _ := servers[1].(map[string]interface{})
+	if beta["name"] != "beta" || beta["tls"] != nil {
+		t.Errorf("Expected second server beta without tls, got %v", beta)
+	}
+	routes, _ := beta["routes"].([]interface{})
+	if len(routes) != 2 {
+		t.Fatalf("Expected nested array of 2 routes on the last server, got %v", beta["routes"])
+	}
+	if route, _ := routes[1].(map[string]interface{}); route["path"] != "/health" {
+		t.Errorf("Expected second route /health, got %v", routes[1])
+	}
+
+	if db, _ := result["database"].(map[string]interface{}); db["host"] != "db.local" {
+		t.Errorf("Expected [database] after arrays of tables, got %v", result["database"])
+	}
+	if result["title"] != "infra" {
+		t.Errorf("Expected root key before tables, got %v", result["title"])
+	}
+}
+
+func TestTOMLArrayOfTables_StrictAndDetection(t *testing.T) {
+	input := []byte("[[servers]]\nname = \"alpha\"\n[servers.tls]\nenabled = true\n\n[[servers]]\nname = \"beta\"\n[servers.tls]\nenabled = false\n")
+
+	if _, err := ParseConfigWithStrictness(input, FormatTOML, ParseStrict); err != nil {
+		t.Errorf("Expected repeated elements to pass strict parsing, got %v", err)
+	}
+	if _, err := ParseConfigWithStrictness([]byte("[[servers]]\nname = \"a\"\nname = \"b\"\n"), FormatTOML, ParseStrict); err == nil {
+		t.Error("Expected a duplicate key within one element to fail strict parsing")
+	}
+	if format := DetectFormatFromContent(input); format != FormatTOML {
+		t.Errorf("Expected content detection to recognize TOML, got %v", format)
+	}
+}
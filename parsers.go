@@ -23,9 +23,12 @@
 package argus
 
 import (
+	"bytes"
+	"encoding/json"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/agilira/go-errors"
 )
@@ -41,6 +44,7 @@ const (
 	FormatHCL
 	FormatINI
 	FormatProperties
+	FormatJSONC // JSON with comments and trailing commas (.jsonc)
 	FormatUnknown
 )
 
@@ -87,6 +91,7 @@ var (
 // RegisterParser registers a custom parser for production use cases.
 // Custom parsers are tried before built-in parsers, allowing for full
 // specification compliance or advanced features not available in built-in parsers.
+// The parser has priority 0, see RegisterParserWithPriority.
 //
 // Example:
 //
@@ -96,9 +101,46 @@ var (
 //
 //	import _ "github.com/your-org/argus-yaml-pro"
 func RegisterParser(parser ConfigParser) {
-	parserMutex.Lock()
-	defer parserMutex.Unlock()
-	customParsers = append(customParsers, parser)
+	RegisterParserWithPriority(parser, 0)
+}
+
+// ParserInfo describes a registered custom parser
+type ParserInfo struct {
+	// Name is the value of the parser's Name method
+	Name string
+
+	// Formats lists the formats the parser Supports
+	Formats []ConfigFormat
+
+	// Priority is the priority the parser was registered with
+	Priority int
+}
+
+// ListParsers returns the registered custom parsers in priority order, ties
+// in registration order: for a given format the first parser listed that
+// supports it is used. Formats not claimed by any listed parser are
+// handled by the built-in parsers.
+//
+// Example:
+//
+//	for _, p := range argus.ListParsers() {
+//	    log.Printf("parser %s handles %v", p.Name, p.Formats)
+//	}
+func ListParsers() []ParserInfo {
+	parserMutex.RLock()
+	defer parserMutex.RUnlock()
+
+	infos := make([]ParserInfo, 0, len(customParsers))
+	for _, parser := range customParsers {
+		info := ParserInfo{Name: parser.Name(), Priority: parserPriority(parser)}
+		for format := FormatJSON; format < FormatUnknown; format++ {
+			if parser.Supports(format) {
+				info.Formats = append(info.Formats, format)
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos
 }
 
 // configMapPool is a sync.Pool for reusing map[string]interface{} to reduce allocations
@@ -165,6 +207,8 @@ func (cf ConfigFormat) String() string {
 		return "INI"
 	case FormatProperties:
 		return "Properties"
+	case FormatJSONC:
+		return "JSONC"
 	default:
 		return "Unknown"
 	}
@@ -242,6 +286,17 @@ func DetectFormat(filePath string) ConfigFormat {
 		return FormatINI
 	}
 
+	// Check last 6 chars for .jsonc
+	if length >= 6 &&
+		filePath[length-6] == '.' &&
+		(filePath[length-5]|32) == 'j' &&
+		(filePath[length-4]|32) == 's' &&
+		(filePath[length-3]|32) == 'o' &&
+		(filePath[length-2]|32) == 'n' &&
+		(filePath[length-1]|32) == 'c' {
+		return FormatJSONC
+	}
+
 	// Check last 5 chars for common extensions: .json, .yaml, .toml, .conf
 	if length >= 5 && filePath[length-5] == '.' {
 		b1, b2, b3, b4 := filePath[length-4]|32, filePath[length-3]|32, filePath[length-2]|32, filePath[length-1]|32
@@ -285,8 +340,183 @@ func DetectFormat(filePath string) ConfigFormat {
 	return FormatUnknown
 }
 
+// formatFromName maps a format name ("json", "jsonc", "yaml", "yml", "toml",
+// "hcl", "tf", "ini", "conf", "cfg", "properties") to a ConfigFormat, case-insensitively
+func formatFromName(name string) ConfigFormat {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "json":
+		return FormatJSON
+	case "jsonc":
+		return FormatJSONC
+	case "yaml", "yml":
+		return FormatYAML
+	case "toml":
+		return FormatTOML
+	case "hcl", "tf":
+		return FormatHCL
+	case "ini", "conf", "cfg", "config":
+		return FormatINI
+	case "properties":
+		return FormatProperties
+	default:
+		return FormatUnknown
+	}
+}
+
+// DetectFormatFromContent guesses the configuration format from the payload itself.
+// Used when neither a file extension nor a content type is available, such as
+// configuration piped through stdin or remote payloads without a Content-Type.
+// The heuristic is intentionally conservative:
+//   - a leading '{' with valid JSON is JSON
+//   - content whose every line is strict TOML syntax, and which parses, is TOML
+//   - a first significant line containing ':' is YAML
+//
+// Top-level JSON arrays, INI files and .properties payloads are not valid
+// configurations for any sniffed format and yield FormatUnknown.
+func DetectFormatFromContent(data []byte) ConfigFormat {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return FormatUnknown
+	}
+
+	if trimmed[0] == '{' && json.Valid(trimmed) {
+		return FormatJSON
+	}
+	if trimmed[0] == '[' && json.Valid(trimmed) {
+		return FormatUnknown // JSON array, not a configuration map
+	}
+
+	for _, line := range strings.Split(string(trimmed), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || line == "---" {
+			continue
+		}
+
+		eq := strings.IndexByte(line, '=')
+		colon := strings.IndexByte(line, ':')
+		switch {
+		case line[0] == '[' || (eq >= 0 && (colon < 0 || eq < colon)):
+			if isStrictTOML(trimmed) {
+				return FormatTOML
+			}
+			return FormatUnknown
+		case colon >= 0:
+			return FormatYAML
+		default:
+			return FormatUnknown
+		}
+	}
+
+	return FormatUnknown
+}
+
+// isStrictTOML reports whether every significant line is a well-formed TOML
+// table header or key/value pair and the built-in parser accepts the content.
+// The built-in parser is lenient (unquoted strings are accepted), so the line
+// check is what tells TOML apart from INI and .properties payloads.
+func isStrictTOML(data []byte) bool {
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if line[0] == '[' {
+			if strings.HasPrefix(line, "[[") && strings.HasSuffix(line, "]]") {
+				line = line[1 : len(line)-1] // Array of tables: check [[name]] as [name]
+			}
+			header := strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+			if len(header) != len(line)-2 || !isTOMLKey(header) {
+				return false
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || !isTOMLKey(strings.TrimSpace(key)) || !isTOMLValue(strings.TrimSpace(value)) {
+			return false
+		}
+	}
+
+	config, err := parseTOML(data)
+	if err != nil {
+		return false
+	}
+	putConfigMap(config)
+	return true
+}
+
+// isTOMLKey reports whether key is a bare, quoted or dotted TOML key
+func isTOMLKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, part := range strings.Split(key, ".") {
+		part = strings.TrimSpace(part)
+		if len(part) >= 2 && (part[0] == '"' || part[0] == '\'') && part[len(part)-1] == part[0] {
+			continue
+		}
+		if part == "" {
+			return false
+		}
+		for _, c := range part {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isTOMLValue reports whether value is a TOML literal: string, boolean,
+// number, date, array or inline table. Bare words are rejected.
+func isTOMLValue(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	switch value[0] {
+	case '"', '\'':
+		return len(value) >= 2 && value[len(value)-1] == value[0]
+	case '[':
+		if value[len(value)-1] != ']' {
+			return false
+		}
+		inner := strings.TrimSpace(value[1 : len(value)-1])
+		if inner == "" || strings.ContainsAny(inner, "[{") {
+			return true // Nested structures are left to the parser
+		}
+		for _, item := range strings.Split(strings.TrimSuffix(inner, ","), ",") {
+			if !isTOMLValue(strings.TrimSpace(item)) {
+				return false
+			}
+		}
+		return true
+	case '{':
+		return value[len(value)-1] == '}'
+	}
+
+	switch value {
+	case "true", "false", "inf", "+inf", "-inf", "nan", "+nan", "-nan":
+		return true
+	}
+
+	if len(value) >= 10 && value[4] == '-' && value[7] == '-' {
+		_, err := time.Parse("2006-01-02", value[:10])
+		return err == nil
+	}
+
+	number := strings.ReplaceAll(value, "_", "")
+	if _, err := strconv.ParseInt(number, 0, 64); err == nil {
+		return true
+	}
+	_, err := strconv.ParseFloat(number, 64)
+	return err == nil
+}
+
 // ParseConfig parses configuration data based on the detected format.
 // Tries custom parsers first, then falls back to built-in parsers.
+// With SetParserFallback(true), a failing parser is retried with the next one.
 // HYPER-OPTIMIZED: Fast path for no custom parsers, reduced lock contention.
 //
 // Parameters:
@@ -298,12 +528,18 @@ func DetectFormat(filePath string) ConfigFormat {
 //   - error: Any parsing errors
 func ParseConfig(data []byte, format ConfigFormat) (map[string]interface{}, error) {
 	// Fast path: Check if we have any custom parsers without locking
-	// This is safe because customParsers is only appended to, never modified
+	// This is safe because customParsers is only ever replaced, never modified in place
 	if len(customParsers) == 0 {
 		// No custom parsers, go straight to built-in
 		return parseBuiltin(data, format)
 	}
 
+	// Opt-in fallback walks every supporting parser, see SetParserFallback
+	if parserFallback.Load() {
+		config, _, err := ParseConfigWithInfo(data, format)
+		return config, err
+	}
+
 	// Slow path: Check custom parsers with minimal lock time
 	parserMutex.RLock()
 	for _, parser := range customParsers {
@@ -319,6 +555,25 @@ func ParseConfig(data []byte, format ConfigFormat) (map[string]interface{}, erro
 	return parseBuiltin(data, format)
 }
 
+// hasCustomParser reports whether ParseConfig would hand format to a
+// registered custom parser instead of the built-in one
+func hasCustomParser(format ConfigFormat) bool {
+	if len(customParsers) == 0 {
+		return false
+	}
+	if parserFallback.Load() {
+		return true
+	}
+	parserMutex.RLock()
+	defer parserMutex.RUnlock()
+	for _, parser := range customParsers {
+		if parser.Supports(format) {
+			return true
+		}
+	}
+	return false
+}
+
 // parseBuiltin handles built-in parsing without any locks for maximum performance.
 // Used as fallback when no custom parsers are available or applicable.
 func parseBuiltin(data []byte, format ConfigFormat) (map[string]interface{}, error) {
@@ -335,6 +590,8 @@ func parseBuiltin(data []byte, format ConfigFormat) (map[string]interface{}, err
 		return parseINI(data)
 	case FormatProperties:
 		return parseProperties(data)
+	case FormatJSONC:
+		return parseJSONC(data)
 	default:
 		return nil, errors.New(ErrCodeInvalidConfig, "unsupported format: "+format.String())
 	}
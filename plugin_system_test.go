@@ -198,3 +198,109 @@ func TestParserPluginConcurrency(t *testing.T) {
 		t.Errorf("Expected at least 1 parser, got %d", len(parsers))
 	}
 }
+
+func TestListParsers(t *testing.T) {
+	parserMutex.Lock()
+	originalParsers := customParsers
+	customParsers = nil
+	parserMutex.Unlock()
+	defer func() {
+		parserMutex.Lock()
+		customParsers = originalParsers
+		parserMutex.Unlock()
+	}()
+
+	if parsers := ListParsers(); len(parsers) != 0 {
+		t.Errorf("Expected no registered parsers, got %v", parsers)
+	}
+
+	RegisterParser(&testAdvancedYAMLParser{})
+	RegisterParser(&testStrictYAMLParser{})
+
+	parsers := ListParsers()
+	if len(parsers) != 2 {
+		t.Fatalf("Expected 2 registered parsers, got %v", parsers)
+	}
+	if parsers[0].Name != "Test YAML Parser" || parsers[1].Name != "Strict YAML Parser" {
+		t.Errorf("Expected parsers in registration order, got %v", parsers)
+	}
+	for _, info := range parsers {
+		if len(info.Formats) != 1 || info.Formats[0] != FormatYAML {
+			t.Errorf("Expected %s to support only YAML, got %v", info.Name, info.Formats)
+		}
+	}
+}
+
+// testNamedParser is a YAML parser with a configurable name that reports it
+// in the parsed result
+type testNamedParser struct{ name string }
+
+func (p *testNamedParser) Parse(data []byte) (map[string]interface{}, error) {
+	return map[string]interface{}{"_parser": p.name}, nil
+}
+
+func (p *testNamedParser) Supports(format ConfigFormat) bool {
+	return format == FormatYAML
+}
+
+func (p *testNamedParser) Name() string {
+	return p.name
+}
+
+func TestParserPriorityAndUnregister(t *testing.T) {
+	parserMutex.Lock()
+	originalParsers := customParsers
+	customParsers = nil
+	parserMutex.Unlock()
+	defer func() {
+		parserMutex.Lock()
+		customParsers = originalParsers
+		parserMutex.Unlock()
+	}()
+
+	RegisterParser(&testNamedParser{name: "first"})
+	RegisterParserWithPriority(&testNamedParser{name: "fallback"}, -5)
+	RegisterParser(&testNamedParser{name: "second"})
+	RegisterParserWithPriority(&testNamedParser{name: "high"}, 10)
+
+	var names []string
+	for _, info := range ListParsers() {
+		names = append(names, info.Name)
+	}
+	want := []string{"high", "first", "second", "fallback"}
+	if len(names) != len(want) {
+		t.Fatalf("Expected parsers %v, got %v", want, names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("Expected parsers %v, got %v", want, names)
+		}
+	}
+	if info := ListParsers()[0]; info.Priority != 10 || len(info.Formats) != 1 {
+		t.Errorf("Expected the high priority parser to report priority 10 and YAML, got %+v", info)
+	}
+
+	config, err := ParseConfig([]byte("a: 1"), FormatYAML)
+	if err != nil || config["_parser"] != "high" {
+		t.Errorf("Expected the highest priority parser to win, got %v, %v", config, err)
+	}
+
+	if !UnregisterParser("high") {
+		t.Error("Expected UnregisterParser to report a removed parser")
+	}
+	if UnregisterParser("high") {
+		t.Error("Expected a second UnregisterParser to report nothing removed")
+	}
+	config, _ = ParseConfig([]byte("a: 1"), FormatYAML)
+	if config["_parser"] != "first" {
+		t.Errorf("Expected the next parser in insertion order to win, got %v", config)
+	}
+
+	for _, name := range []string{"first", "second", "fallback"} {
+		UnregisterParser(name)
+	}
+	config, err = ParseConfig([]byte("a: 1"), FormatYAML)
+	if err != nil || config["a"] != 1 {
+		t.Errorf("Expected the built-in parser after unregistering all, got %v, %v", config, err)
+	}
+}
@@ -28,6 +28,7 @@ package argus
 
 import (
 	"context"
+	"crypto/tls"
 	goerrors "errors"
 	"fmt"
 	"net/url"
@@ -78,12 +79,31 @@ type RemoteConfigOptions struct {
 	// Timeout for remote operations
 	Timeout time.Duration
 
+	// RequestTimeout bounds each attempt separately, so one hung request
+	// leaves time for retries within Timeout. 0 = bounded by Timeout only
+	RequestTimeout time.Duration
+
 	// RetryAttempts for failed requests
 	RetryAttempts int
 
-	// RetryDelay between retry attempts
+	// RetryDelay is the wait before the first retry, grown by Multiplier
+	// for later retries
 	RetryDelay time.Duration
 
+	// InitialBackoff overrides RetryDelay as the wait before the first retry.
+	// 0 = RetryDelay
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the wait between retries. 0 = uncapped
+	MaxBackoff time.Duration
+
+	// Multiplier grows the wait after each retry. 1 or less = constant wait
+	Multiplier float64
+
+	// Jitter draws each wait uniformly from [0, backoff], so instances that
+	// fail together do not retry together
+	Jitter bool
+
 	// Watch enables automatic configuration reloading
 	Watch bool
 
@@ -98,6 +118,41 @@ type RemoteConfigOptions struct {
 
 	// Authentication credentials (provider-specific)
 	Auth map[string]interface{}
+
+	// BasicAuth sends HTTP basic authentication credentials
+	BasicAuth *BasicAuth
+
+	// BearerToken sends an "Authorization: Bearer" header
+	BearerToken string
+
+	// TLSClientConfig configures TLS for HTTP-based providers, e.g. client
+	// certificates for mutual TLS or a private root CA
+	TLSClientConfig *tls.Config
+
+	// Format forces the payload format for providers implementing
+	// RemoteConfigRawLoader that cannot signal their content type
+	// ("json", "yaml", "toml", "hcl", "ini", "properties").
+	// Empty means negotiate: Content-Type, then URL extension, then content sniffing.
+	Format string
+}
+
+// RemoteConfigRawLoader is an optional capability for providers that fetch the
+// raw configuration payload instead of a decoded map. When a provider implements
+// it, Argus negotiates the payload format and decodes the bytes through ParseConfig,
+// so a provider serving YAML is never misparsed as JSON.
+//
+// Capability detection uses a type assertion, so existing providers keep working
+// unchanged:
+//
+//	func (p *HTTPProvider) LoadRaw(ctx context.Context, configURL string) ([]byte, string, error) {
+//	    resp, err := p.client.Get(configURL)
+//	    ...
+//	    return body, resp.Header.Get("Content-Type"), nil
+//	}
+type RemoteConfigRawLoader interface {
+	// LoadRaw returns the raw payload and its MIME content type.
+	// The content type may be empty when the source cannot report one.
+	LoadRaw(ctx context.Context, configURL string) (data []byte, contentType string, err error)
 }
 
 // DefaultRemoteConfigOptions provides sensible defaults for remote configuration.
@@ -107,6 +162,9 @@ func DefaultRemoteConfigOptions() *RemoteConfigOptions {
 		Timeout:       30 * time.Second,
 		RetryAttempts: 3,
 		RetryDelay:    1 * time.Second,
+		MaxBackoff:    30 * time.Second,
+		Multiplier:    2,
+		Jitter:        true,
 		Watch:         false,
 		WatchInterval: 30 * time.Second,
 		Headers:       make(map[string]string),
@@ -269,12 +327,12 @@ func loadWithRetries(ctx context.Context, provider RemoteConfigProvider, configU
 
 	for attempt := 0; attempt <= options.RetryAttempts; attempt++ {
 		if attempt > 0 {
-			if err := waitForRetry(ctxWithTimeout, options.RetryDelay); err != nil {
+			if err := waitForRetry(ctxWithTimeout, options.retryBackoff(attempt)); err != nil {
 				return nil, err
 			}
 		}
 
-		config, lastErr = provider.Load(ctxWithTimeout, configURL)
+		config, lastErr = loadAttempt(ctxWithTimeout, provider, configURL, options)
 		if lastErr == nil {
 			break
 		}
@@ -295,6 +353,107 @@ func loadWithRetries(ctx context.Context, provider RemoteConfigProvider, configU
 	return config, nil
 }
 
+// loadAttempt performs one load with the options attached to the context
+// and bounded by RequestTimeout
+func loadAttempt(ctx context.Context, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions) (map[string]interface{}, error) {
+	ctx = withRemoteOptions(ctx, options)
+	if options != nil && options.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.RequestTimeout)
+		defer cancel()
+	}
+	return loadFromProvider(ctx, provider, configURL, options)
+}
+
+// loadFromProvider loads a configuration map, negotiating the payload format
+// for providers that expose raw bytes via RemoteConfigRawLoader
+func loadFromProvider(ctx context.Context, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions) (map[string]interface{}, error) {
+	rawLoader, ok := provider.(RemoteConfigRawLoader)
+	if !ok {
+		return provider.Load(ctx, configURL)
+	}
+
+	data, contentType, err := rawLoader.LoadRaw(ctx, configURL)
+	if err != nil {
+		return nil, err
+	}
+
+	override := ""
+	if options != nil {
+		override = options.Format
+	}
+
+	format := negotiateRemoteFormat(configURL, contentType, data, override)
+	if format == FormatUnknown {
+		return nil, errors.New(ErrCodeRemoteConfigError, "unable to determine remote configuration format").
+			WithContext("url", configURL).
+			WithContext("content_type", contentType).
+			WithContext("format", override)
+	}
+
+	config, err := ParseConfig(data, format)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeRemoteConfigError, "failed to parse "+format.String()+" remote configuration").
+			WithContext("url", configURL)
+	}
+	return config, nil
+}
+
+// negotiateRemoteFormat selects the payload format in order of authority:
+// explicit override, Content-Type header, URL path extension, content sniffing
+func negotiateRemoteFormat(configURL, contentType string, data []byte, override string) ConfigFormat {
+	if override != "" {
+		return formatFromName(override)
+	}
+
+	if format := formatFromContentType(contentType); format != FormatUnknown {
+		return format
+	}
+
+	if parsedURL, err := url.Parse(configURL); err == nil {
+		if format := DetectFormat(parsedURL.Path); format != FormatUnknown {
+			return format
+		}
+	}
+
+	return DetectFormatFromContent(data)
+}
+
+// formatFromContentType maps a MIME content type to a ConfigFormat.
+// Parameters (e.g. "; charset=utf-8") and structured suffixes ("+json") are honored.
+func formatFromContentType(contentType string) ConfigFormat {
+	mediaType := strings.ToLower(strings.TrimSpace(contentType))
+	if idx := strings.IndexByte(mediaType, ';'); idx >= 0 {
+		mediaType = strings.TrimSpace(mediaType[:idx])
+	}
+
+	switch mediaType {
+	case "":
+		return FormatUnknown
+	case "application/json", "text/json":
+		return FormatJSON
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return FormatYAML
+	case "application/toml", "text/toml", "text/x-toml":
+		return FormatTOML
+	case "application/hcl", "text/hcl":
+		return FormatHCL
+	case "text/x-ini", "application/x-ini":
+		return FormatINI
+	case "text/x-java-properties", "text/x-properties":
+		return FormatProperties
+	}
+
+	switch {
+	case strings.HasSuffix(mediaType, "+json"):
+		return FormatJSON
+	case strings.HasSuffix(mediaType, "+yaml"):
+		return FormatYAML
+	}
+
+	return FormatUnknown
+}
+
 // waitForRetry waits for retry delay or context cancellation
 func waitForRetry(ctx context.Context, delay time.Duration) error {
 	select {
@@ -438,11 +597,19 @@ func WatchRemoteConfigWithContext(ctx context.Context, configURL string, opts ..
 	}
 
 	// Try native watching first
-	return startWatching(ctx, provider, configURL, options)
+	return startWatching(withRemoteOptions(ctx, options), provider, configURL, options)
 }
 
 // startWatching starts the actual watching process
 func startWatching(ctx context.Context, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions) (<-chan map[string]interface{}, error) {
+	// Prefer incremental watching when the provider supports it
+	if revisionWatcher, ok := provider.(RemoteConfigRevisionWatcher); ok {
+		configChan, err := startRevisionWatch(ctx, revisionWatcher, provider, configURL, options)
+		if err != nil || configChan != nil {
+			return configChan, err
+		}
+	}
+
 	configChan, err := provider.Watch(ctx, configURL)
 	if err != nil {
 		return nil, errors.Wrap(err, ErrCodeRemoteConfigError, "failed to start watching remote configuration")
@@ -476,7 +643,7 @@ func pollForChanges(ctx context.Context, provider RemoteConfigProvider, configUR
 	var lastConfig map[string]interface{}
 
 	// Load initial configuration
-	if config, err := provider.Load(ctx, configURL); err == nil {
+	if config, err := loadAttempt(ctx, provider, configURL, options); err == nil {
 		lastConfig = config
 		select {
 		case pollingChan <- config:
@@ -488,7 +655,7 @@ func pollForChanges(ctx context.Context, provider RemoteConfigProvider, configUR
 	for {
 		select {
 		case <-ticker.C:
-			if newConfig := checkForChanges(ctx, provider, configURL, lastConfig); newConfig != nil {
+			if newConfig := checkForChanges(ctx, provider, configURL, options, lastConfig); newConfig != nil {
 				lastConfig = newConfig
 				select {
 				case pollingChan <- newConfig:
@@ -503,8 +670,8 @@ func pollForChanges(ctx context.Context, provider RemoteConfigProvider, configUR
 }
 
 // checkForChanges checks if configuration has changed
-func checkForChanges(ctx context.Context, provider RemoteConfigProvider, configURL string, lastConfig map[string]interface{}) map[string]interface{} {
-	newConfig, err := provider.Load(ctx, configURL)
+func checkForChanges(ctx context.Context, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions, lastConfig map[string]interface{}) map[string]interface{} {
+	newConfig, err := loadAttempt(ctx, provider, configURL, options)
 	if err != nil {
 		return nil
 	}
@@ -596,7 +763,7 @@ func HealthCheckRemoteProviderWithContext(ctx context.Context, configURL string,
 	}
 
 	// Create context with timeout
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, options.Timeout)
+	ctxWithTimeout, cancel := context.WithTimeout(withRemoteOptions(ctx, options), options.Timeout)
 	defer cancel()
 
 	return provider.HealthCheck(ctxWithTimeout, configURL)
@@ -0,0 +1,106 @@
+// remote_config_auth.go: Authentication and TLS options for remote providers
+//
+// RemoteConfigProvider.Load and Watch receive only a context and a URL, so
+// credentials for authenticated endpoints travel in the context: Argus
+// attaches the RemoteConfigOptions of every load, watch and health check, and
+// providers read them back with RemoteConfigOptionsFromContext. Providers
+// written before this change keep working; they simply ignore the options.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+)
+
+// BasicAuth holds HTTP basic authentication credentials
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// remoteOptionsKey is the context key of the RemoteConfigOptions in use
+type remoteOptionsKey struct{}
+
+// withRemoteOptions returns ctx carrying options for providers
+func withRemoteOptions(ctx context.Context, options *RemoteConfigOptions) context.Context {
+	if options == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteOptionsKey{}, options)
+}
+
+// RemoteConfigOptionsFromContext returns the options of the load, watch or
+// health check a provider is serving. Providers use it to apply Headers,
+// BasicAuth, BearerToken and TLSClientConfig to their requests.
+//
+// Example:
+//
+//	func (p *HTTPProvider) LoadRaw(ctx context.Context, configURL string) ([]byte, string, error) {
+//	    req, _ := http.NewRequestWithContext(ctx, http.MethodGet, configURL, nil)
+//	    client := http.DefaultClient
+//	    if opts, ok := argus.RemoteConfigOptionsFromContext(ctx); ok {
+//	        opts.ApplyToRequest(req)
+//	        client = opts.HTTPClient()
+//	    }
+//	    ...
+//	}
+func RemoteConfigOptionsFromContext(ctx context.Context) (*RemoteConfigOptions, bool) {
+	options, ok := ctx.Value(remoteOptionsKey{}).(*RemoteConfigOptions)
+	return options, ok && options != nil
+}
+
+// ApplyToRequest sets Headers, BasicAuth and BearerToken on req. Headers
+// are applied first, so explicit credentials take precedence over an
+// Authorization header.
+func (o *RemoteConfigOptions) ApplyToRequest(req *http.Request) {
+	for name, value := range o.Headers {
+		req.Header.Set(name, value)
+	}
+	if o.BasicAuth != nil {
+		req.SetBasicAuth(o.BasicAuth.Username, o.BasicAuth.Password)
+	}
+	if o.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+o.BearerToken)
+	}
+}
+
+// HTTPClient returns an HTTP client using TLSClientConfig, or
+// http.DefaultClient when none is set. Timeouts come from the request
+// context, so the client sets none.
+func (o *RemoteConfigOptions) HTTPClient() *http.Client {
+	if o.TLSClientConfig == nil {
+		return http.DefaultClient
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = o.TLSClientConfig
+	return &http.Client{Transport: transport}
+}
+
+// LoadRemoteConfigWithOptions loads configuration from configURL with
+// options, including credentials for authenticated endpoints. Nil options
+// use DefaultRemoteConfigOptions.
+//
+// Example:
+//
+//	opts := argus.DefaultRemoteConfigOptions()
+//	opts.BearerToken = os.Getenv("CONFIG_TOKEN")
+//	opts.TLSClientConfig = &tls.Config{Certificates: []tls.Certificate{clientCert}}
+//	opts.RequestTimeout = 5 * time.Second
+//	config, err := argus.LoadRemoteConfigWithOptions(ctx, "https://config.internal/api/app", opts)
+func LoadRemoteConfigWithOptions(ctx context.Context, configURL string, options *RemoteConfigOptions) (map[string]interface{}, error) {
+	return LoadRemoteConfigWithContext(ctx, configURL, options)
+}
+
+// tlsConfigOf returns the TLS client configuration of ctx's options, if any
+func tlsConfigOf(ctx context.Context) *tls.Config {
+	if options, ok := RemoteConfigOptionsFromContext(ctx); ok {
+		return options.TLSClientConfig
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// remote_config_auth_test.go: Tests for remote provider authentication options
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// optionsRecordingProvider records the options and deadline each load sees
+type optionsRecordingProvider struct {
+	mu       sync.Mutex
+	options  *RemoteConfigOptions
+	deadline time.Duration
+}
+
+func (p *optionsRecordingProvider) Name() string                    { return "Options Recording Provider" }
+func (p *optionsRecordingProvider) Scheme() string                  { return "authtest" }
+func (p *optionsRecordingProvider) Validate(configURL string) error { return nil }
+
+func (p *optionsRecordingProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.options, _ = RemoteConfigOptionsFromContext(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		p.deadline = time.Until(deadline)
+	}
+	return map[string]interface{}{"ok": true}, nil
+}
+
+func (p *optionsRecordingProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *optionsRecordingProvider) HealthCheck(ctx context.Context, configURL string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.options, _ = RemoteConfigOptionsFromContext(ctx)
+	return nil
+}
+
+func TestLoadRemoteConfigWithOptions_ThreadsOptions(t *testing.T) {
+	provider := &optionsRecordingProvider{}
+	if registered, err := GetRemoteProvider("authtest"); err == nil {
+		provider = registered.(*optionsRecordingProvider)
+	} else if err := RegisterRemoteProvider(provider); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+
+	opts := DefaultRemoteConfigOptions()
+	opts.BearerToken = "secret-token"
+	opts.RequestTimeout = 500 * time.Millisecond
+
+	if _, err := LoadRemoteConfigWithOptions(context.Background(), "authtest://config/app", opts); err != nil {
+		t.Fatalf("Failed to load remote config: %v", err)
+	}
+	provider.mu.Lock()
+	if provider.options != opts {
+		t.Errorf("Expected the provider to receive the load options, got %+v", provider.options)
+	}
+	if provider.deadline <= 0 || provider.deadline > opts.RequestTimeout {
+		t.Errorf("Expected the request deadline within %v, got %v", opts.RequestTimeout, provider.deadline)
+	}
+	provider.options = nil
+	provider.mu.Unlock()
+
+	if err := HealthCheckRemoteProvider("authtest://config/app", opts); err != nil {
+		t.Fatalf("Failed to health check: %v", err)
+	}
+	provider.mu.Lock()
+	if provider.options != opts {
+		t.Error("Expected health checks to receive the options")
+	}
+	provider.mu.Unlock()
+}
+
+func TestRemoteConfigOptions_AuthenticatedTLSRequest(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "app" || pass != "s3cret" || r.Header.Get("X-Tenant") != "blue" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	opts := DefaultRemoteConfigOptions()
+	opts.Headers["X-Tenant"] = "blue"
+	opts.BasicAuth = &BasicAuth{Username: "app", Password: "s3cret"}
+	opts.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	opts.ApplyToRequest(req)
+	resp, err := opts.HTTPClient().Do(req)
+	if err != nil {
+		t.Fatalf("Request with TLSClientConfig failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected authenticated request to succeed, got %d", resp.StatusCode)
+	}
+
+	// The default client does not trust the test CA
+	if (&RemoteConfigOptions{}).HTTPClient() != http.DefaultClient {
+		t.Error("Expected http.DefaultClient without TLSClientConfig")
+	}
+}
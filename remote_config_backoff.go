@@ -0,0 +1,56 @@
+// remote_config_backoff.go: Exponential backoff with jitter for remote retries
+//
+// When a configuration server comes back after an outage, every instance that
+// was retrying against it reconnects at once if they all wait the same fixed
+// delay. Retries therefore back off exponentially from RetryDelay up to
+// MaxBackoff, and with Jitter each wait is drawn uniformly from [0, backoff]
+// ("full jitter"), spreading reconnects over the whole window.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// retryBackoff returns the wait before retry attempt (1 for the first
+// retry). The base delay is RetryDelay, or InitialBackoff when set; a
+// Multiplier of 1 or less keeps it constant, and a zero MaxBackoff leaves it
+// uncapped.
+func (o *RemoteConfigOptions) retryBackoff(attempt int) time.Duration {
+	backoff := o.InitialBackoff
+	if backoff <= 0 {
+		backoff = o.RetryDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	if o.Multiplier > 1 {
+		for i := 1; i < attempt; i++ {
+			if o.MaxBackoff > 0 && backoff >= o.MaxBackoff {
+				break
+			}
+			next := float64(backoff) * o.Multiplier
+			if next >= math.MaxInt64 {
+				backoff = math.MaxInt64
+				break
+			}
+			backoff = time.Duration(next)
+		}
+	}
+	if o.MaxBackoff > 0 && backoff > o.MaxBackoff {
+		backoff = o.MaxBackoff
+	}
+
+	if o.Jitter {
+		// #nosec G404 -- jitter spreads reconnects; it needs no cryptographic randomness
+		backoff = rand.N(backoff + 1)
+	}
+	return backoff
+}
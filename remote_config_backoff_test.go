@@ -0,0 +1,157 @@
+// remote_config_backoff_test.go: Tests for exponential backoff with jitter
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyRemoteProvider fails a set number of loads before succeeding
+type flakyRemoteProvider struct {
+	mu       sync.Mutex
+	failures int
+}
+
+func (p *flakyRemoteProvider) Name() string                    { return "Flaky Test Provider" }
+func (p *flakyRemoteProvider) Scheme() string                  { return "backofftest" }
+func (p *flakyRemoteProvider) Validate(configURL string) error { return nil }
+
+func (p *flakyRemoteProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return nil, fmt.Errorf("connection refused")
+	}
+	return map[string]interface{}{"ok": true}, nil
+}
+
+func (p *flakyRemoteProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *flakyRemoteProvider) HealthCheck(ctx context.Context, configURL string) error {
+	return nil
+}
+
+func TestRetryBackoff_Exponential(t *testing.T) {
+	opts := &RemoteConfigOptions{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, want := range expected {
+		if got := opts.retryBackoff(i + 1); got != want {
+			t.Errorf("Attempt %d: expected backoff %v, got %v", i+1, want, got)
+		}
+	}
+}
+
+func TestRetryBackoff_FullJitter(t *testing.T) {
+	opts := &RemoteConfigOptions{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     400 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         true,
+	}
+
+	distinct := make(map[time.Duration]bool)
+	for i := 0; i < 200; i++ {
+		got := opts.retryBackoff(5)
+		if got < 0 || got > 400*time.Millisecond {
+			t.Fatalf("Jittered backoff %v outside [0, 400ms]", got)
+		}
+		distinct[got] = true
+	}
+	if len(distinct) < 10 {
+		t.Errorf("Expected jittered backoffs to vary, got %d distinct values", len(distinct))
+	}
+}
+
+func TestRetryBackoff_RetryDelayCompatibility(t *testing.T) {
+	// Options built before backoff fields existed keep a constant RetryDelay
+	opts := &RemoteConfigOptions{RetryDelay: 50 * time.Millisecond}
+	for attempt := 1; attempt <= 5; attempt++ {
+		if got := opts.retryBackoff(attempt); got != 50*time.Millisecond {
+			t.Errorf("Attempt %d: expected constant 50ms, got %v", attempt, got)
+		}
+	}
+
+	if got := (&RemoteConfigOptions{}).retryBackoff(3); got != 0 {
+		t.Errorf("Expected no backoff without delays, got %v", got)
+	}
+}
+
+func TestRetryBackoff_NoOverflow(t *testing.T) {
+	opts := &RemoteConfigOptions{InitialBackoff: time.Hour, Multiplier: 10}
+	if got := opts.retryBackoff(100); got != math.MaxInt64 {
+		t.Errorf("Expected uncapped backoff to saturate, got %v", got)
+	}
+}
+
+func TestRetryBackoff_Defaults(t *testing.T) {
+	opts := DefaultRemoteConfigOptions()
+	if opts.RetryDelay != time.Second || opts.InitialBackoff != 0 || opts.MaxBackoff != 30*time.Second ||
+		opts.Multiplier != 2 || !opts.Jitter {
+		t.Errorf("Unexpected backoff defaults: %+v", opts)
+	}
+	for attempt := 1; attempt <= 20; attempt++ {
+		if got := opts.retryBackoff(attempt); got > 30*time.Second {
+			t.Fatalf("Attempt %d: backoff %v exceeds MaxBackoff", attempt, got)
+		}
+	}
+
+	// RetryDelay set on the defaults is the base of the backoff
+	opts.RetryDelay = 5 * time.Second
+	opts.Jitter = false
+	if got := opts.retryBackoff(1); got != 5*time.Second {
+		t.Errorf("Expected the first retry to wait RetryDelay, got %v", got)
+	}
+}
+
+func TestLoadRemoteConfig_BacksOffBetweenRetries(t *testing.T) {
+	provider := &flakyRemoteProvider{}
+	if registered, err := GetRemoteProvider("backofftest"); err == nil {
+		provider = registered.(*flakyRemoteProvider)
+	} else if err := RegisterRemoteProvider(provider); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+	provider.mu.Lock()
+	provider.failures = 2
+	provider.mu.Unlock()
+
+	opts := &RemoteConfigOptions{
+		Timeout:        5 * time.Second,
+		RetryAttempts:  3,
+		InitialBackoff: 20 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     3,
+	}
+
+	start := time.Now()
+	if _, err := LoadRemoteConfig("backofftest://host/config", opts); err != nil {
+		t.Fatalf("Failed to load remote config: %v", err)
+	}
+	// 20ms before the first retry, 60ms before the second
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("Expected at least 80ms of backoff, got %v", elapsed)
+	}
+}
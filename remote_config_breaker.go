@@ -0,0 +1,115 @@
+// remote_config_breaker.go: Circuit breaker for failing remote sources
+//
+// While a configuration server is down, every sync retries PrimaryURL and
+// FallbackURL, waits out their timeouts and reports the same failure again.
+// With RemoteConfig.CircuitBreakerThreshold set, that many consecutive failed
+// syncs open the breaker: remote sources are left alone for
+// CircuitBreakerCooldown and the last known good configuration keeps being
+// served. The first sync after the cooldown is a half-open probe with no
+// retries; its success closes the breaker, its failure opens it again.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// CircuitState is the state of the remote configuration circuit breaker
+type CircuitState int32
+
+const (
+	// CircuitClosed lets every sync reach the remote sources (default)
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen skips the remote sources until the cooldown ends
+	CircuitOpen
+
+	// CircuitHalfOpen lets one probe sync through after the cooldown
+	CircuitHalfOpen
+)
+
+// String returns the name of the circuit state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+// errCircuitOpen reports a sync skipped by the open circuit breaker
+var errCircuitOpen = errors.New(ErrCodeRemoteCircuitOpen, "remote configuration circuit breaker is open")
+
+// CircuitState returns the state of the circuit breaker; always
+// CircuitClosed when CircuitBreakerThreshold is not set
+func (r *RemoteConfigManager) CircuitState() CircuitState {
+	return CircuitState(r.circuit.Load())
+}
+
+// remoteAllowed reports whether a sync may reach the remote sources, moving
+// an open breaker to half-open once the cooldown has elapsed (caller must
+// hold syncMutex)
+func (r *RemoteConfigManager) remoteAllowed() bool {
+	if r.CircuitState() != CircuitOpen {
+		return true
+	}
+	if time.Since(r.circuitOpened) < r.config.CircuitBreakerCooldown {
+		return false
+	}
+	r.setCircuit(CircuitHalfOpen, AuditInfo, nil)
+	return true
+}
+
+// recordRemoteSuccess closes the breaker (caller must hold syncMutex)
+func (r *RemoteConfigManager) recordRemoteSuccess() {
+	r.failures = 0
+	if r.CircuitState() != CircuitClosed {
+		r.setCircuit(CircuitClosed, AuditInfo, nil)
+	}
+}
+
+// recordRemoteFailure counts a sync in which every remote source failed and
+// opens the breaker at CircuitBreakerThreshold, or when a half-open probe
+// fails. Opening is reported to the ErrorHandler (caller must hold syncMutex).
+func (r *RemoteConfigManager) recordRemoteFailure(cause error) {
+	if r.config.CircuitBreakerThreshold <= 0 {
+		return
+	}
+	r.failures++
+	if r.CircuitState() != CircuitHalfOpen && r.failures < r.config.CircuitBreakerThreshold {
+		return
+	}
+
+	r.circuitOpened = time.Now()
+	details := map[string]interface{}{
+		"consecutive_failures": r.failures,
+		"cooldown":             r.config.CircuitBreakerCooldown.String(),
+		"error":                cause.Error(),
+	}
+	r.setCircuit(CircuitOpen, AuditWarn, details)
+
+	if r.watcher.config.ErrorHandler != nil {
+		err := errors.Wrap(cause, ErrCodeRemoteCircuitOpen, "remote configuration circuit breaker opened").
+			WithContext("consecutive_failures", r.failures).
+			WithContext("cooldown", r.config.CircuitBreakerCooldown.String())
+		r.watcher.config.ErrorHandler(err, r.config.PrimaryURL)
+	}
+}
+
+// setCircuit changes the breaker state and records a circuit_<state> audit
+// event (caller must hold syncMutex)
+func (r *RemoteConfigManager) setCircuit(state CircuitState, level AuditLevel, context map[string]interface{}) {
+	previous := CircuitState(r.circuit.Swap(int32(state)))
+	r.watcher.auditLogger.Log(level, "remote_config", "circuit_"+state.String(), r.config.PrimaryURL, previous.String(), state.String(), context)
+}
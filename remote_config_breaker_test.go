@@ -0,0 +1,152 @@
+// remote_config_breaker_test.go: Tests for the remote circuit breaker
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestRemoteConfigManager_CircuitBreaker(t *testing.T) {
+	auditPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	provider := registerSwitchableProvider(t, map[string]interface{}{"version": "v1"})
+
+	var mu sync.Mutex
+	var openErrors int
+	watcher := New(Config{
+		Audit: AuditConfig{
+			Enabled:       true,
+			OutputFile:    auditPath,
+			MinLevel:      AuditInfo,
+			BufferSize:    100,
+			FlushInterval: time.Hour,
+		},
+		ErrorHandler: func(err error, path string) {
+			if errors.HasCode(err, ErrCodeRemoteCircuitOpen) {
+				mu.Lock()
+				openErrors++
+				mu.Unlock()
+			}
+		},
+	})
+
+	cooldown := 100 * time.Millisecond
+	manager, err := NewRemoteConfigManager(&RemoteConfig{
+		Enabled:                 true,
+		PrimaryURL:              "switchtest://primary/config",
+		SyncInterval:            time.Minute,
+		Timeout:                 time.Second,
+		RetryDelay:              time.Millisecond,
+		CircuitBreakerThreshold: 2,
+		CircuitBreakerCooldown:  cooldown,
+	}, watcher)
+	if err != nil {
+		t.Fatalf("Failed to create RemoteConfigManager: %v", err)
+	}
+	if err := manager.Start(); err != nil {
+		t.Fatalf("Failed to start RemoteConfigManager: %v", err)
+	}
+	defer manager.Stop()
+
+	expectState := func(want CircuitState) {
+		t.Helper()
+		if got := manager.CircuitState(); got != want {
+			t.Fatalf("Expected circuit %v, got %v", want, got)
+		}
+	}
+	expectVersion := func(want string) {
+		t.Helper()
+		config, _, err := manager.GetCurrentConfig()
+		if err != nil {
+			t.Fatalf("Failed to get configuration: %v", err)
+		}
+		if config["version"] != want {
+			t.Fatalf("Expected version %s, got %v", want, config["version"])
+		}
+	}
+
+	provider.set(nil, true)
+	manager.performSync()
+	expectState(CircuitClosed)
+	manager.performSync()
+	expectState(CircuitOpen)
+
+	// Open: the recovered remote is not consulted until the cooldown ends
+	provider.set(map[string]interface{}{"version": "v2"}, false)
+	manager.performSync()
+	expectState(CircuitOpen)
+	expectVersion("v1")
+
+	// A failed half-open probe opens the breaker again
+	provider.set(nil, true)
+	time.Sleep(cooldown + 20*time.Millisecond)
+	manager.performSync()
+	expectState(CircuitOpen)
+
+	provider.set(map[string]interface{}{"version": "v2"}, false)
+	time.Sleep(cooldown + 20*time.Millisecond)
+	manager.performSync()
+	expectState(CircuitClosed)
+	expectVersion("v2")
+
+	mu.Lock()
+	if openErrors != 2 {
+		t.Errorf("Expected 2 circuit open errors reported, got %d", openErrors)
+	}
+	mu.Unlock()
+
+	if err := watcher.auditLogger.Close(); err != nil {
+		t.Fatalf("Failed to close audit logger: %v", err)
+	}
+	events, err := ReadAuditLog(auditPath)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	var transitions []string
+	for _, event := range events {
+		if event.Component == "circuit_open" || event.Component == "circuit_half_open" || event.Component == "circuit_closed" {
+			transitions = append(transitions, fmt.Sprintf("%v->%v", event.OldValue, event.NewValue))
+		}
+	}
+	want := []string{"closed->open", "open->half_open", "half_open->open", "open->half_open", "half_open->closed"}
+	if fmt.Sprint(transitions) != fmt.Sprint(want) {
+		t.Errorf("Expected circuit transitions %v, got %v", want, transitions)
+	}
+}
+
+func TestRemoteConfigManager_CircuitBreakerDisabled(t *testing.T) {
+	provider := registerSwitchableProvider(t, map[string]interface{}{"version": "v1"})
+	watcher := New(Config{})
+	defer func() { _ = watcher.Close() }()
+
+	manager, err := NewRemoteConfigManager(&RemoteConfig{
+		Enabled:      true,
+		PrimaryURL:   "switchtest://primary/config",
+		SyncInterval: time.Minute,
+		Timeout:      time.Second,
+		RetryDelay:   time.Millisecond,
+	}, watcher)
+	if err != nil {
+		t.Fatalf("Failed to create RemoteConfigManager: %v", err)
+	}
+
+	provider.set(nil, true)
+	for i := 0; i < 5; i++ {
+		manager.performSync()
+	}
+	if state := manager.CircuitState(); state != CircuitClosed {
+		t.Errorf("Expected the breaker to stay closed when disabled, got %v", state)
+	}
+	if state := watcher.RemoteCircuitState(); state != CircuitClosed {
+		t.Errorf("Expected CircuitClosed without Config.Remote, got %v", state)
+	}
+}
@@ -52,6 +52,22 @@ type RemoteConfigManager struct {
 	ctx       context.Context
 	cancel    context.CancelFunc
 	syncMutex sync.Mutex // Protects sync operations (not hot path)
+
+	// Source that served the current configuration (string)
+	source atomic.Value
+
+	// Whether the last load merged in the local file (guarded by syncMutex)
+	merged bool
+
+	// Circuit breaker: CircuitState (atomic for lock-free reads), then the
+	// consecutive failed syncs and opening time (guarded by syncMutex)
+	circuit       atomic.Int32
+	failures      int
+	circuitOpened time.Time
+
+	// onChange receives each loaded configuration that differs from the
+	// previous one, with the source that served it (set by the Watcher)
+	onChange func(config map[string]interface{}, source string)
 }
 
 // NewRemoteConfigManager creates a new remote configuration manager.
@@ -89,6 +105,11 @@ func NewRemoteConfigManager(config *RemoteConfig, watcher *Watcher) (*RemoteConf
 		}
 	}
 
+	if err := ValidateOneOf(config.MergeStrategy, []MergeStrategy{MergeNone, MergeRemoteWins, MergeLocalWins, MergeDeep},
+		string(ErrInvalidMergeStrategy.Code), ErrInvalidMergeStrategy.Message); err != nil {
+		return nil, err
+	}
+
 	// Validate fallback path if provided
 	if config.FallbackPath != "" {
 		if !filepath.IsAbs(config.FallbackPath) && !isRelativePathSafe(config.FallbackPath) {
@@ -111,6 +132,10 @@ func NewRemoteConfigManager(config *RemoteConfig, watcher *Watcher) (*RemoteConf
 		config.Timeout = config.SyncInterval / 2
 	}
 
+	if config.CircuitBreakerThreshold > 0 && config.CircuitBreakerCooldown <= 0 {
+		config.CircuitBreakerCooldown = 1 * time.Minute
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	manager := &RemoteConfigManager{
@@ -142,15 +167,16 @@ func (r *RemoteConfigManager) Start() error {
 	}
 
 	// Perform initial configuration load
-	config, err := r.loadWithFallback()
+	r.syncMutex.Lock()
+	config, source, err := r.loadWithFallback()
 	if err != nil {
 		// Continue with sync loop even if initial load fails for recovery
 		r.watcher.auditLogger.Log(AuditInfo, "remote_config", "initial_load_failed", r.config.PrimaryURL, nil, nil, map[string]interface{}{"error": err.Error()})
 	} else {
-		r.currentConfig.Store(&config)
-		r.lastSync.Store(time.Now().UnixNano())
-		r.watcher.auditLogger.Log(AuditInfo, "remote_config", "initial_load_success", r.config.PrimaryURL, nil, nil, nil)
+		r.apply(config, source)
+		r.watcher.auditLogger.Log(AuditInfo, "remote_config", "initial_load_success", r.config.PrimaryURL, nil, nil, r.syncContext())
 	}
+	r.syncMutex.Unlock()
 
 	// Start background sync loop
 	go r.syncLoop()
@@ -166,11 +192,9 @@ func (r *RemoteConfigManager) Start() error {
 //
 // Thread safety: Safe to call multiple times and from multiple goroutines.
 func (r *RemoteConfigManager) Stop() {
-	if !r.running.CompareAndSwap(true, false) {
-		return // Already stopped
-	}
-
+	// Cancel first: also stops a Start that has not begun yet
 	r.cancel()
+	r.running.Store(false)
 }
 
 // GetCurrentConfig returns the most recently loaded configuration.
@@ -219,7 +243,10 @@ func (r *RemoteConfigManager) performSync() {
 	r.syncMutex.Lock()
 	defer r.syncMutex.Unlock()
 
-	config, err := r.loadWithFallback()
+	config, source, err := r.loadWithFallback()
+	if err == errCircuitOpen {
+		return // Keep the last known good configuration until the cooldown ends
+	}
 	if err != nil {
 		r.watcher.auditLogger.Log(AuditWarn, "remote_config", "sync_failed", r.config.PrimaryURL, nil, nil, map[string]interface{}{"error": err.Error()})
 
@@ -230,10 +257,44 @@ func (r *RemoteConfigManager) performSync() {
 		return
 	}
 
+	r.apply(config, source)
+	r.watcher.auditLogger.Log(AuditInfo, "remote_config", "sync_success", r.config.PrimaryURL, nil, nil, r.syncContext())
+}
+
+// apply stores a configuration loaded from source and notifies onChange when
+// it differs from the previous one (caller must hold syncMutex)
+func (r *RemoteConfigManager) apply(config map[string]interface{}, source string) {
 	// Update cache atomically
-	r.currentConfig.Store(&config)
+	previous := r.currentConfig.Swap(&config)
 	r.lastSync.Store(time.Now().UnixNano())
-	r.watcher.auditLogger.Log(AuditInfo, "remote_config", "sync_success", r.config.PrimaryURL, nil, nil, nil)
+	r.noteSource(source)
+
+	if r.onChange != nil && (previous == nil || !configEquals(*previous, config)) {
+		r.onChange(config, source)
+	}
+}
+
+// noteSource records which source served the configuration and audits
+// failover transitions between the primary, FallbackURL and FallbackPath
+// (caller must hold syncMutex)
+func (r *RemoteConfigManager) noteSource(source string) {
+	from, _ := r.source.Load().(string)
+	if from == "" {
+		from = r.config.PrimaryURL
+	}
+	r.source.Store(source)
+	if source == from {
+		return
+	}
+
+	level := AuditWarn
+	switch source {
+	case r.config.PrimaryURL:
+		level = AuditInfo // Recovered
+	case r.config.FallbackPath:
+		level = AuditCritical
+	}
+	r.watcher.auditLogger.Log(level, "remote_config", "source_changed", source, from, source, nil)
 }
 
 // loadWithFallback implements the complete fallback sequence for configuration loading.
@@ -244,40 +305,62 @@ func (r *RemoteConfigManager) performSync() {
 // 2. FallbackURL with retries (if configured)
 // 3. FallbackPath local file (if configured)
 //
+// While the circuit breaker is open, steps 1 and 2 are skipped; with a
+// configuration already loaded, errCircuitOpen is returned so that it is kept.
+//
 // Returns:
 //   - map[string]interface{}: Loaded configuration
+//   - string: The URL or path that served it
 //   - error: Combined errors from all failed attempts
-func (r *RemoteConfigManager) loadWithFallback() (map[string]interface{}, error) {
+func (r *RemoteConfigManager) loadWithFallback() (map[string]interface{}, string, error) {
 	var lastErr error
 
-	// Attempt 1: Primary remote URL
-	if config, err := r.loadRemoteWithRetries(r.config.PrimaryURL); err == nil {
-		return config, nil
-	} else {
-		lastErr = err
-	}
-
-	// Attempt 2: Fallback remote URL (if configured)
-	if r.config.FallbackURL != "" {
-		if config, err := r.loadRemoteWithRetries(r.config.FallbackURL); err == nil {
-			r.watcher.auditLogger.Log(AuditWarn, "remote_config", "fallback_url_used", r.config.FallbackURL, nil, nil, nil)
-			return config, nil
-		} else {
-			lastErr = err
+	if r.remoteAllowed() {
+		config, source, err := r.loadRemoteSources()
+		if err == nil {
+			r.recordRemoteSuccess()
+			return r.mergeLocal(config), source, nil
 		}
+		r.recordRemoteFailure(err)
+		lastErr = err
+	} else if r.currentConfig.Load() != nil {
+		return nil, "", errCircuitOpen
+	} else {
+		lastErr = errCircuitOpen
 	}
 
 	// Attempt 3: Local fallback file (if configured)
 	if r.config.FallbackPath != "" {
+		r.merged = false
 		if config, err := r.loadLocalFallback(); err == nil {
 			r.watcher.auditLogger.Log(AuditCritical, "remote_config", "fallback_file_used", r.config.FallbackPath, nil, nil, nil)
-			return config, nil
+			return config, r.config.FallbackPath, nil
 		} else {
 			lastErr = err
 		}
 	}
 
-	return nil, errors.Wrap(lastErr, ErrCodeRemoteConfigError, "all remote configuration sources failed")
+	return nil, "", errors.Wrap(lastErr, ErrCodeRemoteConfigError, "all remote configuration sources failed")
+}
+
+// loadRemoteSources tries PrimaryURL, then FallbackURL, and returns the
+// configuration with the URL that served it
+func (r *RemoteConfigManager) loadRemoteSources() (map[string]interface{}, string, error) {
+	// Attempt 1: Primary remote URL
+	config, err := r.loadRemoteWithRetries(r.config.PrimaryURL)
+	if err == nil {
+		return config, r.config.PrimaryURL, nil
+	}
+
+	// Attempt 2: Fallback remote URL (if configured)
+	if r.config.FallbackURL != "" {
+		config, err = r.loadRemoteWithRetries(r.config.FallbackURL)
+		if err == nil {
+			r.watcher.auditLogger.Log(AuditWarn, "remote_config", "fallback_url_used", r.config.FallbackURL, nil, nil, nil)
+			return config, r.config.FallbackURL, nil
+		}
+	}
+	return nil, "", err
 }
 
 // loadRemoteWithRetries attempts to load from a remote URL with exponential backoff.
@@ -287,20 +370,20 @@ func (r *RemoteConfigManager) loadRemoteWithRetries(url string) (map[string]inte
 
 	var lastErr error
 
-	for attempt := 0; attempt <= r.config.MaxRetries; attempt++ {
-		if attempt > 0 {
-			// Exponential backoff: wait RetryDelay * 2^(attempt-1)
-			// Safe calculation to prevent integer overflow
-			var delay time.Duration
-			if attempt > 30 {
-				// Cap exponential growth to prevent overflow
-				delay = r.config.RetryDelay * time.Duration(1<<30)
-			} else {
-				delay = r.config.RetryDelay * time.Duration(1<<(attempt-1))
-			}
+	// A half-open probe makes a single attempt
+	retries := r.config.MaxRetries
+	if r.CircuitState() == CircuitHalfOpen {
+		retries = 0
+	}
+
+	// Exponential backoff from RetryDelay, jittered so that instances
+	// sharing a failed source do not retry in lockstep
+	backoff := RemoteConfigOptions{RetryDelay: r.config.RetryDelay, Multiplier: 2, Jitter: true}
 
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
 			select {
-			case <-time.After(delay):
+			case <-time.After(backoff.retryBackoff(attempt)):
 			case <-ctx.Done():
 				return nil, errors.Wrap(ctx.Err(), ErrCodeRemoteConfigError, "timeout during retry delay")
 			}
@@ -322,23 +405,20 @@ func (r *RemoteConfigManager) loadRemoteWithRetries(url string) (map[string]inte
 	return nil, lastErr
 }
 
-// loadLocalFallback loads configuration from the local fallback file.
+// loadLocalFallback loads configuration from the local fallback file, in
+// any format DetectFormat recognizes from its extension.
 func (r *RemoteConfigManager) loadLocalFallback() (map[string]interface{}, error) {
-	// For now, use a simple JSON file loading approach
-	// TODO: Integrate with universal config parsing when available
-
-	// Check if file exists and is readable
-	if _, err := filepath.Abs(r.config.FallbackPath); err != nil {
-		return nil, errors.Wrap(err, ErrCodeInvalidConfig, "invalid fallback path")
+	data, err := readFileLimited(r.config.FallbackPath, r.watcher.config.MaxFileSize)
+	if err != nil {
+		return nil, err
 	}
 
-	// For simplicity in this implementation, we'll return a basic config
-	// In production, this should integrate with the universal config loader
-	return map[string]interface{}{
-		"fallback": true,
-		"source":   r.config.FallbackPath,
-		"message":  "Local fallback configuration loaded",
-	}, nil
+	config, err := ParseConfigWithStrictness(data, DetectFormat(r.config.FallbackPath), r.watcher.config.ParseStrictness)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeRemoteConfigError, "failed to parse fallback configuration file").
+			WithContext("path", r.config.FallbackPath)
+	}
+	return config, nil
 }
 
 // validateRemoteURL validates that a URL is parseable and has a supported scheme.
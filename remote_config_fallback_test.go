@@ -591,8 +591,8 @@ func TestLoadWithFallbackChain(t *testing.T) {
 		if configErr == nil {
 			t.Logf("Config loaded from fallback: %v", currentConfig)
 
-			// Check if it's the fallback config from loadLocalFallback
-			if fallbackValue, exists := currentConfig["fallback"]; exists && fallbackValue == true {
+			// Check if it's the fallback file's content from loadLocalFallback
+			if fallbackValue, exists := currentConfig["fallback_loaded"]; exists && fallbackValue == true {
 				t.Logf("SUCCESS: loadLocalFallback was called and returned fallback config")
 			} else if testValue, exists := currentConfig["test"]; exists {
 				t.Logf("Unexpected: Got mock provider response: test=%v", testValue)
@@ -658,8 +658,8 @@ func TestLoadWithFallbackChain(t *testing.T) {
 		if configErr == nil {
 			t.Logf("Config from complete fallback chain: %v", currentConfig)
 
-			// Should be from loadLocalFallback since both remote URLs fail
-			if fallbackValue, exists := currentConfig["fallback"]; exists && fallbackValue == true {
+			// Should be the fallback file's content since both remote URLs fail
+			if fallbackValue, exists := currentConfig["fallback_loaded"]; exists && fallbackValue == true {
 				t.Logf("SUCCESS: Complete fallback chain worked - used local fallback file")
 			} else {
 				t.Errorf("Expected fallback config, got: %v", currentConfig)
@@ -0,0 +1,159 @@
+// remote_config_format_test.go: Testing remote config format negotiation
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// httpRawProvider is a minimal HTTP provider exposing raw payloads.
+// It handles "httpraw://host/path" by fetching "http://host/path".
+type httpRawProvider struct{}
+
+func (p *httpRawProvider) Name() string   { return "HTTP Raw Test Provider" }
+func (p *httpRawProvider) Scheme() string { return "httpraw" }
+
+func (p *httpRawProvider) Validate(configURL string) error { return nil }
+
+func (p *httpRawProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	data, contentType, err := p.LoadRaw(ctx, configURL)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(data, formatFromContentType(contentType))
+}
+
+func (p *httpRawProvider) LoadRaw(ctx context.Context, configURL string) ([]byte, string, error) {
+	target := "http://" + strings.TrimPrefix(configURL, "httpraw://")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, resp.Header.Get("Content-Type"), nil
+}
+
+func (p *httpRawProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *httpRawProvider) HealthCheck(ctx context.Context, configURL string) error { return nil }
+
+func registerHTTPRawProvider(t *testing.T) {
+	t.Helper()
+	if _, err := GetRemoteProvider("httpraw"); err == nil {
+		return
+	}
+	if err := RegisterRemoteProvider(&httpRawProvider{}); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+}
+
+func TestRemoteConfig_FormatFromContentType(t *testing.T) {
+	registerHTTPRawProvider(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+		_, _ = w.Write([]byte("service:\n  name: billing\n  port: 8080\ndebug: true\n"))
+	}))
+	defer server.Close()
+
+	configURL := "httpraw://" + strings.TrimPrefix(server.URL, "http://") + "/api/config"
+	config, err := LoadRemoteConfig(configURL)
+	if err != nil {
+		t.Fatalf("Failed to load remote config: %v", err)
+	}
+
+	service, ok := config["service"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected nested service map, got %#v", config["service"])
+	}
+	if service["name"] != "billing" {
+		t.Errorf("Expected service.name=billing, got %v", service["name"])
+	}
+	if config["debug"] != true {
+		t.Errorf("Expected debug=true, got %v", config["debug"])
+	}
+}
+
+func TestRemoteConfig_FormatOverride(t *testing.T) {
+	registerHTTPRawProvider(t)
+
+	// Server mislabels TOML as plain text; the override must win
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("name = \"billing\"\nport = 8080\n"))
+	}))
+	defer server.Close()
+
+	opts := DefaultRemoteConfigOptions()
+	opts.Format = "toml"
+	opts.RetryAttempts = 0
+
+	configURL := "httpraw://" + strings.TrimPrefix(server.URL, "http://") + "/config"
+	config, err := LoadRemoteConfig(configURL, opts)
+	if err != nil {
+		t.Fatalf("Failed to load remote config: %v", err)
+	}
+	if config["name"] != "billing" {
+		t.Errorf("Expected name=billing, got %v", config["name"])
+	}
+
+	opts.Format = "xml"
+	if _, err := LoadRemoteConfig(configURL, opts); err == nil {
+		t.Error("Expected error for unsupported format override")
+	}
+}
+
+func TestNegotiateRemoteFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		contentType string
+		data        string
+		override    string
+		want        ConfigFormat
+	}{
+		{"override wins", "x://h/c.json", "application/json", "a: 1", "yaml", FormatYAML},
+		{"content type", "x://h/c", "application/x-yaml", "", "", FormatYAML},
+		{"structured suffix", "x://h/c", "application/vnd.api+json", "", "", FormatJSON},
+		{"url extension", "x://h/app.toml", "text/plain", "", "", FormatTOML},
+		{"sniff json", "x://h/c", "", `{"a": 1}`, "", FormatJSON},
+		{"sniff yaml", "x://h/c", "", "# comment\na: 1\n", "", FormatYAML},
+		{"sniff toml section", "x://h/c", "", "[server]\nport = 1\n", "", FormatTOML},
+		{"sniff toml pair", "x://h/c", "", "url = \"http://x\"\n", "", FormatTOML},
+		{"sniff toml typed", "x://h/c", "", "# app\nname = 'api'\nport = 8080\nratio = 0.5\ntags = [\"a\", \"b\"]\nenabled = true\n", "", FormatTOML},
+		{"json array", "x://h/c", "", "[1, 2, 3]", "", FormatUnknown},
+		{"json array of objects", "x://h/c", "", "[\n  {\"a\": 1}\n]", "", FormatUnknown},
+		{"properties", "x://h/c", "", "db.host=localhost\ndb.port=5432\n", "", FormatUnknown},
+		{"ini", "x://h/c", "", "[server]\nhost = localhost\n", "", FormatUnknown},
+		{"unknown", "x://h/c", "", "plain words", "", FormatUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := negotiateRemoteFormat(tt.url, tt.contentType, []byte(tt.data), tt.override)
+			if got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// remote_config_health.go: Aggregated health checks of remote providers
+//
+// A readiness endpoint should reflect whether the configuration sources a
+// process depends on are reachable. Providers know how to check a URL but not
+// which URL matters, so each scheme's check URL is registered once with
+// SetRemoteHealthCheckURL, and HealthCheckAllProviders checks them all
+// concurrently under one deadline.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/agilira/go-errors"
+)
+
+// Health check URLs by provider scheme
+var (
+	healthCheckURLs  = make(map[string]string)
+	healthCheckMutex sync.RWMutex
+)
+
+// SetRemoteHealthCheckURL sets the URL HealthCheckAllProviders checks for
+// the provider of its scheme, replacing any previous URL for that scheme.
+// The provider must be registered and accept the URL.
+func SetRemoteHealthCheckURL(configURL string) error {
+	provider, err := validateAndGetProvider(configURL)
+	if err != nil {
+		return err
+	}
+
+	healthCheckMutex.Lock()
+	defer healthCheckMutex.Unlock()
+	healthCheckURLs[provider.Scheme()] = configURL
+	return nil
+}
+
+// HealthCheckAllProviders runs HealthCheck concurrently on every registered
+// provider with a URL set by SetRemoteHealthCheckURL and returns the result
+// by scheme: nil when healthy. Providers without a URL are not included.
+// All checks share the deadline of ctx, or the default remote timeout when
+// ctx has none; checks still running at the deadline report a timeout.
+//
+// Example:
+//
+//	_ = argus.SetRemoteHealthCheckURL("consul://consul:8500/config/myapp")
+//	http.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+//	    for scheme, err := range argus.HealthCheckAllProviders(r.Context()) {
+//	        if err != nil {
+//	            http.Error(w, scheme+": "+err.Error(), http.StatusServiceUnavailable)
+//	            return
+//	        }
+//	    }
+//	    w.WriteHeader(http.StatusOK)
+//	})
+func HealthCheckAllProviders(ctx context.Context) map[string]error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultRemoteConfigOptions().Timeout)
+		defer cancel()
+	}
+
+	type target struct {
+		provider  RemoteConfigProvider
+		configURL string
+	}
+	var targets []target
+	healthCheckMutex.RLock()
+	for _, provider := range ListRemoteProviders() {
+		if configURL, ok := healthCheckURLs[provider.Scheme()]; ok {
+			targets = append(targets, target{provider, configURL})
+		}
+	}
+	healthCheckMutex.RUnlock()
+
+	type result struct {
+		scheme string
+		err    error
+	}
+	// Buffered so checks outliving the deadline do not block forever
+	results := make(chan result, len(targets))
+	for _, t := range targets {
+		go func() {
+			results <- result{t.provider.Scheme(), t.provider.HealthCheck(ctx, t.configURL)}
+		}()
+	}
+
+	status := make(map[string]error, len(targets))
+	for range targets {
+		select {
+		case r := <-results:
+			status[r.scheme] = r.err
+		case <-ctx.Done():
+			for _, t := range targets {
+				if _, done := status[t.provider.Scheme()]; !done {
+					status[t.provider.Scheme()] = errors.Wrap(ctx.Err(), ErrCodeRemoteConfigError, "health check did not complete").
+						WithContext("url", t.configURL)
+				}
+			}
+			return status
+		}
+	}
+	return status
+}
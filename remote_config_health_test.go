@@ -0,0 +1,112 @@
+// remote_config_health_test.go: Tests for aggregated provider health checks
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// healthCheckProvider answers health checks with a fixed behavior
+type healthCheckProvider struct {
+	scheme string
+	check  func(ctx context.Context) error
+}
+
+func (p *healthCheckProvider) Name() string                    { return "Health Check Test Provider" }
+func (p *healthCheckProvider) Scheme() string                  { return p.scheme }
+func (p *healthCheckProvider) Validate(configURL string) error { return nil }
+
+func (p *healthCheckProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
+}
+
+func (p *healthCheckProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *healthCheckProvider) HealthCheck(ctx context.Context, configURL string) error {
+	return p.check(ctx)
+}
+
+// registerHealthCheckProvider registers (or reuses, under -count=N) a
+// provider for scheme and sets its health check URL for the test
+func registerHealthCheckProvider(t *testing.T, scheme string, check func(ctx context.Context) error) {
+	t.Helper()
+	if registered, err := GetRemoteProvider(scheme); err == nil {
+		registered.(*healthCheckProvider).check = check
+	} else if err := RegisterRemoteProvider(&healthCheckProvider{scheme: scheme, check: check}); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+
+	if err := SetRemoteHealthCheckURL(scheme + "://host/config"); err != nil {
+		t.Fatalf("Failed to set health check URL: %v", err)
+	}
+	t.Cleanup(func() {
+		healthCheckMutex.Lock()
+		delete(healthCheckURLs, scheme)
+		healthCheckMutex.Unlock()
+	})
+}
+
+func TestHealthCheckAllProviders(t *testing.T) {
+	registerHealthCheckProvider(t, "healthok", func(ctx context.Context) error { return nil })
+	registerHealthCheckProvider(t, "healthdown", func(ctx context.Context) error {
+		return fmt.Errorf("connection refused")
+	})
+
+	status := HealthCheckAllProviders(context.Background())
+	if len(status) != 2 {
+		t.Fatalf("Expected status for the 2 providers with URLs, got %v", status)
+	}
+	if err, ok := status["healthok"]; !ok || err != nil {
+		t.Errorf("Expected healthok to be healthy, got %v", err)
+	}
+	if status["healthdown"] == nil {
+		t.Error("Expected healthdown to report its error")
+	}
+}
+
+func TestHealthCheckAllProviders_SharedDeadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	registerHealthCheckProvider(t, "healthok", func(ctx context.Context) error { return nil })
+	// Ignores its context, so only the shared deadline ends the wait
+	registerHealthCheckProvider(t, "healthhung", func(ctx context.Context) error {
+		<-release
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	status := HealthCheckAllProviders(ctx)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the checks to end at the deadline, took %v", elapsed)
+	}
+	if status["healthok"] != nil {
+		t.Errorf("Expected healthok to be healthy, got %v", status["healthok"])
+	}
+	if !errors.HasCode(status["healthhung"], ErrCodeRemoteConfigError) {
+		t.Errorf("Expected a timeout for healthhung, got %v", status["healthhung"])
+	}
+}
+
+func TestSetRemoteHealthCheckURL_Invalid(t *testing.T) {
+	if err := SetRemoteHealthCheckURL("unknownscheme://host/config"); err == nil {
+		t.Error("Expected an unregistered scheme to be rejected")
+	}
+	if err := SetRemoteHealthCheckURL(""); err == nil {
+		t.Error("Expected an empty URL to be rejected")
+	}
+}
@@ -0,0 +1,109 @@
+// remote_config_merge.go: Merge strategies for remote and local configuration
+//
+// A deployment often keeps host-specific settings in the local FallbackPath
+// file while the shared configuration lives in a remote store. MergeStrategy
+// combines the two on every sync instead of using the local file only when
+// all remote sources are down, and decides which side wins on conflicts.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// MergeStrategy selects how a remote configuration is combined with the
+// local RemoteConfig.FallbackPath file when both are available
+type MergeStrategy int
+
+const (
+	// MergeNone uses the remote configuration as is; FallbackPath is only
+	// loaded when every remote source fails (default)
+	MergeNone MergeStrategy = iota
+
+	// MergeRemoteWins merges top-level keys: keys only in the local file are
+	// kept, and the remote value wins for keys present in both
+	MergeRemoteWins
+
+	// MergeLocalWins merges top-level keys: keys only in the remote
+	// configuration are kept, and the local value wins for keys present in both
+	MergeLocalWins
+
+	// MergeDeep merges nested maps key by key at every level, with the remote
+	// value winning for leaves present in both
+	MergeDeep
+)
+
+// String returns the name of the merge strategy
+func (s MergeStrategy) String() string {
+	switch s {
+	case MergeNone:
+		return "none"
+	case MergeRemoteWins:
+		return "remote_wins"
+	case MergeLocalWins:
+		return "local_wins"
+	case MergeDeep:
+		return "deep"
+	default:
+		return "unknown"
+	}
+}
+
+// mergeRemoteConfig combines remote and local configurations with strategy.
+// The inputs are not modified.
+func mergeRemoteConfig(strategy MergeStrategy, remote, local map[string]interface{}) map[string]interface{} {
+	switch strategy {
+	case MergeRemoteWins:
+		return mergeTopLevel(local, remote)
+	case MergeLocalWins:
+		return mergeTopLevel(remote, local)
+	case MergeDeep:
+		return mergeProfileMaps(local, remote)
+	default:
+		return remote
+	}
+}
+
+// mergeTopLevel returns a copy of base with the keys of overlay replacing
+// or adding entries; nested values are shared, not merged
+func mergeTopLevel(base, overlay map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base)+len(overlay))
+	for k, v := range base {
+		result[k] = v
+	}
+	for k, v := range overlay {
+		result[k] = v
+	}
+	return result
+}
+
+// mergeLocal applies the merge strategy to a configuration loaded from a
+// remote source. A missing or unparsable local file leaves the remote
+// configuration unchanged. Caller must hold syncMutex.
+func (r *RemoteConfigManager) mergeLocal(remote map[string]interface{}) map[string]interface{} {
+	r.merged = false
+	if r.config.MergeStrategy == MergeNone || r.config.FallbackPath == "" {
+		return remote
+	}
+
+	local, err := r.loadLocalFallback()
+	if err != nil {
+		r.watcher.auditLogger.Log(AuditWarn, "remote_config", "local_merge_skipped", r.config.FallbackPath, nil, nil, map[string]interface{}{"error": err.Error(), "merge_strategy": r.config.MergeStrategy.String()})
+		return remote
+	}
+	r.merged = true
+	return mergeRemoteConfig(r.config.MergeStrategy, remote, local)
+}
+
+// syncContext returns the audit context of a successful load: the merge
+// strategy applied, when the local file was merged in (caller must hold
+// syncMutex)
+func (r *RemoteConfigManager) syncContext() map[string]interface{} {
+	if !r.merged {
+		return nil
+	}
+	return map[string]interface{}{
+		"merge_strategy": r.config.MergeStrategy.String(),
+		"local_path":     r.config.FallbackPath,
+	}
+}
@@ -0,0 +1,140 @@
+// remote_config_merge_test.go: Tests for remote and local merge strategies
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergeRemoteConfig(t *testing.T) {
+	remote := map[string]interface{}{
+		"timeout": 30,
+		"db":      map[string]interface{}{"host": "db.remote", "pool": 10},
+	}
+	local := map[string]interface{}{
+		"timeout": 5,
+		"region":  "eu-west",
+		"db":      map[string]interface{}{"host": "localhost", "user": "app"},
+	}
+
+	tests := []struct {
+		strategy MergeStrategy
+		want     map[string]interface{}
+	}{
+		{MergeNone, remote},
+		{MergeRemoteWins, map[string]interface{}{
+			"timeout": 30, "region": "eu-west",
+			"db": map[string]interface{}{"host": "db.remote", "pool": 10},
+		}},
+		{MergeLocalWins, map[string]interface{}{
+			"timeout": 5, "region": "eu-west",
+			"db": map[string]interface{}{"host": "localhost", "user": "app"},
+		}},
+		{MergeDeep, map[string]interface{}{
+			"timeout": 30, "region": "eu-west",
+			"db": map[string]interface{}{"host": "db.remote", "pool": 10, "user": "app"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.strategy.String(), func(t *testing.T) {
+			if got := mergeRemoteConfig(tt.strategy, remote, local); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// Inputs are left untouched
+	if len(remote) != 2 || len(remote["db"].(map[string]interface{})) != 2 {
+		t.Errorf("Expected remote configuration to be unchanged, got %v", remote)
+	}
+}
+
+func TestRemoteConfigManager_MergeStrategy(t *testing.T) {
+	dir := t.TempDir()
+	localPath := filepath.Join(dir, "local.json")
+	if err := os.WriteFile(localPath, []byte(`{"region": "eu-west", "db": {"user": "app"}}`), 0644); err != nil {
+		t.Fatalf("Failed to write local file: %v", err)
+	}
+	auditPath := filepath.Join(dir, "audit.jsonl")
+	registerSwitchableProvider(t, map[string]interface{}{
+		"db": map[string]interface{}{"host": "db.remote"},
+	})
+
+	watcher := New(Config{Audit: AuditConfig{
+		Enabled:       true,
+		OutputFile:    auditPath,
+		MinLevel:      AuditInfo,
+		BufferSize:    100,
+		FlushInterval: time.Hour,
+	}})
+
+	manager, err := NewRemoteConfigManager(&RemoteConfig{
+		Enabled:       true,
+		PrimaryURL:    "switchtest://primary/config",
+		FallbackPath:  localPath,
+		SyncInterval:  time.Minute,
+		MergeStrategy: MergeDeep,
+	}, watcher)
+	if err != nil {
+		t.Fatalf("Failed to create RemoteConfigManager: %v", err)
+	}
+	if err := manager.Start(); err != nil {
+		t.Fatalf("Failed to start RemoteConfigManager: %v", err)
+	}
+	manager.Stop()
+
+	config, _, err := manager.GetCurrentConfig()
+	if err != nil {
+		t.Fatalf("Failed to get configuration: %v", err)
+	}
+	want := map[string]interface{}{
+		"region": "eu-west",
+		"db":     map[string]interface{}{"host": "db.remote", "user": "app"},
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("Expected deep-merged configuration %v, got %v", want, config)
+	}
+
+	if err := watcher.auditLogger.Close(); err != nil {
+		t.Fatalf("Failed to close audit logger: %v", err)
+	}
+	events, err := ReadAuditLog(auditPath)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	found := false
+	for _, event := range events {
+		if event.Component == "initial_load_success" && event.Context["merge_strategy"] == "deep" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected the merge strategy in the initial_load_success audit event")
+	}
+}
+
+func TestRemoteConfig_InvalidMergeStrategy(t *testing.T) {
+	watcher := New(Config{})
+	defer func() { _ = watcher.Close() }()
+
+	if _, err := NewRemoteConfigManager(&RemoteConfig{
+		Enabled:       true,
+		PrimaryURL:    "switchtest://primary/config",
+		MergeStrategy: MergeStrategy(99),
+	}, watcher); err == nil {
+		t.Error("Expected an unknown merge strategy to be rejected")
+	}
+
+	config := (&Config{Remote: RemoteConfig{MergeStrategy: MergeStrategy(99)}}).WithDefaults()
+	if err := config.Validate(); err != ErrInvalidMergeStrategy {
+		t.Errorf("Expected ErrInvalidMergeStrategy, got %v", err)
+	}
+}
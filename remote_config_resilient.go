@@ -0,0 +1,94 @@
+// remote_config_resilient.go: Watches that survive dropped provider streams
+//
+// A provider's Watch channel closes when its connection drops, which ends a
+// consumer's range loop with no indication of why. WatchRemoteConfigResilient
+// keeps the consumer's channel open instead: the last configuration delivered
+// stays current while the watch is re-established with the retry backoff of
+// RemoteConfigOptions, and only changed configurations are delivered after a
+// reconnect. WatchRemoteConfig keeps its semantics.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"time"
+)
+
+// resilientWatchMinDelay keeps reconnects from spinning when the retry
+// options allow no delay
+const resilientWatchMinDelay = 10 * time.Millisecond
+
+// WatchRemoteConfigResilient watches a remote configuration source like
+// WatchRemoteConfigWithContext, but the returned channel closes only when ctx
+// is done. When the provider's watch ends or cannot be re-opened, it is
+// re-established with exponential backoff (RetryDelay, MaxBackoff,
+// Multiplier, Jitter) for as long as ctx lives, and the consumer keeps the
+// last configuration received. Configurations equal to the last one
+// delivered, typically the first one after a reconnect, are not re-sent.
+//
+// Only the initial watch setup can fail; later failures are retried.
+//
+// Example:
+//
+//	configs, err := argus.WatchRemoteConfigResilient(ctx, "etcd://etcd:2379/config/myapp")
+//	if err != nil {
+//	    return err
+//	}
+//	for config := range configs { // Ends only when ctx is canceled
+//	    applyConfig(config)
+//	}
+func WatchRemoteConfigResilient(ctx context.Context, configURL string, opts ...*RemoteConfigOptions) (<-chan map[string]interface{}, error) {
+	provider, options, err := setupRemoteConfig(configURL, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRemoteOptions(ctx, options)
+	upstream, err := startWatching(ctx, provider, configURL, options)
+	if err != nil {
+		return nil, err
+	}
+
+	configChan := make(chan map[string]interface{}, 1)
+	go func() {
+		defer close(configChan)
+		resilientWatch(ctx, provider, configURL, options, upstream, configChan)
+	}()
+	return configChan, nil
+}
+
+// resilientWatch forwards changed configurations from upstream to
+// configChan and re-opens upstream whenever it closes before ctx is done.
+// The backoff resets only once a re-opened watch delivers a configuration,
+// so a stream that keeps closing right away is retried ever more slowly.
+func resilientWatch(ctx context.Context, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions, upstream <-chan map[string]interface{}, configChan chan<- map[string]interface{}) {
+	var last map[string]interface{}
+	attempt := 0
+	for {
+		for config := range upstream {
+			attempt = 0
+			if last != nil && configEquals(last, config) {
+				continue
+			}
+			last = config
+			select {
+			case configChan <- config:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for upstream = nil; upstream == nil; {
+			attempt++
+			delay := max(options.retryBackoff(attempt), resilientWatchMinDelay)
+			if err := waitForRetry(ctx, delay); err != nil {
+				return
+			}
+			upstream, _ = startWatching(ctx, provider, configURL, options)
+		}
+	}
+}
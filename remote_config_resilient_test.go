@@ -0,0 +1,127 @@
+// remote_config_resilient_test.go: Tests for resilient remote watches
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// droppingWatchProvider serves each Watch call from a script: a list of
+// configurations sent before the stream drops, or an error
+type droppingWatchProvider struct {
+	mu      sync.Mutex
+	streams [][]map[string]interface{}
+	calls   int
+}
+
+func (p *droppingWatchProvider) Name() string                    { return "Dropping Watch Provider" }
+func (p *droppingWatchProvider) Scheme() string                  { return "resilienttest" }
+func (p *droppingWatchProvider) Validate(configURL string) error { return nil }
+
+func (p *droppingWatchProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("load not supported")
+}
+
+func (p *droppingWatchProvider) HealthCheck(ctx context.Context, configURL string) error {
+	return nil
+}
+
+func (p *droppingWatchProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	call := p.calls
+	p.calls++
+
+	if call >= len(p.streams) {
+		// Script exhausted: a healthy stream that stays open
+		ch := make(chan map[string]interface{})
+		go func() {
+			<-ctx.Done()
+			close(ch)
+		}()
+		return ch, nil
+	}
+	if p.streams[call] == nil {
+		return nil, fmt.Errorf("connection refused")
+	}
+
+	ch := make(chan map[string]interface{}, len(p.streams[call]))
+	for _, config := range p.streams[call] {
+		ch <- config
+	}
+	close(ch) // Network drop
+	return ch, nil
+}
+
+func TestWatchRemoteConfigResilient_Reconnects(t *testing.T) {
+	provider := &droppingWatchProvider{}
+	if registered, err := GetRemoteProvider("resilienttest"); err == nil {
+		provider = registered.(*droppingWatchProvider)
+	} else if err := RegisterRemoteProvider(provider); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+	provider.mu.Lock()
+	provider.calls = 0
+	provider.streams = [][]map[string]interface{}{
+		{{"version": "v1"}},
+		nil, // Re-opening fails once
+		{{"version": "v1"}, {"version": "v2"}},
+	}
+	provider.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := DefaultRemoteConfigOptions()
+	opts.InitialBackoff = 5 * time.Millisecond
+	opts.MaxBackoff = 20 * time.Millisecond
+	configs, err := WatchRemoteConfigResilient(ctx, "resilienttest://host/config", opts)
+	if err != nil {
+		t.Fatalf("Failed to start watching: %v", err)
+	}
+
+	for _, want := range []string{"v1", "v2"} {
+		select {
+		case config, ok := <-configs:
+			if !ok {
+				t.Fatalf("Expected the channel to stay open, closed before %s", want)
+			}
+			if config["version"] != want {
+				t.Fatalf("Expected version %s, got %v", want, config["version"])
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timed out waiting for version %s", want)
+		}
+	}
+
+	// The repeated v1 after the reconnect is not re-sent, and the channel
+	// stays open on the healthy stream
+	select {
+	case config, ok := <-configs:
+		t.Fatalf("Expected no further delivery, got %v (open: %v)", config, ok)
+	case <-time.After(100 * time.Millisecond):
+	}
+	provider.mu.Lock()
+	if provider.calls != 4 {
+		t.Errorf("Expected 4 Watch calls, got %d", provider.calls)
+	}
+	provider.mu.Unlock()
+
+	cancel()
+	select {
+	case _, ok := <-configs:
+		if ok {
+			t.Error("Expected the channel to close after cancel")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for the channel to close")
+	}
+}
@@ -0,0 +1,169 @@
+// remote_config_revision.go: Incremental (revision-based) remote watching
+//
+// Stores such as etcd and Consul version every write and can stream only the
+// changes made after a given revision. For large configurations that change a
+// few keys at a time this avoids re-downloading and re-parsing the whole
+// document on every update.
+//
+// CAPABILITY DETECTION:
+// The RemoteConfigProvider interface is intentionally small and stable. Extra
+// capabilities are expressed as separate optional interfaces that a provider
+// may implement; Argus discovers them at runtime with a type assertion:
+//
+//	if rw, ok := provider.(RemoteConfigRevisionWatcher); ok {
+//	    // incremental watching available
+//	}
+//
+// Existing providers keep compiling and working unchanged, and new providers
+// opt in by adding a method. RemoteConfigRawLoader follows the same pattern.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// RemoteConfigChange describes the configuration changes up to Revision.
+// A change either carries a full Snapshot (which replaces the current state)
+// or a delta made of Set (top-level keys created or updated) and Deleted
+// (top-level keys removed).
+type RemoteConfigChange struct {
+	// Revision is the store revision this change brings the configuration to
+	Revision uint64
+
+	// Snapshot, when non-nil, is the complete configuration at Revision
+	Snapshot map[string]interface{}
+
+	// Set holds top-level keys created or updated since the previous revision
+	Set map[string]interface{}
+
+	// Deleted lists top-level keys removed since the previous revision
+	Deleted []string
+}
+
+// RemoteConfigRevisionWatcher is an optional capability for providers able to
+// stream changes since a known revision (e.g. etcd mod revisions, Consul
+// modify indexes). When implemented, WatchRemoteConfig prefers it over Watch
+// and Load-based polling.
+//
+// A revision of 0 means "no known state": the provider must send a change with
+// a full Snapshot first. When the returned channel closes while the watch
+// context is still active, Argus resumes from the last applied revision.
+// Returning a nil channel and nil error declines incremental watching for the
+// URL, and Argus falls back to Watch or polling.
+type RemoteConfigRevisionWatcher interface {
+	WatchSince(ctx context.Context, configURL string, revision uint64) (<-chan RemoteConfigChange, error)
+}
+
+// startRevisionWatch starts incremental watching. It returns a nil channel
+// when the provider declines, so the caller can fall back to full reloads.
+func startRevisionWatch(ctx context.Context, watcher RemoteConfigRevisionWatcher, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions) (<-chan map[string]interface{}, error) {
+	changes, err := watcher.WatchSince(ctx, configURL, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeRemoteConfigError, "failed to start incremental remote watch")
+	}
+	if changes == nil {
+		return nil, nil
+	}
+
+	configChan := make(chan map[string]interface{}, 1)
+	go func() {
+		defer close(configChan)
+		followRevisions(ctx, watcher, provider, configURL, options, changes, configChan)
+	}()
+
+	return configChan, nil
+}
+
+// followRevisions applies incoming changes and emits the resulting full
+// configuration. When the change stream ends it resumes from the last
+// revision; if resuming fails it degrades to Load-based polling.
+func followRevisions(ctx context.Context, watcher RemoteConfigRevisionWatcher, provider RemoteConfigProvider, configURL string, options *RemoteConfigOptions, changes <-chan RemoteConfigChange, configChan chan<- map[string]interface{}) {
+	var (
+		current  map[string]interface{}
+		revision uint64
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change, ok := <-changes:
+			if !ok {
+				changes = resumeRevisionWatch(ctx, watcher, configURL, revision, options)
+				if changes == nil {
+					if ctx.Err() == nil {
+						pollForChanges(ctx, provider, configURL, options, configChan)
+					}
+					return
+				}
+				continue
+			}
+
+			if change.Revision != 0 && change.Revision <= revision {
+				continue // Stale or duplicate delivery
+			}
+
+			current = applyRemoteConfigChange(current, change)
+			if change.Revision != 0 {
+				revision = change.Revision
+			}
+
+			select {
+			case configChan <- copyMap(current):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// resumeRevisionWatch re-opens the change stream from revision, honoring the
+// retry policy. The retry backoff is applied before every attempt, including
+// the first, so a provider whose stream closes immediately cannot cause a
+// busy loop. Returns nil when the stream cannot be re-established.
+func resumeRevisionWatch(ctx context.Context, watcher RemoteConfigRevisionWatcher, configURL string, revision uint64, options *RemoteConfigOptions) <-chan RemoteConfigChange {
+	for attempt := 0; attempt <= options.RetryAttempts; attempt++ {
+		select {
+		case <-time.After(options.retryBackoff(attempt + 1)):
+		case <-ctx.Done():
+			return nil
+		}
+
+		changes, err := watcher.WatchSince(ctx, configURL, revision)
+		if err == nil && changes != nil {
+			return changes
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// applyRemoteConfigChange returns the configuration resulting from applying
+// change to current. current is never modified.
+func applyRemoteConfigChange(current map[string]interface{}, change RemoteConfigChange) map[string]interface{} {
+	if change.Snapshot != nil {
+		return copyMap(change.Snapshot)
+	}
+
+	next := make(map[string]interface{}, len(current)+len(change.Set))
+	for k, v := range current {
+		next[k] = v
+	}
+	for k, v := range change.Set {
+		next[k] = v
+	}
+	for _, k := range change.Deleted {
+		delete(next, k)
+	}
+	return next
+}
@@ -0,0 +1,178 @@
+// remote_config_revision_test.go: Testing incremental (revision-based) remote watching
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// revisionMockProvider exposes WatchSince and counts full loads
+type revisionMockProvider struct {
+	mu        sync.Mutex
+	requested []uint64
+	loads     atomic.Int32
+}
+
+func (p *revisionMockProvider) Name() string                    { return "Revision Mock Provider" }
+func (p *revisionMockProvider) Scheme() string                  { return "revtest" }
+func (p *revisionMockProvider) Validate(configURL string) error { return nil }
+
+func (p *revisionMockProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	p.loads.Add(1)
+	return map[string]interface{}{"source": "load"}, nil
+}
+
+func (p *revisionMockProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *revisionMockProvider) HealthCheck(ctx context.Context, configURL string) error { return nil }
+
+func (p *revisionMockProvider) WatchSince(ctx context.Context, configURL string, revision uint64) (<-chan RemoteConfigChange, error) {
+	p.mu.Lock()
+	p.requested = append(p.requested, revision)
+	p.mu.Unlock()
+
+	ch := make(chan RemoteConfigChange, 3)
+	switch revision {
+	case 0:
+		ch <- RemoteConfigChange{Revision: 1, Snapshot: map[string]interface{}{"a": 1, "b": 2}}
+		ch <- RemoteConfigChange{Revision: 2, Set: map[string]interface{}{"b": 20, "c": 3}, Deleted: []string{"a"}}
+		close(ch) // Simulate a dropped stream: Argus must resume from revision 2
+	default:
+		ch <- RemoteConfigChange{Revision: revision, Set: map[string]interface{}{"stale": true}}
+		ch <- RemoteConfigChange{Revision: revision + 1, Set: map[string]interface{}{"d": 4}}
+	}
+	return ch, nil
+}
+
+// registerRevisionMockProvider returns the registered mock with its state
+// reset; the registry is global, so repeated runs (-count=N) reuse it
+func registerRevisionMockProvider(t *testing.T) *revisionMockProvider {
+	t.Helper()
+	if registered, err := GetRemoteProvider("revtest"); err == nil {
+		provider := registered.(*revisionMockProvider)
+		provider.mu.Lock()
+		provider.requested = nil
+		provider.mu.Unlock()
+		provider.loads.Store(0)
+		return provider
+	}
+
+	provider := &revisionMockProvider{}
+	if err := RegisterRemoteProvider(provider); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+	return provider
+}
+
+func TestRemoteConfig_WatchSince(t *testing.T) {
+	provider := registerRevisionMockProvider(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := DefaultRemoteConfigOptions()
+	opts.RetryDelay = 10 * time.Millisecond
+	configChan, err := WatchRemoteConfigWithContext(ctx, "revtest://localhost/app", opts)
+	if err != nil {
+		t.Fatalf("Failed to start watching: %v", err)
+	}
+
+	var configs []map[string]interface{}
+	for len(configs) < 3 {
+		select {
+		case cfg, ok := <-configChan:
+			if !ok {
+				t.Fatalf("Watch channel closed early after %d configs", len(configs))
+			}
+			configs = append(configs, cfg)
+		case <-ctx.Done():
+			t.Fatalf("Timed out after %d configs", len(configs))
+		}
+	}
+
+	if configs[0]["a"] != 1 || configs[0]["b"] != 2 {
+		t.Errorf("Expected initial snapshot {a:1 b:2}, got %v", configs[0])
+	}
+	if _, ok := configs[1]["a"]; ok || configs[1]["b"] != 20 || configs[1]["c"] != 3 {
+		t.Errorf("Expected delta applied {b:20 c:3}, got %v", configs[1])
+	}
+	if _, ok := configs[2]["stale"]; ok {
+		t.Errorf("Expected stale revision to be skipped, got %v", configs[2])
+	}
+	if configs[2]["d"] != 4 || configs[2]["b"] != 20 {
+		t.Errorf("Expected resumed delta {b:20 c:3 d:4}, got %v", configs[2])
+	}
+
+	provider.mu.Lock()
+	requested := append([]uint64(nil), provider.requested...)
+	provider.mu.Unlock()
+	if len(requested) != 2 || requested[0] != 0 || requested[1] != 2 {
+		t.Errorf("Expected WatchSince revisions [0 2], got %v", requested)
+	}
+	if loads := provider.loads.Load(); loads != 0 {
+		t.Errorf("Expected no full loads with incremental watching, got %d", loads)
+	}
+}
+
+// closingRevisionWatcher returns streams that close immediately
+type closingRevisionWatcher struct {
+	calls atomic.Int32
+}
+
+func (w *closingRevisionWatcher) WatchSince(ctx context.Context, configURL string, revision uint64) (<-chan RemoteConfigChange, error) {
+	w.calls.Add(1)
+	ch := make(chan RemoteConfigChange)
+	close(ch)
+	return ch, nil
+}
+
+func TestResumeRevisionWatch_AppliesRetryDelay(t *testing.T) {
+	watcher := &closingRevisionWatcher{}
+	opts := &RemoteConfigOptions{RetryAttempts: 3, RetryDelay: 20 * time.Millisecond}
+
+	start := time.Now()
+	if changes := resumeRevisionWatch(context.Background(), watcher, "x://h/c", 1, opts); changes == nil {
+		t.Fatal("Expected a re-opened stream")
+	}
+	if elapsed := time.Since(start); elapsed < opts.RetryDelay {
+		t.Errorf("Expected RetryDelay before re-opening, re-opened after %v", elapsed)
+	}
+
+	// A cancelled context stops retrying without calling the provider
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	before := watcher.calls.Load()
+	if changes := resumeRevisionWatch(ctx, watcher, "x://h/c", 1, opts); changes != nil {
+		t.Error("Expected nil stream for cancelled context")
+	}
+	if watcher.calls.Load() != before {
+		t.Error("Expected no WatchSince calls after cancellation")
+	}
+}
+
+func TestApplyRemoteConfigChange(t *testing.T) {
+	current := map[string]interface{}{"a": 1}
+	next := applyRemoteConfigChange(current, RemoteConfigChange{Set: map[string]interface{}{"b": 2}, Deleted: []string{"a"}})
+
+	if _, ok := current["b"]; ok {
+		t.Error("Expected current configuration to be left untouched")
+	}
+	if _, ok := next["a"]; ok || next["b"] != 2 {
+		t.Errorf("Expected {b:2}, got %v", next)
+	}
+
+	snapshot := applyRemoteConfigChange(next, RemoteConfigChange{Snapshot: map[string]interface{}{"z": 0}})
+	if len(snapshot) != 1 || snapshot["z"] != 0 {
+		t.Errorf("Expected snapshot to replace state, got %v", snapshot)
+	}
+}
@@ -0,0 +1,71 @@
+// remote_config_store.go: Write-back of configuration to remote sources
+//
+// Tools that compute configuration at runtime, or edit it on behalf of an
+// operator, need to persist the result to the source it was loaded from.
+// Writing is an optional capability: providers opt in by implementing
+// RemoteConfigStorer, discovered with a type assertion like
+// RemoteConfigRawLoader, so read-only providers keep working unchanged.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/agilira/go-errors"
+)
+
+// RemoteConfigStorer is an optional capability for providers able to
+// persist configuration back to the remote source (etcd, Consul, Redis,
+// file stores). Store replaces the configuration at configURL, so a later
+// Load of the same URL returns config.
+type RemoteConfigStorer interface {
+	Store(ctx context.Context, configURL string, config map[string]interface{}) error
+}
+
+// StoreRemoteConfig writes configuration to a remote source using default
+// context. The provider is selected by URL scheme like LoadRemoteConfig; a
+// provider that does not implement RemoteConfigStorer fails with
+// ErrCodeRemoteStoreUnsupported. Stores are not retried, so a failed write
+// is reported to the caller rather than repeated behind its back.
+//
+// Example:
+//
+//	config["feature_flags"] = flags
+//	err := argus.StoreRemoteConfig("consul://localhost:8500/config/myapp", config)
+func StoreRemoteConfig(configURL string, config map[string]interface{}, opts ...*RemoteConfigOptions) error {
+	return StoreRemoteConfigWithContext(context.Background(), configURL, config, opts...)
+}
+
+// StoreRemoteConfigWithContext writes configuration to a remote source,
+// bounded by ctx and RemoteConfigOptions.Timeout
+func StoreRemoteConfigWithContext(ctx context.Context, configURL string, config map[string]interface{}, opts ...*RemoteConfigOptions) error {
+	if config == nil {
+		return errors.New(ErrCodeInvalidConfig, "remote config to store cannot be nil")
+	}
+
+	provider, options, err := setupRemoteConfig(configURL, opts...)
+	if err != nil {
+		return err
+	}
+
+	storer, ok := provider.(RemoteConfigStorer)
+	if !ok {
+		return errors.New(ErrCodeRemoteStoreUnsupported,
+			fmt.Sprintf("remote provider '%s' does not support storing configuration", provider.Name())).
+			WithContext("scheme", provider.Scheme())
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(withRemoteOptions(ctx, options), options.Timeout)
+	defer cancel()
+
+	if err := storer.Store(ctxWithTimeout, configURL, config); err != nil {
+		return errors.Wrap(err, ErrCodeRemoteConfigError, "failed to store remote config").
+			WithContext("provider", provider.Name())
+	}
+	return nil
+}
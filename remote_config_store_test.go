@@ -0,0 +1,129 @@
+// remote_config_store_test.go: Tests for remote configuration write-back
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/agilira/go-errors"
+)
+
+// memoryStoreProvider keeps configurations in memory, keyed by URL
+type memoryStoreProvider struct {
+	mu      sync.Mutex
+	configs map[string]map[string]interface{}
+}
+
+func (p *memoryStoreProvider) Name() string              { return "Memory Store Provider" }
+func (p *memoryStoreProvider) Scheme() string            { return "memstore" }
+func (p *memoryStoreProvider) Validate(url string) error { return nil }
+
+func (p *memoryStoreProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	config, ok := p.configs[configURL]
+	if !ok {
+		return nil, errors.New(ErrCodeConfigNotFound, "no config at "+configURL)
+	}
+	return copyMap(config), nil
+}
+
+func (p *memoryStoreProvider) Store(ctx context.Context, configURL string, config map[string]interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.configs[configURL] = copyMap(config)
+	return nil
+}
+
+func (p *memoryStoreProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *memoryStoreProvider) HealthCheck(ctx context.Context, configURL string) error { return nil }
+
+// readOnlyProvider implements RemoteConfigProvider without Store
+type readOnlyProvider struct{}
+
+func (p *readOnlyProvider) Name() string              { return "Read-Only Provider" }
+func (p *readOnlyProvider) Scheme() string            { return "readonly" }
+func (p *readOnlyProvider) Validate(url string) error { return nil }
+
+func (p *readOnlyProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
+}
+
+func (p *readOnlyProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *readOnlyProvider) HealthCheck(ctx context.Context, configURL string) error { return nil }
+
+func registerStoreTestProviders(t *testing.T) {
+	t.Helper()
+	if _, err := GetRemoteProvider("memstore"); err != nil {
+		if err := RegisterRemoteProvider(&memoryStoreProvider{configs: make(map[string]map[string]interface{})}); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+	}
+	if _, err := GetRemoteProvider("readonly"); err != nil {
+		if err := RegisterRemoteProvider(&readOnlyProvider{}); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+	}
+}
+
+func TestStoreRemoteConfig_RoundTrip(t *testing.T) {
+	registerStoreTestProviders(t)
+	configURL := "memstore://local/config/app"
+
+	config := map[string]interface{}{"port": 8080, "debug": true}
+	if err := StoreRemoteConfig(configURL, config); err != nil {
+		t.Fatalf("Failed to store remote config: %v", err)
+	}
+
+	loaded, err := LoadRemoteConfig(configURL)
+	if err != nil {
+		t.Fatalf("Failed to load remote config: %v", err)
+	}
+	if !ConfigEquals(loaded, config) {
+		t.Errorf("Expected %v, got %v", config, loaded)
+	}
+
+	// Update and reload
+	config["port"] = 9090
+	if err := StoreRemoteConfig(configURL, config); err != nil {
+		t.Fatalf("Failed to store remote config: %v", err)
+	}
+	loaded, err = LoadRemoteConfig(configURL)
+	if err != nil {
+		t.Fatalf("Failed to load remote config: %v", err)
+	}
+	if loaded["port"] != 9090 {
+		t.Errorf("Expected updated port 9090, got %v", loaded["port"])
+	}
+}
+
+func TestStoreRemoteConfig_Errors(t *testing.T) {
+	registerStoreTestProviders(t)
+
+	err := StoreRemoteConfig("readonly://local/config", map[string]interface{}{"a": 1})
+	if !errors.HasCode(err, ErrCodeRemoteStoreUnsupported) {
+		t.Errorf("Expected ErrCodeRemoteStoreUnsupported, got %v", err)
+	}
+
+	if err := StoreRemoteConfig("memstore://local/config", nil); err == nil {
+		t.Error("Expected error for nil config")
+	}
+	if err := StoreRemoteConfig("", map[string]interface{}{}); err == nil {
+		t.Error("Expected error for empty URL")
+	}
+	if err := StoreRemoteConfig("nosuchscheme://x", map[string]interface{}{}); err == nil {
+		t.Error("Expected error for unregistered scheme")
+	}
+}
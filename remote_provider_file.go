@@ -0,0 +1,121 @@
+// remote_provider_file.go: Local file remote provider (file://)
+//
+// Remote configuration pipelines (LoadRemoteConfig, WatchRemoteConfig,
+// Config.Remote) can be exercised without a network by pointing them at a
+// local file:
+//
+//	config, err := argus.LoadRemoteConfig("file:///etc/myapp/config.yaml")
+//
+// The file is parsed by its extension, or by content sniffing when it has
+// none, and watched through the standard polling fallback at
+// RemoteConfigOptions.WatchInterval. Paths go through ValidateSecurePath,
+// the same check applied to watched files.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/agilira/go-errors"
+)
+
+// fileProvider is the built-in RemoteConfigProvider for file:// URLs
+type fileProvider struct{}
+
+func init() {
+	_ = RegisterRemoteProvider(fileProvider{})
+}
+
+// Name returns the provider name
+func (fileProvider) Name() string {
+	return "Local File"
+}
+
+// Scheme returns the URL scheme handled by the provider
+func (fileProvider) Scheme() string {
+	return "file"
+}
+
+// Validate checks that configURL names a safe local path
+func (fileProvider) Validate(configURL string) error {
+	_, err := filePathFromURL(configURL)
+	return err
+}
+
+// LoadRaw reads the file; Argus parses it by extension or content
+func (fileProvider) LoadRaw(ctx context.Context, configURL string) ([]byte, string, error) {
+	path, err := filePathFromURL(configURL)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+
+	data, err := readFileLimited(path, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, "", nil
+}
+
+// Load reads and parses the file
+func (p fileProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	return loadFromProvider(ctx, p, configURL, nil)
+}
+
+// Watch returns no channel, so WatchRemoteConfig polls the file at
+// RemoteConfigOptions.WatchInterval
+func (fileProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+// HealthCheck verifies that the file exists
+func (fileProvider) HealthCheck(ctx context.Context, configURL string) error {
+	path, err := filePathFromURL(configURL)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return errors.Wrap(err, ErrCodeFileNotFound, "config file is not accessible").
+			WithContext("path", path)
+	}
+	return nil
+}
+
+// filePathFromURL extracts the local path of file:///path or
+// file://localhost/path and validates it with ValidateSecurePath
+func filePathFromURL(configURL string) (string, error) {
+	parsedURL, err := url.Parse(configURL)
+	if err != nil {
+		return "", errors.Wrap(err, ErrCodeInvalidConfig, "invalid file URL")
+	}
+	if parsedURL.Scheme != "file" {
+		return "", errors.New(ErrCodeInvalidConfig, "file URL scheme must be 'file', got '"+parsedURL.Scheme+"'")
+	}
+	if parsedURL.Host != "" && parsedURL.Host != "localhost" {
+		return "", errors.New(ErrCodeInvalidConfig, "file URL must refer to the local host, got '"+parsedURL.Host+"'")
+	}
+
+	path := parsedURL.Path
+	if path == "" {
+		path = parsedURL.Opaque // file:relative/config.json
+	}
+	// file:///C:/app/config.json on Windows
+	if len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+
+	if err := ValidateSecurePath(path); err != nil {
+		return "", errors.Wrap(err, ErrCodeInvalidConfig, "invalid or unsafe file path").
+			WithContext("path", path)
+	}
+	return filepath.FromSlash(path), nil
+}
@@ -0,0 +1,80 @@
+// remote_provider_file_test.go: Tests for the file:// remote provider
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileProvider_LoadAndWatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("version: 1\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	configURL := "file://" + filepath.ToSlash(path)
+
+	config, err := LoadRemoteConfig(configURL)
+	if err != nil {
+		t.Fatalf("Failed to load file URL: %v", err)
+	}
+	if config["version"] != 1 {
+		t.Errorf("Expected version 1, got %v", config["version"])
+	}
+	if err := HealthCheckRemoteProvider(configURL); err != nil {
+		t.Errorf("Expected a healthy file provider, got %v", err)
+	}
+
+	options := DefaultRemoteConfigOptions()
+	options.WatchInterval = 20 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configs, err := WatchRemoteConfigWithContext(ctx, configURL, options)
+	if err != nil {
+		t.Fatalf("Failed to watch file URL: %v", err)
+	}
+	receive := func() map[string]interface{} {
+		t.Helper()
+		select {
+		case config := <-configs:
+			return config
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timed out waiting for configuration")
+			return nil
+		}
+	}
+
+	if config := receive(); config["version"] != 1 {
+		t.Errorf("Expected initial version 1, got %v", config["version"])
+	}
+	if err := os.WriteFile(path, []byte("version: 2\n"), 0644); err != nil {
+		t.Fatalf("Failed to update config: %v", err)
+	}
+	if config := receive(); config["version"] != 2 {
+		t.Errorf("Expected updated version 2, got %v", config["version"])
+	}
+}
+
+func TestFileProvider_RejectsUnsafeURLs(t *testing.T) {
+	for _, configURL := range []string{
+		"file:///etc/../etc/passwd",
+		"file://remote-host/etc/app/config.json",
+		"file://",
+	} {
+		if _, err := LoadRemoteConfig(configURL); err == nil {
+			t.Errorf("Expected %s to be rejected", configURL)
+		}
+	}
+
+	if _, err := LoadRemoteConfig("file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "missing.json"))); err == nil {
+		t.Error("Expected a missing file to fail")
+	}
+}
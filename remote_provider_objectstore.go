@@ -0,0 +1,356 @@
+// remote_provider_objectstore.go: Object store remote provider (gs://)
+//
+// Teams that keep configuration in a bucket can point Argus at the object:
+//
+//	config, err := argus.LoadRemoteConfig("gs://my-bucket/services/api/config.yaml")
+//
+// The object is fetched and parsed by its content type or extension, and
+// watched by polling the object generation, so unchanged objects are never
+// downloaded again. The provider is stdlib-only: the gs client talks to the
+// Cloud Storage JSON API directly instead of pulling in the cloud SDK.
+//
+// Authentication for gs://, first match wins:
+//   - access_token URL parameter (gs://bucket/obj?access_token=...)
+//   - GOOGLE_OAUTH_ACCESS_TOKEN environment variable
+//   - the GCE/GKE/Cloud Run metadata server (ambient service account)
+//
+// STORAGE_EMULATOR_HOST redirects requests to a local emulator without auth.
+// Other stores plug in through ObjectStoreClient and NewObjectStoreProvider.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// ObjectRef identifies an object in a bucket-based store
+type ObjectRef struct {
+	Bucket string
+	Object string
+
+	// Params holds the URL query parameters, e.g. credentials
+	Params url.Values
+}
+
+// ObjectStoreClient fetches objects from a bucket-based store.
+// Version is an opaque tag that changes whenever the object changes
+// (GCS generation, S3 ETag).
+type ObjectStoreClient interface {
+	// GetObject returns the object content, its content type and version
+	GetObject(ctx context.Context, ref ObjectRef) (data []byte, contentType, version string, err error)
+
+	// ObjectVersion returns the current version without downloading the content
+	ObjectVersion(ctx context.Context, ref ObjectRef) (string, error)
+}
+
+// defaultObjectPollInterval is used when the URL has no poll parameter
+const defaultObjectPollInterval = 30 * time.Second
+
+// ObjectStoreProvider is a RemoteConfigProvider for objects in a bucket,
+// addressed as scheme://bucket/path/to/object. The optional poll URL
+// parameter (e.g. ?poll=10s) sets how often Watch checks the version.
+type ObjectStoreProvider struct {
+	scheme string
+	client ObjectStoreClient
+}
+
+// NewObjectStoreProvider creates an object store provider for scheme backed
+// by client. Register it with RegisterRemoteProvider.
+//
+// Example:
+//
+//	_ = argus.RegisterRemoteProvider(argus.NewObjectStoreProvider("minio", myClient))
+func NewObjectStoreProvider(scheme string, client ObjectStoreClient) *ObjectStoreProvider {
+	return &ObjectStoreProvider{scheme: scheme, client: client}
+}
+
+func init() {
+	_ = RegisterRemoteProvider(NewObjectStoreProvider("gs", newGCSClient()))
+}
+
+// Name returns the provider name
+func (p *ObjectStoreProvider) Name() string {
+	return "Object Store (" + p.scheme + ")"
+}
+
+// Scheme returns the URL scheme handled by the provider
+func (p *ObjectStoreProvider) Scheme() string {
+	return p.scheme
+}
+
+// Validate checks that configURL names a bucket and an object
+func (p *ObjectStoreProvider) Validate(configURL string) error {
+	_, _, err := p.parseObjectURL(configURL)
+	return err
+}
+
+// LoadRaw fetches the object; Argus parses it by content type or extension
+func (p *ObjectStoreProvider) LoadRaw(ctx context.Context, configURL string) ([]byte, string, error) {
+	ref, _, err := p.parseObjectURL(configURL)
+	if err != nil {
+		return nil, "", err
+	}
+	data, contentType, _, err := p.client.GetObject(ctx, ref)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, contentType, nil
+}
+
+// Load fetches and parses the object
+func (p *ObjectStoreProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	return loadFromProvider(ctx, p, configURL, nil)
+}
+
+// Watch polls the object version and sends the parsed object on every
+// change, starting with the current content. The channel is closed when
+// ctx is done.
+func (p *ObjectStoreProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	ref, interval, err := p.parseObjectURL(configURL)
+	if err != nil {
+		return nil, err
+	}
+
+	configChan := make(chan map[string]interface{}, 1)
+	go func() {
+		defer close(configChan)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		lastVersion := ""
+		for {
+			if version, err := p.client.ObjectVersion(ctx, ref); err == nil && version != lastVersion {
+				if config, err := p.Load(ctx, configURL); err == nil {
+					lastVersion = version
+					select {
+					case configChan <- config:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return configChan, nil
+}
+
+// HealthCheck verifies that the object is reachable
+func (p *ObjectStoreProvider) HealthCheck(ctx context.Context, configURL string) error {
+	ref, _, err := p.parseObjectURL(configURL)
+	if err != nil {
+		return err
+	}
+	_, err = p.client.ObjectVersion(ctx, ref)
+	return err
+}
+
+// parseObjectURL splits scheme://bucket/object?poll=30s
+func (p *ObjectStoreProvider) parseObjectURL(configURL string) (ObjectRef, time.Duration, error) {
+	parsedURL, err := url.Parse(configURL)
+	if err != nil {
+		return ObjectRef{}, 0, errors.Wrap(err, ErrCodeInvalidConfig, "invalid object URL")
+	}
+	if parsedURL.Scheme != p.scheme {
+		return ObjectRef{}, 0, errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("object URL scheme must be '%s', got '%s'", p.scheme, parsedURL.Scheme))
+	}
+
+	ref := ObjectRef{
+		Bucket: parsedURL.Host,
+		Object: strings.TrimPrefix(parsedURL.Path, "/"),
+		Params: parsedURL.Query(),
+	}
+	if ref.Bucket == "" || ref.Object == "" {
+		return ObjectRef{}, 0, errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("object URL must be %s://bucket/object", p.scheme))
+	}
+	for _, segment := range strings.Split(ref.Object, "/") {
+		if segment == "." || segment == ".." {
+			return ObjectRef{}, 0, errors.New(ErrCodeInvalidConfig, "object path cannot contain '.' or '..' segments")
+		}
+	}
+
+	interval := defaultObjectPollInterval
+	if poll := ref.Params.Get("poll"); poll != "" {
+		interval, err = time.ParseDuration(poll)
+		if err != nil || interval <= 0 {
+			return ObjectRef{}, 0, errors.New(ErrCodeInvalidConfig, "invalid poll interval: "+poll)
+		}
+	}
+
+	return ref, interval, nil
+}
+
+// gcsClient is an ObjectStoreClient for the Cloud Storage JSON API
+type gcsClient struct {
+	httpClient *http.Client
+
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// gcsMetadataTokenURL serves access tokens of the ambient service account
+const gcsMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+func newGCSClient() *gcsClient {
+	return &gcsClient{httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// GetObject downloads the object content
+func (c *gcsClient) GetObject(ctx context.Context, ref ObjectRef) ([]byte, string, string, error) {
+	resp, err := c.do(ctx, ref, c.objectURL(ref)+"?alt=media")
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", "", errors.Wrap(err, ErrCodeRemoteConfigError, "failed to read object").
+			WithContext("object", ref.Bucket+"/"+ref.Object)
+	}
+	return data, resp.Header.Get("Content-Type"), resp.Header.Get("X-Goog-Generation"), nil
+}
+
+// ObjectVersion reads the object generation from its metadata
+func (c *gcsClient) ObjectVersion(ctx context.Context, ref ObjectRef) (string, error) {
+	resp, err := c.do(ctx, ref, c.objectURL(ref)+"?fields=generation")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var meta struct {
+		Generation string `json:"generation"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return "", errors.Wrap(err, ErrCodeRemoteConfigError, "invalid object metadata").
+			WithContext("object", ref.Bucket+"/"+ref.Object)
+	}
+	return meta.Generation, nil
+}
+
+// objectURL returns the JSON API URL of the object, honoring
+// STORAGE_EMULATOR_HOST
+func (c *gcsClient) objectURL(ref ObjectRef) string {
+	endpoint := "https://storage.googleapis.com"
+	if host := os.Getenv("STORAGE_EMULATOR_HOST"); host != "" {
+		endpoint = strings.TrimSuffix(host, "/")
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "http://" + endpoint
+		}
+	}
+	return endpoint + "/storage/v1/b/" + url.PathEscape(ref.Bucket) + "/o/" + url.PathEscape(ref.Object)
+}
+
+// do sends an authenticated GET request and checks the status
+func (c *gcsClient) do(ctx context.Context, ref ObjectRef, requestURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeRemoteConfigError, "failed to build object request")
+	}
+
+	// Credentials from RemoteConfigOptions win over the ambient ones
+	if options, ok := RemoteConfigOptionsFromContext(ctx); ok {
+		options.ApplyToRequest(req)
+	}
+	if req.Header.Get("Authorization") == "" && os.Getenv("STORAGE_EMULATOR_HOST") == "" {
+		token, err := c.accessToken(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.client(ctx).Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeRemoteConfigError, "object request failed").
+			WithContext("object", ref.Bucket+"/"+ref.Object)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, errors.New(ErrCodeRemoteConfigError, fmt.Sprintf("HTTP %d fetching object", resp.StatusCode)).
+			WithContext("object", ref.Bucket+"/"+ref.Object)
+	}
+	return resp, nil
+}
+
+// client returns the HTTP client for a request, honoring the
+// TLSClientConfig of the options in ctx
+func (c *gcsClient) client(ctx context.Context) *http.Client {
+	tlsConfig := tlsConfigOf(ctx)
+	if tlsConfig == nil {
+		return c.httpClient
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: c.httpClient.Timeout}
+}
+
+// accessToken resolves credentials: URL parameter, environment, then the
+// metadata server (cached until shortly before expiry)
+func (c *gcsClient) accessToken(ctx context.Context, ref ObjectRef) (string, error) {
+	if token := ref.Params.Get("access_token"); token != "" {
+		return token, nil
+	}
+	if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		return token, nil
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcsMetadataTokenURL, nil)
+	if err != nil {
+		return "", errors.Wrap(err, ErrCodeRemoteConfigError, "failed to build metadata request")
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, ErrCodeRemoteConfigError, "no GCS credentials: set access_token, GOOGLE_OAUTH_ACCESS_TOKEN or run with a metadata server")
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(ErrCodeRemoteConfigError, fmt.Sprintf("metadata server returned HTTP %d", resp.StatusCode))
+	}
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil || tok.AccessToken == "" {
+		return "", errors.New(ErrCodeRemoteConfigError, "invalid token from metadata server")
+	}
+
+	c.token = tok.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(tok.ExpiresIn)*time.Second - time.Minute)
+	return c.token, nil
+}
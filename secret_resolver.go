@@ -0,0 +1,138 @@
+// secret_resolver.go: Lazy resolution of secret references during binding
+//
+// Configuration files should reference secrets rather than contain them:
+//
+//	database:
+//	  password: "vault:secret/db#password"
+//
+// A SecretResolver registered for the "vault" scheme turns the reference into
+// the actual secret when BindSecret is applied, so the plaintext only lives in
+// the bound variable. Values without a registered scheme bind as literals,
+// which keeps local development files working unchanged.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/agilira/go-errors"
+)
+
+// SecretResolver fetches secrets for references of the form "scheme:ref"
+type SecretResolver interface {
+	// Scheme returns the reference prefix handled by the resolver (e.g. "vault")
+	Scheme() string
+
+	// Resolve returns the secret for ref, the part after "scheme:".
+	// Errors must not include the secret value.
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+var (
+	secretResolvers   = make(map[string]SecretResolver)
+	secretResolversMu sync.RWMutex
+)
+
+// RegisterSecretResolver makes a resolver available to BindSecret.
+// Registering a second resolver for the same scheme is an error.
+//
+// Example:
+//
+//	argus.RegisterSecretResolver(argus.EnvSecretResolver{})
+func RegisterSecretResolver(resolver SecretResolver) error {
+	if resolver == nil {
+		return errors.New(ErrCodeInvalidConfig, "secret resolver cannot be nil")
+	}
+
+	scheme := resolver.Scheme()
+	if scheme == "" {
+		return errors.New(ErrCodeInvalidConfig, "secret resolver scheme cannot be empty")
+	}
+
+	secretResolversMu.Lock()
+	defer secretResolversMu.Unlock()
+
+	if _, exists := secretResolvers[scheme]; exists {
+		return errors.New(ErrCodeInvalidConfig,
+			fmt.Sprintf("secret resolver for scheme '%s' already registered", scheme))
+	}
+	secretResolvers[scheme] = resolver
+	return nil
+}
+
+// GetSecretResolver returns the resolver registered for scheme
+func GetSecretResolver(scheme string) (SecretResolver, bool) {
+	secretResolversMu.RLock()
+	defer secretResolversMu.RUnlock()
+
+	resolver, ok := secretResolvers[scheme]
+	return resolver, ok
+}
+
+// BindSecret binds a string that may be a secret reference. When the value
+// has the form "scheme:ref" and a resolver is registered for scheme, the
+// resolved secret is bound; otherwise the value is bound as is. Resolution
+// happens at Apply time and failures are returned by Apply with the
+// ErrCodeSecretResolution code. An absent key binds the empty string.
+//
+// Example:
+//
+//	var password string
+//	err := argus.BindFromConfig(config).
+//	    BindSecret(&password, "database.password"). // "vault:secret/db#password"
+//	    Apply()
+func (cb *ConfigBinder) BindSecret(target *string, key string) *ConfigBinder {
+	return cb.addCustomBinding(key, false, func(value interface{}, exists bool) error {
+		if !exists {
+			return setValidated(cb, key, target, "")
+		}
+
+		raw := cb.toString(value)
+		scheme, ref, ok := strings.Cut(raw, ":")
+		if !ok {
+			return setValidated(cb, key, target, raw)
+		}
+		resolver, ok := GetSecretResolver(scheme)
+		if !ok {
+			return setValidated(cb, key, target, raw)
+		}
+
+		secret, err := resolver.Resolve(context.Background(), ref)
+		if err != nil {
+			return errors.Wrap(err, ErrCodeSecretResolution,
+				fmt.Sprintf("failed to resolve secret with scheme '%s'", scheme))
+		}
+		return setValidated(cb, key, target, secret)
+	})
+}
+
+// EnvSecretResolver resolves "env:NAME" references from environment
+// variables. It is a reference implementation and is not registered by
+// default. An unset variable is an error; an empty one resolves to "".
+type EnvSecretResolver struct{}
+
+// Scheme returns "env"
+func (EnvSecretResolver) Scheme() string {
+	return "env"
+}
+
+// Resolve returns the value of the environment variable named ref
+func (EnvSecretResolver) Resolve(_ context.Context, ref string) (string, error) {
+	if ref == "" {
+		return "", errors.New(ErrCodeInvalidConfig, "empty environment variable name")
+	}
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", errors.New(ErrCodeConfigNotFound,
+			fmt.Sprintf("environment variable '%s' is not set", ref))
+	}
+	return value, nil
+}
@@ -0,0 +1,132 @@
+// secret_resolver_test.go: Tests for secret references in the config binder
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/agilira/go-errors"
+)
+
+// mockSecretResolver serves secrets from a fixed map
+type mockSecretResolver struct {
+	secrets map[string]string
+}
+
+func (m mockSecretResolver) Scheme() string { return "mockvault" }
+
+func (m mockSecretResolver) Resolve(_ context.Context, ref string) (string, error) {
+	secret, ok := m.secrets[ref]
+	if !ok {
+		return "", errors.New(ErrCodeConfigNotFound, "secret not found: "+ref)
+	}
+	return secret, nil
+}
+
+// registerMockSecretResolver registers the mock once per test binary;
+// the registry is global and rejects duplicate schemes
+func registerMockSecretResolver(t *testing.T) {
+	t.Helper()
+	if _, ok := GetSecretResolver("mockvault"); ok {
+		return
+	}
+	resolver := mockSecretResolver{secrets: map[string]string{"secret/db#password": "s3cr3t"}}
+	if err := RegisterSecretResolver(resolver); err != nil {
+		t.Fatalf("Failed to register resolver: %v", err)
+	}
+}
+
+func TestConfigBinder_BindSecret(t *testing.T) {
+	registerMockSecretResolver(t)
+
+	config := map[string]interface{}{
+		"database": map[string]interface{}{
+			"password": "mockvault:secret/db#password",
+			"user":     "admin",
+			"dsn":      "unknown:value",
+		},
+	}
+
+	var password, user, dsn, missing string
+	missing = "preset"
+	err := BindFromConfig(config).
+		BindSecret(&password, "database.password").
+		BindSecret(&user, "database.user").
+		BindSecret(&dsn, "database.dsn").
+		BindSecret(&missing, "database.missing").
+		Apply()
+	if err != nil {
+		t.Fatalf("Failed to apply bindings: %v", err)
+	}
+
+	if password != "s3cr3t" {
+		t.Errorf("Expected resolved secret, got %q", password)
+	}
+	if user != "admin" {
+		t.Errorf("Expected literal value, got %q", user)
+	}
+	if dsn != "unknown:value" {
+		t.Errorf("Expected unregistered scheme to bind literally, got %q", dsn)
+	}
+	if missing != "" {
+		t.Errorf("Expected absent key to bind empty string, got %q", missing)
+	}
+}
+
+func TestConfigBinder_BindSecretResolutionError(t *testing.T) {
+	registerMockSecretResolver(t)
+
+	config := map[string]interface{}{"password": "mockvault:secret/missing"}
+
+	var password string
+	err := BindFromConfig(config).BindSecret(&password, "password").Apply()
+	if err == nil {
+		t.Fatal("Expected resolution error")
+	}
+	if !errors.HasCode(err, ErrCodeSecretResolution) {
+		t.Errorf("Expected ErrCodeSecretResolution, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "password") {
+		t.Errorf("Expected error to name the key, got %v", err)
+	}
+	if password != "" {
+		t.Errorf("Expected target untouched on error, got %q", password)
+	}
+}
+
+func TestRegisterSecretResolver_Validation(t *testing.T) {
+	registerMockSecretResolver(t)
+
+	if err := RegisterSecretResolver(nil); err == nil {
+		t.Error("Expected error for nil resolver")
+	}
+	if err := RegisterSecretResolver(mockSecretResolver{}); err == nil {
+		t.Error("Expected error for duplicate scheme")
+	}
+}
+
+func TestEnvSecretResolver(t *testing.T) {
+	t.Setenv("ARGUS_TEST_SECRET", "from-env")
+
+	resolver := EnvSecretResolver{}
+	secret, err := resolver.Resolve(context.Background(), "ARGUS_TEST_SECRET")
+	if err != nil {
+		t.Fatalf("Failed to resolve secret: %v", err)
+	}
+	if secret != "from-env" {
+		t.Errorf("Expected 'from-env', got %q", secret)
+	}
+
+	if _, err := resolver.Resolve(context.Background(), "ARGUS_TEST_SECRET_UNSET"); err == nil {
+		t.Error("Expected error for unset variable")
+	}
+	if _, err := resolver.Resolve(context.Background(), ""); err == nil {
+		t.Error("Expected error for empty name")
+	}
+}
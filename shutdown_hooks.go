@@ -0,0 +1,162 @@
+// shutdown_hooks.go: Coordinated application teardown driven by the watcher lifecycle
+//
+// Resources built from configuration (database pools, HTTP clients, caches)
+// often need to be released in the reverse order of their creation. Shutdown
+// hooks let applications hand that teardown to Argus, so a single
+// GracefulShutdown call stops watching and then unwinds the application
+// within the same deadline. Hooks registered with RegisterShutdownHook run
+// earlier, once queued events are delivered, so buffers filled by callbacks
+// can be flushed while the audit trail is still open.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+
+	"github.com/agilira/go-errors"
+)
+
+// ShutdownHook releases an application resource during GracefulShutdown.
+// The context carries the remaining shutdown deadline and must be honored.
+type ShutdownHook func(ctx context.Context) error
+
+// OnShutdown registers a hook invoked by GracefulShutdown after the watcher
+// has stopped. Hooks run in LIFO order (last registered, first executed),
+// mirroring defer semantics so dependents are torn down before their
+// dependencies. Every hook runs even if an earlier one fails; errors are
+// aggregated into the error returned by GracefulShutdown.
+//
+// Hooks also run when GracefulShutdown finds the watcher already stopped or
+// Stop fails; the hook errors are then joined with the watcher error.
+//
+// Hooks are not invoked by Stop or Close, which remain purely watcher-scoped.
+//
+// Example:
+//
+//	db := openDB(cfg)
+//	watcher.OnShutdown(func(ctx context.Context) error {
+//	    return db.Close()
+//	})
+func (w *Watcher) OnShutdown(hook ShutdownHook) {
+	if hook == nil {
+		return
+	}
+
+	w.shutdownHooksMu.Lock()
+	w.shutdownHooks = append(w.shutdownHooks, hook)
+	w.shutdownHooksMu.Unlock()
+}
+
+// RegisterShutdownHook registers a hook invoked by GracefulShutdown once
+// in-flight callbacks have returned and queued events have been delivered,
+// before the audit trail is closed and the hooks registered with OnShutdown
+// release resources. Hooks run in registration order, so an application can
+// flush its own buffers alongside the watcher's. Every hook runs even if an
+// earlier one fails; errors are aggregated into the error returned by
+// GracefulShutdown. Like OnShutdown hooks, they also run when GracefulShutdown
+// finds the watcher already stopped, and never on Stop or Close.
+//
+// Example:
+//
+//	watcher.RegisterShutdownHook(func(ctx context.Context) error {
+//	    return metricsBuffer.Flush(ctx)
+//	})
+func (w *Watcher) RegisterShutdownHook(hook ShutdownHook) {
+	if hook == nil {
+		return
+	}
+
+	w.shutdownHooksMu.Lock()
+	w.flushHooks = append(w.flushHooks, hook)
+	w.shutdownHooksMu.Unlock()
+}
+
+// runShutdownHooks executes hooks registered with OnShutdown in LIFO order,
+// each bounded by the remaining deadline of ctx. Hooks are consumed: a second
+// call is a no-op.
+func (w *Watcher) runShutdownHooks(ctx context.Context) error {
+	w.shutdownHooksMu.Lock()
+	hooks := w.shutdownHooks
+	w.shutdownHooks = nil
+	w.shutdownHooksMu.Unlock()
+
+	return runShutdownHookList(ctx, hooks, true, "shutdown hook")
+}
+
+// runFlushHooks executes hooks registered with RegisterShutdownHook in
+// registration order, each bounded by the remaining deadline of ctx. Hooks
+// are consumed: a second call is a no-op.
+func (w *Watcher) runFlushHooks(ctx context.Context) error {
+	w.shutdownHooksMu.Lock()
+	hooks := w.flushHooks
+	w.flushHooks = nil
+	w.shutdownHooksMu.Unlock()
+
+	return runShutdownHookList(ctx, hooks, false, "flush hook")
+}
+
+// runShutdownHookList runs hooks in registration order, or in reverse with
+// lifo, aggregating failures into an ErrCodeShutdownHook error. kind names the
+// hooks in errors.
+func runShutdownHookList(ctx context.Context, hooks []ShutdownHook, lifo bool, kind string) error {
+	var errs []error
+	for n := range hooks {
+		i := n
+		if lifo {
+			i = len(hooks) - 1 - n
+		}
+		if err := runShutdownHook(ctx, hooks[i]); err != nil {
+			errs = append(errs, fmt.Errorf("%s #%d: %w", kind, i, err))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.Wrap(goerrors.Join(errs...), ErrCodeShutdownHook,
+		fmt.Sprintf("%d of %d %ss failed", len(errs), len(hooks), kind))
+}
+
+// joinShutdownErrors combines a watcher error with hook failures. The watcher
+// error comes first so checks on its error code keep working.
+func joinShutdownErrors(err, hookErr error) error {
+	if hookErr == nil {
+		return err
+	}
+	if err == nil {
+		return hookErr
+	}
+	return goerrors.Join(err, hookErr)
+}
+
+// runShutdownHook runs a single hook, abandoning it if the deadline expires
+// so that a misbehaving hook cannot block the remaining teardown
+func runShutdownHook(ctx context.Context, hook ShutdownHook) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- hook(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
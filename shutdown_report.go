@@ -0,0 +1,229 @@
+// shutdown_report.go: Per-subsystem diagnostics for GracefulShutdown
+//
+// A forced shutdown used to surface as a single timeout error, leaving
+// operators to guess what did not finish. The shutdown sequence now runs as
+// named steps and records the status and duration of each, so a deploy log
+// can say "callbacks timed out after 30s" instead of "timeout exceeded".
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	goerrors "errors"
+	"sync"
+	"time"
+)
+
+// Subsystems reported by GracefulShutdown, in shutdown order
+const (
+	ShutdownPollLoop      = "poll_loop"      // Polling goroutine stopped
+	ShutdownCallbacks     = "callbacks"      // In-flight callbacks returned
+	ShutdownEventDrain    = "event_drain"    // Queued BoreasLite events delivered
+	ShutdownEventsChannel = "events_channel" // Events channel flushed and closed
+	ShutdownFlushHooks    = "flush_hooks"    // Hooks registered with RegisterShutdownHook
+	ShutdownAuditFlush    = "audit_flush"    // Audit trail flushed and closed
+	ShutdownHooks         = "shutdown_hooks" // Hooks registered with OnShutdown
+)
+
+// ShutdownStatus is the outcome of one shutdown step
+type ShutdownStatus int
+
+const (
+	// ShutdownPending means the step had not started when the report was taken
+	ShutdownPending ShutdownStatus = iota
+
+	// ShutdownCompleted means the step finished successfully
+	ShutdownCompleted
+
+	// ShutdownFailed means the step finished with an error
+	ShutdownFailed
+
+	// ShutdownTimedOut means the step was still running at the deadline
+	ShutdownTimedOut
+)
+
+// String returns the name of the status
+func (s ShutdownStatus) String() string {
+	switch s {
+	case ShutdownPending:
+		return "pending"
+	case ShutdownCompleted:
+		return "completed"
+	case ShutdownFailed:
+		return "failed"
+	case ShutdownTimedOut:
+		return "timed_out"
+	default:
+		return "unknown"
+	}
+}
+
+// SubsystemShutdown reports how one subsystem shut down
+type SubsystemShutdown struct {
+	Name     string
+	Status   ShutdownStatus
+	Duration time.Duration // Time spent so far when timed out
+	Err      error
+}
+
+// ShutdownReport describes a GracefulShutdown call subsystem by subsystem
+type ShutdownReport struct {
+	// Subsystems lists every step in shutdown order
+	Subsystems []SubsystemShutdown
+
+	// Duration is the total time of the shutdown, up to the deadline if it timed out
+	Duration time.Duration
+
+	// TimedOut is true when the deadline expired before every step finished.
+	// Unfinished steps keep running in the background; ShutdownReport on the
+	// watcher returns their later progress.
+	TimedOut bool
+
+	// EventsFlushed is the number of queued change events delivered to
+	// callbacks after the shutdown started
+	EventsFlushed int64
+
+	// AuditFlushed is true once the audit trail was flushed and closed
+	AuditFlushed bool
+}
+
+// Incomplete returns the names of subsystems that did not complete successfully
+func (r ShutdownReport) Incomplete() []string {
+	var names []string
+	for _, s := range r.Subsystems {
+		if s.Status != ShutdownCompleted {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// shutdownTracker records step progress while the sequence runs; it outlives
+// the GracefulShutdown call when the deadline expires
+type shutdownTracker struct {
+	mu       sync.Mutex
+	start    time.Time
+	end      time.Time
+	timedOut bool
+	flushed  int64
+	steps    []SubsystemShutdown
+	started  []time.Time
+}
+
+func newShutdownTracker(names ...string) *shutdownTracker {
+	t := &shutdownTracker{
+		start:   time.Now(),
+		steps:   make([]SubsystemShutdown, len(names)),
+		started: make([]time.Time, len(names)),
+	}
+	for i, name := range names {
+		t.steps[i].Name = name
+	}
+	return t
+}
+
+// run executes step i and records its outcome
+func (t *shutdownTracker) run(i int, step func() error) error {
+	t.mu.Lock()
+	t.started[i] = time.Now()
+	t.mu.Unlock()
+
+	err := step()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps[i].Duration = time.Since(t.started[i])
+	t.steps[i].Err = err
+	t.steps[i].Status = ShutdownCompleted
+	if err != nil {
+		t.steps[i].Status = ShutdownFailed
+		if goerrors.Is(err, context.DeadlineExceeded) {
+			t.steps[i].Status = ShutdownTimedOut
+		}
+	}
+	return err
+}
+
+// setEventsFlushed records the number of events drained from the ring
+func (t *shutdownTracker) setEventsFlushed(n int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.flushed = n
+}
+
+// finish marks the end of the GracefulShutdown call
+func (t *shutdownTracker) finish(timedOut bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.end = time.Now()
+	t.timedOut = timedOut
+}
+
+// report returns a snapshot; steps still running count as timed out
+func (t *shutdownTracker) report() ShutdownReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	end := t.end
+	if end.IsZero() {
+		end = time.Now()
+	}
+	report := ShutdownReport{
+		Subsystems:    make([]SubsystemShutdown, len(t.steps)),
+		Duration:      end.Sub(t.start),
+		TimedOut:      t.timedOut,
+		EventsFlushed: t.flushed,
+	}
+	copy(report.Subsystems, t.steps)
+	for i := range report.Subsystems {
+		s := &report.Subsystems[i]
+		if s.Status == ShutdownPending && !t.started[i].IsZero() {
+			s.Status = ShutdownTimedOut
+			s.Duration = time.Since(t.started[i])
+		}
+		if s.Name == ShutdownAuditFlush {
+			report.AuditFlushed = s.Status == ShutdownCompleted
+		}
+	}
+	return report
+}
+
+// ShutdownReport returns the report of the last GracefulShutdown call,
+// including progress made in the background after a timeout, and false if
+// GracefulShutdown was never called.
+func (w *Watcher) ShutdownReport() (ShutdownReport, bool) {
+	tracker := w.shutdownTracker.Load()
+	if tracker == nil {
+		return ShutdownReport{}, false
+	}
+	return tracker.report(), true
+}
+
+// waitCallbacks waits until no callback is executing
+func (w *Watcher) waitCallbacks(ctx context.Context) error {
+	return waitUntil(ctx, func() bool { return w.callbacksInFlight.Load() == 0 })
+}
+
+// waitUntil polls cond until it holds or ctx expires. The deadline is checked
+// first, so a condition met only after ctx expired still reports the timeout.
+func waitUntil(ctx context.Context, cond func() bool) error {
+	ticker := time.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if cond() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+}
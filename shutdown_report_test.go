@@ -0,0 +1,203 @@
+// shutdown_report_test.go: Tests for per-subsystem GracefulShutdown reports
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGracefulShutdown_Report(t *testing.T) {
+	watcher := New(Config{PollInterval: 50 * time.Millisecond})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	if _, ok := watcher.ShutdownReport(); ok {
+		t.Fatal("Expected no report before GracefulShutdown")
+	}
+
+	var hookRan bool
+	watcher.OnShutdown(func(ctx context.Context) error {
+		hookRan = true
+		return nil
+	})
+
+	if err := watcher.GracefulShutdown(5 * time.Second); err != nil {
+		t.Fatalf("GracefulShutdown failed: %v", err)
+	}
+	if !hookRan {
+		t.Error("Expected shutdown hook to run")
+	}
+
+	report, ok := watcher.ShutdownReport()
+	if !ok {
+		t.Fatal("Expected a report after GracefulShutdown")
+	}
+	if report.TimedOut {
+		t.Error("Expected TimedOut false")
+	}
+	want := []string{ShutdownPollLoop, ShutdownCallbacks, ShutdownEventDrain, ShutdownEventsChannel, ShutdownFlushHooks, ShutdownAuditFlush, ShutdownHooks}
+	if len(report.Subsystems) != len(want) {
+		t.Fatalf("Expected %d subsystems, got %d", len(want), len(report.Subsystems))
+	}
+	for i, s := range report.Subsystems {
+		if s.Name != want[i] {
+			t.Errorf("Subsystem %d: expected %s, got %s", i, want[i], s.Name)
+		}
+		if s.Status != ShutdownCompleted {
+			t.Errorf("Subsystem %s: expected completed, got %s (%v)", s.Name, s.Status, s.Err)
+		}
+	}
+	if incomplete := report.Incomplete(); len(incomplete) != 0 {
+		t.Errorf("Expected no incomplete subsystems, got %v", incomplete)
+	}
+}
+
+func TestGracefulShutdown_ReportFlagsSlowCallback(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "slow.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: 50 * time.Millisecond})
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	if err := watcher.Watch(configPath, func(event ChangeEvent) {
+		close(entered)
+		<-release
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	event := ConvertChangeEventToFileEvent(ChangeEvent{Path: absPath, IsModify: true})
+	go watcher.processFileEvent(&event)
+	<-entered
+
+	err = watcher.GracefulShutdown(100 * time.Millisecond)
+	if err == nil {
+		t.Fatal("Expected timeout error with a blocked callback")
+	}
+
+	report, _ := watcher.ShutdownReport()
+	if !report.TimedOut {
+		t.Error("Expected TimedOut true")
+	}
+	statuses := make(map[string]ShutdownStatus)
+	for _, s := range report.Subsystems {
+		statuses[s.Name] = s.Status
+	}
+	if statuses[ShutdownPollLoop] != ShutdownCompleted {
+		t.Errorf("Expected poll loop completed, got %s", statuses[ShutdownPollLoop])
+	}
+	if statuses[ShutdownCallbacks] != ShutdownTimedOut {
+		t.Errorf("Expected callbacks timed out, got %s", statuses[ShutdownCallbacks])
+	}
+	if incomplete := report.Incomplete(); len(incomplete) == 0 || incomplete[0] != ShutdownCallbacks {
+		t.Errorf("Expected callbacks first among incomplete subsystems, got %v", incomplete)
+	}
+
+	// Remaining steps finish in the background once the deadline has passed
+	close(release)
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		report, _ = watcher.ShutdownReport()
+		if report.Subsystems[len(report.Subsystems)-1].Status != ShutdownPending {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Background shutdown did not reach the hooks step")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if report.Subsystems[1].Status != ShutdownTimedOut {
+		t.Errorf("Expected callbacks to stay timed out, got %s", report.Subsystems[1].Status)
+	}
+}
+
+func TestShutdownStatus_String(t *testing.T) {
+	tests := map[ShutdownStatus]string{
+		ShutdownPending:    "pending",
+		ShutdownCompleted:  "completed",
+		ShutdownFailed:     "failed",
+		ShutdownTimedOut:   "timed_out",
+		ShutdownStatus(99): "unknown",
+	}
+	for status, want := range tests {
+		if got := status.String(); got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
+
+func TestGracefulShutdownWithReport(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, DisableAudit: true})
+	entered := make(chan struct{}, 8)
+	release := make(chan struct{})
+	if err := watcher.Watch(configPath, func(event ChangeEvent) {
+		entered <- struct{}{}
+		<-release
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	// The first event blocks the processor, so the next ones stay queued
+	watcher.eventRing.writeFileChangeFlags(absPath, time.Now(), 2, FileEventModify)
+	<-entered
+	for i := 0; i < 3; i++ {
+		watcher.eventRing.writeFileChangeFlags(absPath, time.Now(), 2, FileEventModify)
+	}
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+
+	report, err := watcher.GracefulShutdownWithReport(5 * time.Second)
+	if err != nil {
+		t.Fatalf("GracefulShutdownWithReport failed: %v", err)
+	}
+	if report == nil {
+		t.Fatal("Expected a report")
+	}
+	if report.TimedOut {
+		t.Error("Expected TimedOut false")
+	}
+	if report.EventsFlushed < 3 {
+		t.Errorf("Expected at least 3 flushed events, got %d", report.EventsFlushed)
+	}
+	if !report.AuditFlushed {
+		t.Error("Expected AuditFlushed true")
+	}
+	if len(report.Subsystems) != 7 || report.Duration <= 0 {
+		t.Errorf("Expected 7 timed subsystems, got %d in %v", len(report.Subsystems), report.Duration)
+	}
+
+	// Already stopped: no report, only the error
+	report, err = watcher.GracefulShutdownWithReport(time.Second)
+	if err == nil || report != nil {
+		t.Errorf("Expected an error and no report for a stopped watcher, got %v and %v", err, report)
+	}
+}
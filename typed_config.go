@@ -0,0 +1,123 @@
+// typed_config.go: Read-mostly typed access to a parsed configuration
+//
+// ConfigBinder fills variables through pointers, which suits a fixed set of
+// settings bound once. For read-mostly access to large configurations,
+// TypedConfig returns values directly and collects conversion errors, so the
+// caller checks a single Err() instead of declaring dozens of locals:
+//
+//	cfg := argus.NewTypedConfig(config)
+//	host := cfg.String("server.host", "localhost")
+//	port := cfg.Int("server.port", 8080)
+//	if err := cfg.Err(); err != nil { ... }
+//
+// Conversions are the ConfigBinder ones, so both APIs accept the same input.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	goerrors "errors"
+	"sync"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// TypedConfig provides typed accessors over a parsed configuration.
+// Accessors return the default (or the zero value) when a key is absent or
+// cannot be converted; conversion errors accumulate and are returned by Err.
+// A TypedConfig is safe for concurrent use.
+type TypedConfig struct {
+	binder *ConfigBinder
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// NewTypedConfig creates a typed accessor over config. Keys use the same
+// dot notation as ConfigBinder.
+func NewTypedConfig(config map[string]interface{}) *TypedConfig {
+	return &TypedConfig{binder: NewConfigBinder(config)}
+}
+
+// Has reports whether key is present in the configuration
+func (tc *TypedConfig) Has(key string) bool {
+	_, exists := tc.binder.getValue(key)
+	return exists
+}
+
+// String returns the value at key as a string
+func (tc *TypedConfig) String(key string, defaultValue ...string) string {
+	value, exists := tc.binder.getValue(key)
+	if !exists {
+		return typedDefault(defaultValue)
+	}
+	return tc.binder.toString(value)
+}
+
+// Int returns the value at key as an int
+func (tc *TypedConfig) Int(key string, defaultValue ...int) int {
+	return typedGet(tc, key, defaultValue, tc.binder.toInt)
+}
+
+// Int64 returns the value at key as an int64
+func (tc *TypedConfig) Int64(key string, defaultValue ...int64) int64 {
+	return typedGet(tc, key, defaultValue, tc.binder.toInt64)
+}
+
+// Bool returns the value at key as a bool
+func (tc *TypedConfig) Bool(key string, defaultValue ...bool) bool {
+	return typedGet(tc, key, defaultValue, tc.binder.toBool)
+}
+
+// Float64 returns the value at key as a float64
+func (tc *TypedConfig) Float64(key string, defaultValue ...float64) float64 {
+	return typedGet(tc, key, defaultValue, tc.binder.toFloat64)
+}
+
+// Duration returns the value at key as a time.Duration
+func (tc *TypedConfig) Duration(key string, defaultValue ...time.Duration) time.Duration {
+	return typedGet(tc, key, defaultValue, tc.binder.toDuration)
+}
+
+// Err returns the conversion errors accumulated so far, joined, or nil.
+// Each error names its key.
+func (tc *TypedConfig) Err() error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	return goerrors.Join(tc.errs...)
+}
+
+// record accumulates a conversion error for key
+func (tc *TypedConfig) record(key string, err error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.errs = append(tc.errs, errors.Wrap(err, ErrCodeInvalidConfig, "failed to read key '"+key+"'"))
+}
+
+// typedGet converts the value at key, falling back to the default when the
+// key is absent or the conversion fails
+func typedGet[T any](tc *TypedConfig, key string, defaultValue []T, convert func(interface{}) (T, error)) T {
+	value, exists := tc.binder.getValue(key)
+	if !exists {
+		return typedDefault(defaultValue)
+	}
+	val, err := convert(value)
+	if err != nil {
+		tc.record(key, err)
+		return typedDefault(defaultValue)
+	}
+	return val
+}
+
+// typedDefault returns the first default, or the zero value
+func typedDefault[T any](defaultValue []T) T {
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	var zero T
+	return zero
+}
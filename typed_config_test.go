@@ -0,0 +1,84 @@
+// typed_config_test.go: Tests for typed configuration accessors
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTypedConfig(t *testing.T) {
+	cfg := NewTypedConfig(map[string]interface{}{
+		"server": map[string]interface{}{
+			"host":    "api.example.com",
+			"port":    9000,
+			"timeout": "15s",
+			"tls":     "true",
+		},
+		"ratio": 0.75,
+		"limit": int64(1 << 40),
+	})
+
+	if got := cfg.String("server.host", "localhost"); got != "api.example.com" {
+		t.Errorf("Expected host api.example.com, got %q", got)
+	}
+	if got := cfg.Int("server.port", 8080); got != 9000 {
+		t.Errorf("Expected port 9000, got %d", got)
+	}
+	if got := cfg.Duration("server.timeout"); got != 15*time.Second {
+		t.Errorf("Expected timeout 15s, got %v", got)
+	}
+	if !cfg.Bool("server.tls") {
+		t.Error("Expected tls true")
+	}
+	if got := cfg.Float64("ratio"); got != 0.75 {
+		t.Errorf("Expected ratio 0.75, got %v", got)
+	}
+	if got := cfg.Int64("limit"); got != 1<<40 {
+		t.Errorf("Expected limit 1<<40, got %d", got)
+	}
+
+	// Absent keys use the default without recording an error
+	if got := cfg.String("server.name", "default"); got != "default" {
+		t.Errorf("Expected default name, got %q", got)
+	}
+	if cfg.Has("server.name") || !cfg.Has("server.port") {
+		t.Error("Unexpected Has result")
+	}
+	if err := cfg.Err(); err != nil {
+		t.Fatalf("Expected no errors yet, got %v", err)
+	}
+}
+
+func TestTypedConfig_AccumulatesErrors(t *testing.T) {
+	cfg := NewTypedConfig(map[string]interface{}{
+		"port":    "not-a-number",
+		"timeout": "forever",
+		"debug":   true,
+	})
+
+	if got := cfg.Int("port", 8080); got != 8080 {
+		t.Errorf("Expected default on conversion error, got %d", got)
+	}
+	if got := cfg.Duration("timeout"); got != 0 {
+		t.Errorf("Expected zero value on conversion error, got %v", got)
+	}
+	if !cfg.Bool("debug") {
+		t.Error("Valid keys must still be readable after errors")
+	}
+
+	err := cfg.Err()
+	if err == nil {
+		t.Fatal("Expected accumulated error")
+	}
+	for _, key := range []string{"'port'", "'timeout'"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Expected error to name key %s, got %v", key, err)
+		}
+	}
+}
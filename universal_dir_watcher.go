@@ -0,0 +1,66 @@
+// universal_dir_watcher.go: Universal watcher over a directory of config files
+//
+// Kubernetes mounts a ConfigMap as a directory with one file per key, and
+// drop-in directories (config.d) split one configuration across files.
+// UniversalConfigWatcherDir presents such a directory as a single
+// configuration: every supported file is parsed and deep-merged, and the
+// callback receives the aggregate whenever a file is added, changed or removed.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"errors"
+)
+
+// supportedConfigPatterns matches every extension DetectFormat recognizes
+var supportedConfigPatterns = []string{
+	"*.json", "*.jsonc", "*.yaml", "*.yml", "*.toml", "*.hcl", "*.tf",
+	"*.ini", "*.conf", "*.cfg", "*.config", "*.properties",
+}
+
+// UniversalConfigWatcherDir watches every supported configuration file in
+// dir (not its subdirectories) and calls callback with their contents
+// deep-merged in file name order: 10-override.yaml overrides 00-base.yaml
+// key by key, nested maps included. The callback fires once with the initial
+// aggregate and again whenever the directory changes. Files created or
+// deleted in the directory join or leave the aggregate on the next poll cycle
+// (every second), so a deleted file's keys are gone from the configuration
+// passed to the callback. Files that fail to parse are skipped until fixed.
+//
+// Example:
+//
+//	watcher, err := argus.UniversalConfigWatcherDir("/etc/myapp/config.d",
+//	    func(config map[string]interface{}) {
+//	        log.Printf("configuration now has %d top-level keys", len(config))
+//	    })
+//	defer watcher.Close()
+func UniversalConfigWatcherDir(dir string, callback func(config map[string]interface{})) (*DirectoryWatcher, error) {
+	return UniversalConfigWatcherDirWithOptions(dir, DirectoryWatchOptions{}, callback)
+}
+
+// UniversalConfigWatcherDirWithOptions is UniversalConfigWatcherDir with
+// custom DirectoryWatchOptions, e.g. a shorter PollInterval or Recursive.
+// Empty Patterns default to every supported configuration format.
+func UniversalConfigWatcherDirWithOptions(dir string, options DirectoryWatchOptions, callback func(config map[string]interface{})) (*DirectoryWatcher, error) {
+	if callback == nil {
+		return nil, errors.New("argus: callback cannot be nil")
+	}
+	if len(options.Patterns) == 0 {
+		options.Patterns = supportedConfigPatterns
+	}
+
+	dw, err := watchDirectoryInternal(dir, options, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	dw.deepMerge = true
+
+	go dw.mergeLoop(func(merged map[string]interface{}, _ []string) {
+		callback(merged)
+	})
+	return dw, nil
+}
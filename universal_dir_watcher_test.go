@@ -0,0 +1,143 @@
+// universal_dir_watcher_test.go: Tests for the directory-wide universal watcher
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// dirConfigRecorder collects the configurations passed to a dir watcher callback
+type dirConfigRecorder struct {
+	mu      sync.Mutex
+	configs []map[string]interface{}
+}
+
+func (r *dirConfigRecorder) record(config map[string]interface{}) {
+	r.mu.Lock()
+	r.configs = append(r.configs, config)
+	r.mu.Unlock()
+}
+
+// waitFor polls until the latest configuration satisfies match
+func (r *dirConfigRecorder) waitFor(t *testing.T, what string, match func(map[string]interface{}) bool) map[string]interface{} {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		var latest map[string]interface{}
+		if len(r.configs) > 0 {
+			latest = r.configs[len(r.configs)-1]
+		}
+		r.mu.Unlock()
+		if latest != nil && match(latest) {
+			return latest
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %s", what)
+	return nil
+}
+
+func writeDirConfig(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
+
+func TestUniversalConfigWatcherDir_MergesAndTracksFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeDirConfig(t, filepath.Join(dir, "00-base.yaml"), "server:\n  host: localhost\n  port: 8080\n")
+	writeDirConfig(t, filepath.Join(dir, "10-override.json"), `{"server": {"port": 9090}}`)
+	writeDirConfig(t, filepath.Join(dir, "README.md"), "not a config file")
+
+	recorder := &dirConfigRecorder{}
+	watcher, err := UniversalConfigWatcherDirWithOptions(dir, DirectoryWatchOptions{
+		PollInterval: 50 * time.Millisecond,
+	}, recorder.record)
+	if err != nil {
+		t.Fatalf("Failed to create dir watcher: %v", err)
+	}
+	defer func() { _ = watcher.Close() }()
+
+	config := recorder.waitFor(t, "initial aggregate", func(c map[string]interface{}) bool { return c["server"] != nil })
+	server, _ := config["server"].(map[string]interface{})
+	if server["host"] != "localhost" || server["port"] != float64(9090) {
+		t.Errorf("Expected deep merge host=localhost port=9090, got %v", server)
+	}
+
+	// New file joins the aggregate
+	logPath := filepath.Join(dir, "20-logging.properties")
+	writeDirConfig(t, logPath, "log.level=debug\n")
+	recorder.waitFor(t, "new file", func(c map[string]interface{}) bool { return c["log.level"] == "debug" })
+
+	// Deleted file leaves the aggregate
+	if err := os.Remove(filepath.Join(dir, "10-override.json")); err != nil {
+		t.Fatalf("Failed to remove file: %v", err)
+	}
+	config = recorder.waitFor(t, "deleted file", func(c map[string]interface{}) bool {
+		s, _ := c["server"].(map[string]interface{})
+		return s["port"] == 8080
+	})
+	if config["log.level"] != "debug" {
+		t.Errorf("Expected remaining files to stay merged, got %v", config)
+	}
+}
+
+func TestUniversalConfigWatcherDir_ConfigMapSymlinkSwap(t *testing.T) {
+	dir := t.TempDir()
+
+	// Kubernetes layout: config.yaml -> ..data/config.yaml, ..data -> ..v1
+	writeVersion := func(name, data string) {
+		if err := os.Mkdir(filepath.Join(dir, name), 0700); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+		writeDirConfig(t, filepath.Join(dir, name, "config.yaml"), data)
+	}
+	writeVersion("..v1", "mode: blue\n")
+	if err := os.Symlink("..v1", filepath.Join(dir, "..data")); err != nil {
+		t.Skipf("Cannot create symlinks on this system: %v", err)
+	}
+	if err := os.Symlink(filepath.Join("..data", "config.yaml"), filepath.Join(dir, "config.yaml")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+
+	recorder := &dirConfigRecorder{}
+	watcher, err := UniversalConfigWatcherDirWithOptions(dir, DirectoryWatchOptions{
+		PollInterval: 50 * time.Millisecond,
+	}, recorder.record)
+	if err != nil {
+		t.Fatalf("Failed to create dir watcher: %v", err)
+	}
+	defer func() { _ = watcher.Close() }()
+	recorder.waitFor(t, "initial config", func(c map[string]interface{}) bool { return c["mode"] == "blue" })
+
+	// Atomic update: new version directory, then swap the ..data link
+	time.Sleep(10 * time.Millisecond) // Distinct modification time
+	writeVersion("..v2", "mode: green\n")
+	tmpLink := filepath.Join(dir, "..data_tmp")
+	if err := os.Symlink("..v2", tmpLink); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+	if err := os.Rename(tmpLink, filepath.Join(dir, "..data")); err != nil {
+		t.Fatalf("Failed to swap symlink: %v", err)
+	}
+	recorder.waitFor(t, "swapped config", func(c map[string]interface{}) bool { return c["mode"] == "green" })
+}
+
+func TestUniversalConfigWatcherDir_InvalidInput(t *testing.T) {
+	if _, err := UniversalConfigWatcherDir(t.TempDir(), nil); err == nil {
+		t.Error("Expected an error for a nil callback")
+	}
+	if _, err := UniversalConfigWatcherDir(filepath.Join(t.TempDir(), "missing"), func(map[string]interface{}) {}); err == nil {
+		t.Error("Expected an error for a missing directory")
+	}
+}
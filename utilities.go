@@ -7,8 +7,10 @@
 package argus
 
 import (
+	"io"
 	"log"
 	"os"
+	"time"
 
 	"github.com/agilira/go-errors"
 )
@@ -54,13 +56,94 @@ func UniversalConfigWatcher(configPath string, callback func(config map[string]i
 // Returns:
 //   - *Watcher: Configured and started watcher
 //   - error: Any initialization or file access errors
+//
+// The path "-" reads the configuration once from stdin (format detected from
+// the content) and invokes the callback a single time; stdin is never watched.
 func UniversalConfigWatcherWithConfig(configPath string, callback func(config map[string]interface{}), config Config) (*Watcher, error) {
+	return watchUniversalDetected(configPath, ignoreMeta(callback), config, nil)
+}
+
+// ConfigMeta describes the file behind a configuration delivered by
+// UniversalConfigWatcherDetailed. It is passed by value, so callbacks cost
+// no extra allocation beyond the file content itself.
+type ConfigMeta struct {
+	// Format is the format the file was parsed as
+	Format ConfigFormat
+
+	// RawBytes is the file content as read, before parsing or environment
+	// expansion. Each callback receives its own slice, safe to retain.
+	RawBytes []byte
+
+	// ModTime is the file's modification time (zero for stdin)
+	ModTime time.Time
+}
+
+// UniversalConfigWatcherDetailed is UniversalConfigWatcher with a callback
+// that also receives the parsed format, the raw file content and the
+// modification time, e.g. to hash or forward the original bytes without
+// reading the file a second time.
+//
+// Example:
+//
+//	watcher, err := argus.UniversalConfigWatcherDetailed("config.yaml",
+//	    func(config map[string]interface{}, meta argus.ConfigMeta) {
+//	        sum := sha256.Sum256(meta.RawBytes)
+//	        log.Printf("%s config %x loaded (modified %v)", meta.Format, sum[:4], meta.ModTime)
+//	    })
+func UniversalConfigWatcherDetailed(configPath string, callback func(config map[string]interface{}, meta ConfigMeta)) (*Watcher, error) {
+	return watchUniversalDetected(configPath, callback, Config{}, nil)
+}
+
+// ignoreMeta adapts a plain configuration callback to the detailed form
+func ignoreMeta(callback func(config map[string]interface{})) func(map[string]interface{}, ConfigMeta) {
+	return func(config map[string]interface{}, _ ConfigMeta) {
+		callback(config)
+	}
+}
+
+// configValidator rejects a configuration before it reaches the callback
+type configValidator func(config map[string]interface{}) error
+
+// watchUniversalDetected watches configPath with the format detected from
+// its extension; StdinPath is read once with the format detected from content.
+// A non-nil validate vets every configuration before delivery.
+func watchUniversalDetected(configPath string, callback func(config map[string]interface{}, meta ConfigMeta), config Config, validate configValidator) (*Watcher, error) {
+	if configPath == StdinPath {
+		return loadStdinOnce(callback, config, FormatUnknown, validate)
+	}
+
 	// Detect format from file extension
 	format := DetectFormat(configPath)
 	if format == FormatUnknown {
 		return nil, errors.New(ErrCodeConfigNotFound, "unsupported config format for file: "+configPath)
 	}
 
+	return watchUniversal(configPath, format, callback, config, validate)
+}
+
+// UniversalConfigWatcherWithFormat creates a watcher that parses the file as
+// format regardless of its extension, for extensionless files such as
+// /etc/myapp/config or Docker secrets, or a .txt file holding JSON.
+// The path "-" reads stdin once, parsed as format.
+//
+// Example:
+//
+//	watcher, err := argus.UniversalConfigWatcherWithFormat("/run/secrets/app", argus.FormatJSON,
+//	    func(config map[string]interface{}) {
+//	        // config parsed as JSON
+//	    })
+func UniversalConfigWatcherWithFormat(configPath string, format ConfigFormat, callback func(config map[string]interface{})) (*Watcher, error) {
+	if format < FormatJSON || format >= FormatUnknown {
+		return nil, errors.New(ErrCodeInvalidConfig, "UniversalConfigWatcherWithFormat requires a known format, got "+format.String())
+	}
+	if configPath == StdinPath {
+		return loadStdinOnce(ignoreMeta(callback), Config{}, format, nil)
+	}
+	return watchUniversal(configPath, format, ignoreMeta(callback), Config{}, nil)
+}
+
+// watchUniversal watches configPath, parsing it as format on every change
+func watchUniversal(configPath string, format ConfigFormat, callback func(config map[string]interface{}, meta ConfigMeta), config Config, validate configValidator) (*Watcher, error) {
 	// Configure watcher
 	watcher := setupUniversalWatcher(config)
 
@@ -68,7 +151,7 @@ func UniversalConfigWatcherWithConfig(configPath string, callback func(config ma
 	var currentConfig map[string]interface{}
 
 	// Create watch callback
-	watchCallback := createUniversalWatchCallback(format, callback, watcher, &currentConfig)
+	watchCallback := createUniversalWatchCallback(format, callback, watcher, &currentConfig, validate)
 
 	// Setup file watching
 	if err := watcher.Watch(configPath, watchCallback); err != nil {
@@ -76,7 +159,7 @@ func UniversalConfigWatcherWithConfig(configPath string, callback func(config ma
 	}
 
 	// Initialize and start watcher
-	if err := initializeUniversalWatcher(watcher, configPath, format, callback, &currentConfig); err != nil {
+	if err := initializeUniversalWatcher(watcher, configPath, format, callback, &currentConfig, validate); err != nil {
 		return nil, err
 	}
 
@@ -91,11 +174,13 @@ func setupUniversalWatcher(config Config) *Watcher {
 			log.Printf("Argus: error in file %s: %v", path, err)
 		}
 	}
+	// The initial configuration is delivered by initializeUniversalWatcher
+	config.FireOnStart = false
 	return New(config)
 }
 
 // createUniversalWatchCallback creates the file change callback
-func createUniversalWatchCallback(format ConfigFormat, callback func(config map[string]interface{}), watcher *Watcher, currentConfig *map[string]interface{}) func(ChangeEvent) {
+func createUniversalWatchCallback(format ConfigFormat, callback func(config map[string]interface{}, meta ConfigMeta), watcher *Watcher, currentConfig *map[string]interface{}, validate configValidator) func(ChangeEvent) {
 	return func(event ChangeEvent) {
 		if event.IsDelete {
 			// AUDIT: Log file deletion
@@ -105,14 +190,27 @@ func createUniversalWatchCallback(format ConfigFormat, callback func(config map[
 			return
 		}
 
-		newConfig, err := readAndParseConfig(event.Path, format)
+		newConfig, data, err := watcher.readWatchedConfig(event.Path, format)
+		if err == nil {
+			err = watcher.checkNotEmpty(event.Path, data)
+		}
 		if err != nil {
-			if watcher.config.ErrorHandler != nil {
-				watcher.config.ErrorHandler(err, event.Path)
-			}
+			watcher.rejectConfig(event.Path, err)
 			return
 		}
 
+		// Keep the last valid configuration when the new one is rejected
+		if validate != nil {
+			if err := validate(newConfig); err != nil {
+				if auditor := watcher.auditLogger; auditor != nil {
+					auditor.Log(AuditWarn, "config_rejected", "argus", event.Path, nil, nil, map[string]interface{}{"error": err.Error()})
+				}
+				watcher.rejectConfig(event.Path, err)
+				return
+			}
+		}
+		watcher.keepLastGood(event.Path, newConfig)
+
 		// AUDIT: Log configuration change with before/after values
 		if auditor := watcher.auditLogger; auditor != nil {
 			auditor.LogConfigChange(event.Path, *currentConfig, newConfig)
@@ -121,39 +219,102 @@ func createUniversalWatchCallback(format ConfigFormat, callback func(config map[
 		// Update current config for next comparison
 		*currentConfig = copyMap(newConfig)
 
-		callback(newConfig)
+		callback(newConfig, ConfigMeta{Format: format, RawBytes: data, ModTime: event.ModTime})
+	}
+}
+
+// readWatchedConfig reads and parses a config file with the watcher's
+// size limit, strictness, key normalization, environment expansion and
+// in-process overrides. The raw file content is returned alongside.
+func (w *Watcher) readWatchedConfig(path string, format ConfigFormat) (map[string]interface{}, []byte, error) {
+	config, data, err := readAndParseConfigRaw(path, format, w.config.MaxFileSize, w.config.ParseStrictness)
+	if err != nil {
+		return nil, nil, err
 	}
+	if config, err = NormalizeKeys(config, w.config.NormalizeKeys); err != nil {
+		return nil, nil, err
+	}
+	if config, err = ExpandEnvVars(config, w.config.ExpandEnv); err != nil {
+		return nil, nil, err
+	}
+	return w.applyOverrides(path, config), data, nil
+}
+
+// readAndParseConfig reads and parses a config file with the given strictness.
+// When maxSize is positive, files larger than maxSize bytes are rejected
+// without being read into memory.
+func readAndParseConfig(path string, format ConfigFormat, maxSize int64, strictness ParseStrictness) (map[string]interface{}, error) {
+	config, _, err := readAndParseConfigRaw(path, format, maxSize, strictness)
+	return config, err
 }
 
-// readAndParseConfig reads and parses a config file
-func readAndParseConfig(path string, format ConfigFormat) (map[string]interface{}, error) {
+// readAndParseConfigRaw is readAndParseConfig also returning the raw content
+func readAndParseConfigRaw(path string, format ConfigFormat, maxSize int64, strictness ParseStrictness) (map[string]interface{}, []byte, error) {
 	// SECURITY: Validate path to prevent directory traversal attacks
 	if err := ValidateSecurePath(path); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	data, err := readFileLimited(path, maxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newConfig, err := ParseConfigWithStrictness(data, format, strictness)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, ErrCodeInvalidConfig, "failed to parse "+format.String()+" config")
+	}
+
+	return newConfig, data, nil
+}
+
+// readFileLimited reads a file, refusing files larger than maxSize bytes (0 = no limit).
+// The read itself is bounded too, so a file growing after the size check cannot
+// bypass the limit.
+func readFileLimited(path string, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		// #nosec G304 -- Path validated by callers with ValidateSecurePath
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrCodeFileNotFound, "failed to read config file")
+		}
+		return data, nil
 	}
 
-	// #nosec G304 -- Path validation performed above with ValidateSecurePath
-	data, err := os.ReadFile(path)
+	// #nosec G304 -- Path validated by callers with ValidateSecurePath
+	file, err := os.Open(path)
 	if err != nil {
 		return nil, errors.Wrap(err, ErrCodeFileNotFound, "failed to read config file")
 	}
+	defer func() { _ = file.Close() }()
 
-	newConfig, err := ParseConfig(data, format)
+	data, err := io.ReadAll(io.LimitReader(file, maxSize+1))
 	if err != nil {
-		return nil, errors.Wrap(err, ErrCodeInvalidConfig, "failed to parse "+format.String()+" config")
+		return nil, errors.Wrap(err, ErrCodeFileNotFound, "failed to read config file")
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.New(ErrCodeFileTooLarge, "config file exceeds maximum size").
+			WithContext("path", path).
+			WithContext("max_file_size", maxSize)
 	}
 
-	return newConfig, nil
+	return data, nil
 }
 
 // initializeUniversalWatcher loads initial config and starts watching
-func initializeUniversalWatcher(watcher *Watcher, configPath string, format ConfigFormat, callback func(config map[string]interface{}), currentConfig *map[string]interface{}) error {
+func initializeUniversalWatcher(watcher *Watcher, configPath string, format ConfigFormat, callback func(config map[string]interface{}, meta ConfigMeta), currentConfig *map[string]interface{}, validate configValidator) error {
 	// Load initial configuration and start watcher
-	if _, err := os.Stat(configPath); err == nil {
-		initialConfig, err := readAndParseConfig(configPath, format) // #nosec G304 -- configPath is user-provided intentionally
+	if info, err := os.Stat(configPath); err == nil {
+		initialConfig, data, err := watcher.readWatchedConfig(configPath, format) // #nosec G304 -- configPath is user-provided intentionally
 		if err != nil {
 			return errors.Wrap(err, ErrCodeInvalidConfig, "failed to read initial config")
 		}
+		if validate != nil {
+			if err := validate(initialConfig); err != nil {
+				return err
+			}
+		}
+		watcher.keepLastGood(configPath, initialConfig)
 
 		// Set current config for audit trail
 		*currentConfig = copyMap(initialConfig)
@@ -164,7 +325,7 @@ func initializeUniversalWatcher(watcher *Watcher, configPath string, format Conf
 		}
 
 		// Call callback with initial config
-		callback(initialConfig)
+		callback(initialConfig, ConfigMeta{Format: format, RawBytes: data, ModTime: info.ModTime()})
 	} else {
 		// File doesn't exist yet, start watcher anyway
 		if err := watcher.Start(); err != nil {
@@ -329,3 +329,97 @@ func TestUtilitiesFunctions(t *testing.T) {
 		}
 	})
 }
+
+func TestUniversalConfigWatcherWithFormat(t *testing.T) {
+	tempDir := t.TempDir()
+	path := tempDir + "/config" // No extension
+	if err := os.WriteFile(path, []byte(`{"port": 8080}`), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	if _, err := UniversalConfigWatcher(path, func(map[string]interface{}) {}); err == nil {
+		t.Fatal("Expected extension detection to fail for an extensionless file")
+	}
+
+	configs := make(chan map[string]interface{}, 4)
+	watcher, err := UniversalConfigWatcherWithFormat(path, FormatJSON, func(config map[string]interface{}) {
+		configs <- config
+	})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	select {
+	case config := <-configs:
+		if config["port"] != float64(8080) {
+			t.Errorf("Expected port 8080 parsed as JSON, got %v", config["port"])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for the initial configuration")
+	}
+
+	for _, format := range []ConfigFormat{FormatUnknown, ConfigFormat(-1)} {
+		if _, err := UniversalConfigWatcherWithFormat(path, format, func(map[string]interface{}) {}); err == nil {
+			t.Errorf("Expected an error for format %v", format)
+		}
+	}
+}
+
+func TestUniversalConfigWatcherDetailed(t *testing.T) {
+	path := t.TempDir() + "/config.yaml"
+	initial := []byte("port: 8080\n")
+	if err := os.WriteFile(path, initial, 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Failed to stat test file: %v", err)
+	}
+
+	type delivery struct {
+		config map[string]interface{}
+		meta   ConfigMeta
+	}
+	deliveries := make(chan delivery, 4)
+	// UniversalConfigWatcherDetailed with a short poll interval
+	watcher, err := watchUniversalDetected(path, func(config map[string]interface{}, meta ConfigMeta) {
+		deliveries <- delivery{config, meta}
+	}, Config{PollInterval: 50 * time.Millisecond}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	receive := func() delivery {
+		t.Helper()
+		select {
+		case d := <-deliveries:
+			return d
+		case <-time.After(3 * time.Second):
+			t.Fatal("Timed out waiting for the callback")
+			return delivery{}
+		}
+	}
+
+	d := receive()
+	if d.meta.Format != FormatYAML || string(d.meta.RawBytes) != string(initial) {
+		t.Errorf("Expected YAML meta with raw content %q, got %v %q", initial, d.meta.Format, d.meta.RawBytes)
+	}
+	if !d.meta.ModTime.Equal(info.ModTime()) {
+		t.Errorf("Expected mod time %v, got %v", info.ModTime(), d.meta.ModTime)
+	}
+
+	time.Sleep(50 * time.Millisecond) // Distinct modification time
+	updated := []byte("port: 9090\n")
+	if err := os.WriteFile(path, updated, 0600); err != nil {
+		t.Fatalf("Failed to update test file: %v", err)
+	}
+	d = receive()
+	if d.config["port"] != 9090 || string(d.meta.RawBytes) != string(updated) {
+		t.Errorf("Expected updated config and raw content, got %v %q", d.config["port"], d.meta.RawBytes)
+	}
+	if d.meta.ModTime.IsZero() {
+		t.Error("Expected a modification time for the change")
+	}
+}
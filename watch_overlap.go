@@ -0,0 +1,214 @@
+// watch_overlap.go: Detection of overlapping file and directory watches
+//
+// A file watched on its own and also covered by a directory watch is reported
+// twice: once by the Watcher callback and once by the DirectoryWatcher. The
+// same happens for a directory nested in a recursive directory watch. The
+// overlap is easy to create by accident in setups that mix both styles, so
+// registrations are checked against a process-wide registry of active
+// watches and the overlap is reported as a warning or an error.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"weak"
+
+	"github.com/agilira/go-errors"
+)
+
+// OverlapPolicy selects how an overlapping watch registration is reported
+type OverlapPolicy int
+
+const (
+	// OverlapWarn registers the watch and reports the overlap through the
+	// ErrorHandler, or the standard logger when none is set (default)
+	OverlapWarn OverlapPolicy = iota
+
+	// OverlapError rejects the registration with ErrCodeWatchOverlap
+	OverlapError
+
+	// OverlapIgnore registers the watch without any report, for setups
+	// that overlap on purpose
+	OverlapIgnore
+)
+
+// String returns the name of the overlap policy
+func (p OverlapPolicy) String() string {
+	switch p {
+	case OverlapWarn:
+		return "warn"
+	case OverlapError:
+		return "error"
+	case OverlapIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+// dirWatchEntry describes an active directory watch
+type dirWatchEntry struct {
+	dir       string // Absolute, cleaned
+	recursive bool
+	patterns  []string
+}
+
+// watchRegistry tracks active watches of the process. Watchers are held
+// weakly, so a watcher dropped without Stop does not leak or keep reporting
+// overlaps. Lock order: mu is taken before a Watcher's filesMu.
+var watchRegistry = struct {
+	mu       sync.Mutex
+	dirs     map[*DirectoryWatcher]dirWatchEntry
+	watchers map[weak.Pointer[Watcher]]struct{}
+}{
+	dirs:     make(map[*DirectoryWatcher]dirWatchEntry),
+	watchers: make(map[weak.Pointer[Watcher]]struct{}),
+}
+
+// covers reports whether the directory watch reports changes of file
+func (e dirWatchEntry) covers(file string) bool {
+	rel, ok := relativeTo(e.dir, file)
+	if !ok || rel == "." {
+		return false
+	}
+	if !e.recursive && strings.ContainsRune(rel, filepath.Separator) {
+		return false
+	}
+	base := filepath.Base(file)
+	for _, pattern := range e.patterns {
+		if m, _ := filepath.Match(pattern, base); m {
+			return true
+		}
+	}
+	return false
+}
+
+// coversDir reports whether the directory watch reports changes of files in dir
+func (e dirWatchEntry) coversDir(dir string) bool {
+	rel, ok := relativeTo(e.dir, dir)
+	return ok && (rel == "." || e.recursive)
+}
+
+// relativeTo returns path relative to dir, and false when path is outside dir
+func relativeTo(dir, path string) (string, bool) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// fileOverlap describes the directory watches covering absPath, or "" if none
+func fileOverlap(absPath string) string {
+	watchRegistry.mu.Lock()
+	defer watchRegistry.mu.Unlock()
+
+	var dirs []string
+	for _, entry := range watchRegistry.dirs {
+		if entry.covers(absPath) {
+			dirs = append(dirs, describeDirWatch(entry))
+		}
+	}
+	if len(dirs) == 0 {
+		return ""
+	}
+	sort.Strings(dirs)
+	return fmt.Sprintf("file %s is already covered by directory watch %s; changes will be reported twice",
+		absPath, strings.Join(dirs, ", "))
+}
+
+// dirOverlap describes the file and directory watches overlapping entry, or "" if none
+func dirOverlap(entry dirWatchEntry) string {
+	watchRegistry.mu.Lock()
+	defer watchRegistry.mu.Unlock()
+
+	var overlaps []string
+	for _, other := range watchRegistry.dirs {
+		if other.coversDir(entry.dir) || entry.coversDir(other.dir) {
+			overlaps = append(overlaps, "directory watch "+describeDirWatch(other))
+		}
+	}
+	for wp := range watchRegistry.watchers {
+		w := wp.Value()
+		if w == nil {
+			delete(watchRegistry.watchers, wp) // Collected without Stop
+			continue
+		}
+		w.filesMu.RLock()
+		for file := range w.files {
+			if entry.covers(file) {
+				overlaps = append(overlaps, "file watch "+file)
+			}
+		}
+		w.filesMu.RUnlock()
+	}
+	if len(overlaps) == 0 {
+		return ""
+	}
+	sort.Strings(overlaps)
+	return fmt.Sprintf("directory watch %s overlaps %s; changes will be reported twice",
+		describeDirWatch(entry), strings.Join(overlaps, ", "))
+}
+
+// describeDirWatch formats a directory watch for overlap messages
+func describeDirWatch(entry dirWatchEntry) string {
+	if entry.recursive {
+		return entry.dir + " (recursive)"
+	}
+	return entry.dir
+}
+
+// reportOverlap applies policy to an overlap message. It returns the error
+// rejecting the registration under OverlapError, nil otherwise.
+func reportOverlap(policy OverlapPolicy, msg, path string, handler ErrorHandler) error {
+	if msg == "" || policy == OverlapIgnore {
+		return nil
+	}
+	err := errors.New(ErrCodeWatchOverlap, msg).WithContext("path", path)
+	if policy == OverlapError {
+		return err
+	}
+	if handler != nil {
+		handler(err, path)
+	} else {
+		log.Printf("argus: warning: %s", msg)
+	}
+	return nil
+}
+
+// registerWatcher makes the files of w visible to directory watch checks
+func registerWatcher(w *Watcher) {
+	watchRegistry.mu.Lock()
+	watchRegistry.watchers[weak.Make(w)] = struct{}{}
+	watchRegistry.mu.Unlock()
+}
+
+// unregisterWatcher drops a stopped watcher from overlap checks
+func unregisterWatcher(w *Watcher) {
+	watchRegistry.mu.Lock()
+	delete(watchRegistry.watchers, weak.Make(w))
+	watchRegistry.mu.Unlock()
+}
+
+// registerDirWatch records an active directory watch
+func registerDirWatch(dw *DirectoryWatcher, entry dirWatchEntry) {
+	watchRegistry.mu.Lock()
+	watchRegistry.dirs[dw] = entry
+	watchRegistry.mu.Unlock()
+}
+
+// unregisterDirWatch drops a closed directory watch
+func unregisterDirWatch(dw *DirectoryWatcher) {
+	watchRegistry.mu.Lock()
+	delete(watchRegistry.dirs, dw)
+	watchRegistry.mu.Unlock()
+}
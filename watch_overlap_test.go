@@ -0,0 +1,165 @@
+// watch_overlap_test.go: Tests for overlapping file and directory watches
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// newOverlapFixture creates dir/app.yaml and dir/sub/db.yaml
+func newOverlapFixture(t *testing.T) (dir, appFile, nestedFile string) {
+	t.Helper()
+	dir = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0750); err != nil {
+		t.Fatalf("Failed to create subdirectory: %v", err)
+	}
+	appFile = filepath.Join(dir, "app.yaml")
+	nestedFile = filepath.Join(dir, "sub", "db.yaml")
+	for _, path := range []string{appFile, nestedFile} {
+		if err := os.WriteFile(path, []byte("key: value\n"), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+	}
+	return dir, appFile, nestedFile
+}
+
+func watchTestDirectory(t *testing.T, dir string, options DirectoryWatchOptions) *DirectoryWatcher {
+	t.Helper()
+	options.PollInterval = time.Hour
+	dw, err := WatchDirectory(dir, options, func(DirectoryConfigUpdate) {})
+	if err != nil {
+		t.Fatalf("Failed to watch directory: %v", err)
+	}
+	t.Cleanup(func() { _ = dw.Close() })
+	return dw
+}
+
+func TestWatchOverlap_FileCoveredByDirectory(t *testing.T) {
+	dir, appFile, _ := newOverlapFixture(t)
+	watchTestDirectory(t, dir, DirectoryWatchOptions{Patterns: []string{"*.yaml"}})
+
+	t.Run("error", func(t *testing.T) {
+		watcher := New(Config{DisableAudit: true, WatchOverlap: OverlapError})
+		err := watcher.Watch(appFile, func(ChangeEvent) {})
+		if !errors.HasCode(err, ErrCodeWatchOverlap) {
+			t.Fatalf("Expected ErrCodeWatchOverlap, got %v", err)
+		}
+		if !strings.Contains(err.Error(), appFile) || !strings.Contains(err.Error(), dir) {
+			t.Errorf("Expected message naming file and directory, got %v", err)
+		}
+		if watcher.WatchedFiles() != 0 {
+			t.Error("Expected the overlapping watch to be rejected")
+		}
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		var warnings []error
+		watcher := New(Config{
+			DisableAudit: true,
+			ErrorHandler: func(err error, path string) { warnings = append(warnings, err) },
+		})
+		if err := watcher.Watch(appFile, func(ChangeEvent) {}); err != nil {
+			t.Fatalf("Expected warn policy to register the watch, got %v", err)
+		}
+		if len(warnings) != 1 || !errors.HasCode(warnings[0], ErrCodeWatchOverlap) {
+			t.Errorf("Expected one overlap warning, got %v", warnings)
+		}
+	})
+
+	t.Run("ignore", func(t *testing.T) {
+		var warnings int
+		watcher := New(Config{
+			DisableAudit: true,
+			WatchOverlap: OverlapIgnore,
+			ErrorHandler: func(error, string) { warnings++ },
+		})
+		if err := watcher.Watch(appFile, func(ChangeEvent) {}); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+		if warnings != 0 {
+			t.Errorf("Expected no warnings, got %d", warnings)
+		}
+	})
+}
+
+func TestWatchOverlap_Coverage(t *testing.T) {
+	dir, appFile, nestedFile := newOverlapFixture(t)
+	otherFile := filepath.Join(t.TempDir(), "app.yaml")
+
+	tests := []struct {
+		name    string
+		options DirectoryWatchOptions
+		file    string
+		want    bool
+	}{
+		{"direct child", DirectoryWatchOptions{}, appFile, true},
+		{"nested, not recursive", DirectoryWatchOptions{}, nestedFile, false},
+		{"nested, recursive", DirectoryWatchOptions{Recursive: true}, nestedFile, true},
+		{"pattern mismatch", DirectoryWatchOptions{Patterns: []string{"*.json"}}, appFile, false},
+		{"outside directory", DirectoryWatchOptions{Recursive: true}, otherFile, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dw := watchTestDirectory(t, dir, tt.options)
+			defer func() { _ = dw.Close() }()
+
+			got := fileOverlap(tt.file) != ""
+			if got != tt.want {
+				t.Errorf("Expected overlap %v for %s", tt.want, tt.file)
+			}
+		})
+	}
+}
+
+func TestWatchOverlap_DirectoryCoveringWatchedFile(t *testing.T) {
+	dir, _, nestedFile := newOverlapFixture(t)
+
+	watcher := New(Config{DisableAudit: true})
+	if err := watcher.Watch(nestedFile, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	_, err := WatchDirectory(dir, DirectoryWatchOptions{Recursive: true, Overlap: OverlapError},
+		func(DirectoryConfigUpdate) {})
+	if !errors.HasCode(err, ErrCodeWatchOverlap) || !strings.Contains(err.Error(), nestedFile) {
+		t.Fatalf("Expected overlap error naming %s, got %v", nestedFile, err)
+	}
+
+	// A stopped watcher no longer overlaps
+	if err := watcher.Stop(); err != nil {
+		t.Fatalf("Failed to stop watcher: %v", err)
+	}
+	watchTestDirectory(t, dir, DirectoryWatchOptions{Recursive: true, Overlap: OverlapError})
+}
+
+func TestWatchOverlap_NestedDirectories(t *testing.T) {
+	dir, _, _ := newOverlapFixture(t)
+	watchTestDirectory(t, dir, DirectoryWatchOptions{Recursive: true})
+
+	_, err := WatchDirectory(filepath.Join(dir, "sub"), DirectoryWatchOptions{Overlap: OverlapError},
+		func(DirectoryConfigUpdate) {})
+	if !errors.HasCode(err, ErrCodeWatchOverlap) {
+		t.Errorf("Expected overlap error for a directory inside a recursive watch, got %v", err)
+	}
+}
+
+func TestWatchOverlap_InvalidPolicy(t *testing.T) {
+	config := Config{WatchOverlap: OverlapPolicy(42)}
+	if err := config.WithDefaults().Validate(); err != ErrInvalidWatchOverlap {
+		t.Errorf("Expected ErrInvalidWatchOverlap, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+// watcher_callback_pool.go: Concurrent callback delivery across paths
+//
+// BoreasLite has a single consumer, so by default one blocking callback holds
+// up the events of every other file. With Config.CallbackConcurrency the
+// consumer hands events to a bounded pool instead: each path with queued
+// events is owned by one worker until its queue is empty, so a path's events
+// are still delivered one at a time and in order, while different paths
+// proceed in parallel.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "sync"
+
+// callbackPool delivers events on up to cap(slots) goroutines, one per path
+type callbackPool struct {
+	w       *Watcher
+	slots   chan struct{} // One token per running worker
+	pending chan struct{} // One token per queued event, bounds the backlog
+
+	mu     sync.Mutex
+	queues map[string][]ChangeEvent // Events not yet delivered, by active path
+	closed bool                     // Set by close: dispatch drops events
+}
+
+// newCallbackPool creates a pool of up to workers goroutines holding at most
+// backlog undelivered events. Workers are started on demand and exit once
+// their path has nothing left to deliver.
+func newCallbackPool(w *Watcher, workers int, backlog int64) *callbackPool {
+	return &callbackPool{
+		w:       w,
+		slots:   make(chan struct{}, workers),
+		pending: make(chan struct{}, backlog),
+		queues:  make(map[string][]ChangeEvent),
+	}
+}
+
+// dispatch queues event behind the undelivered events of its path and starts
+// a worker for the path if none owns it. It blocks while the backlog is full
+// or every worker is busy, which pushes back on the ring buffer like a slow
+// callback does without a pool.
+func (p *callbackPool) dispatch(event ChangeEvent) {
+	p.pending <- struct{}{}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		<-p.pending
+		return
+	}
+	p.w.callbacksInFlight.Add(1)
+	queue, active := p.queues[event.Path]
+	p.queues[event.Path] = append(queue, event)
+	p.mu.Unlock()
+	if active {
+		return
+	}
+
+	p.slots <- struct{}{}
+	go p.drain(event.Path)
+}
+
+// drain delivers the queued events of path in order until none is left
+func (p *callbackPool) drain(path string) {
+	defer func() { <-p.slots }()
+
+	for {
+		p.mu.Lock()
+		queue := p.queues[path]
+		if len(queue) == 0 {
+			delete(p.queues, path)
+			p.mu.Unlock()
+			return
+		}
+		event := queue[0]
+		p.queues[path] = queue[1:]
+		p.mu.Unlock()
+
+		p.deliver(event)
+		p.w.callbacksInFlight.Add(-1)
+		<-p.pending
+	}
+}
+
+// close drops the events not yet handed to a callback and makes dispatch
+// drop later ones. Workers exit once their current delivery returns; wait
+// for them with Watcher.waitCallbacks.
+func (p *callbackPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for path, queue := range p.queues {
+		for range queue {
+			p.w.callbacksInFlight.Add(-1)
+			<-p.pending
+		}
+		p.queues[path] = nil // The worker owning path finds it empty and exits
+	}
+}
+
+// deliver delivers event, recovering from a panic so the worker keeps
+// draining its path
+func (p *callbackPool) deliver(event ChangeEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.w.reportCallbackPanic(event.Path, r)
+		}
+	}()
+	p.w.deliverFileEvent(event)
+}
@@ -0,0 +1,208 @@
+// watcher_callback_pool_test.go: Tests for concurrent callback delivery
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// createPoolTestFiles creates n config files and returns their absolute paths
+func createPoolTestFiles(t *testing.T, n int) []string {
+	t.Helper()
+	dir := t.TempDir()
+	paths := make([]string, n)
+	for i := range paths {
+		path, err := filepath.Abs(filepath.Join(dir, string(rune('a'+i))+".json"))
+		if err != nil {
+			t.Fatalf("Failed to resolve path: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(`{}`), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+		paths[i] = path
+	}
+	return paths
+}
+
+// sendPoolEvent feeds a change of path to the watcher as the event processor would
+func sendPoolEvent(w *Watcher, path string, seq int64) {
+	event := ConvertChangeEventToFileEvent(ChangeEvent{Path: path, Size: seq, IsModify: true})
+	w.processFileEvent(&event)
+}
+
+func TestCallbackConcurrency_PerPathOrdering(t *testing.T) {
+	const events = 200
+	paths := createPoolTestFiles(t, 3)
+	watcher := New(Config{PollInterval: time.Hour, CallbackConcurrency: 4, DisableAudit: true})
+	defer watcher.Close()
+
+	var mu sync.Mutex
+	received := make(map[string][]int64)
+	var overlaps atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(events * len(paths))
+	for _, path := range paths {
+		var running atomic.Int32
+		if err := watcher.Watch(path, func(event ChangeEvent) {
+			defer wg.Done()
+			if running.Add(1) > 1 {
+				overlaps.Add(1)
+			}
+			time.Sleep(50 * time.Microsecond)
+			mu.Lock()
+			received[event.Path] = append(received[event.Path], event.Size)
+			mu.Unlock()
+			running.Add(-1)
+		}); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+
+	for seq := int64(0); seq < events; seq++ {
+		for _, path := range paths {
+			sendPoolEvent(watcher, path, seq)
+		}
+	}
+	wg.Wait()
+
+	if n := overlaps.Load(); n != 0 {
+		t.Errorf("Expected callbacks of one path never to overlap, got %d overlaps", n)
+	}
+	for _, path := range paths {
+		seqs := received[path]
+		if len(seqs) != events {
+			t.Fatalf("Expected %d events for %s, got %d", events, path, len(seqs))
+		}
+		for i, seq := range seqs {
+			if seq != int64(i) {
+				t.Fatalf("Expected events of %s in order, got %d at position %d", path, seq, i)
+			}
+		}
+	}
+	if inFlight := watcher.callbacksInFlight.Load(); inFlight != 0 {
+		t.Errorf("Expected no callbacks in flight after delivery, got %d", inFlight)
+	}
+}
+
+func TestCallbackConcurrency_SlowPathDoesNotBlockOthers(t *testing.T) {
+	paths := createPoolTestFiles(t, 2)
+	watcher := New(Config{PollInterval: time.Hour, CallbackConcurrency: 2, DisableAudit: true})
+	defer watcher.Close()
+
+	release := make(chan struct{})
+	slowDone := make(chan struct{})
+	fastDone := make(chan struct{})
+	if err := watcher.Watch(paths[0], func(ChangeEvent) {
+		<-release
+		close(slowDone)
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Watch(paths[1], func(ChangeEvent) { close(fastDone) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	sendPoolEvent(watcher, paths[0], 1)
+	sendPoolEvent(watcher, paths[1], 1)
+
+	select {
+	case <-fastDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the fast path to be delivered while the slow callback blocks")
+	}
+	close(release)
+	select {
+	case <-slowDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the slow callback to complete once released")
+	}
+}
+
+func TestCallbackConcurrency_GracefulShutdownWaitsForPool(t *testing.T) {
+	paths := createPoolTestFiles(t, 1)
+	watcher := New(Config{PollInterval: time.Hour, CallbackConcurrency: 2, DisableAudit: true})
+
+	var delivered atomic.Int64
+	if err := watcher.Watch(paths[0], func(ChangeEvent) {
+		time.Sleep(10 * time.Millisecond)
+		delivered.Add(1)
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	for seq := int64(0); seq < 5; seq++ {
+		sendPoolEvent(watcher, paths[0], seq)
+	}
+	if err := watcher.GracefulShutdown(5 * time.Second); err != nil {
+		t.Fatalf("Failed to shut down watcher: %v", err)
+	}
+	if n := delivered.Load(); n != 5 {
+		t.Errorf("Expected all 5 queued events delivered before shutdown returned, got %d", n)
+	}
+}
+
+func TestCallbackConcurrency_StopDropsBacklog(t *testing.T) {
+	paths := createPoolTestFiles(t, 1)
+	watcher := New(Config{PollInterval: time.Hour, CallbackConcurrency: 2, DisableAudit: true})
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var delivered atomic.Int64
+	if err := watcher.Watch(paths[0], func(ChangeEvent) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		delivered.Add(1)
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	for seq := int64(0); seq < 3; seq++ {
+		sendPoolEvent(watcher, paths[0], seq)
+	}
+	<-started
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- watcher.Stop() }()
+	select {
+	case <-stopped:
+		t.Fatal("Expected Stop to wait for the running callback")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Fatalf("Failed to stop watcher: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected Stop to return once the running callback returned")
+	}
+
+	sendPoolEvent(watcher, paths[0], 3)
+	time.Sleep(20 * time.Millisecond)
+	if n := delivered.Load(); n != 1 {
+		t.Errorf("Expected only the running callback to complete, got %d deliveries", n)
+	}
+	if inFlight := watcher.callbacksInFlight.Load(); inFlight != 0 {
+		t.Errorf("Expected no callbacks in flight after Stop, got %d", inFlight)
+	}
+}
@@ -0,0 +1,34 @@
+// watcher_content.go: File content carried on change events
+//
+// Diff-based reload logic needs the content before and after a change. With
+// Config.IncludeContentInEvents the watcher keeps the last delivered content
+// of each watched file and attaches both versions to the event, so handlers
+// do not re-read the file and keep their own previous copy. Files are read
+// without holding filesMu, so a large file never stalls Watch, Unwatch or
+// the poll loop.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// readContent returns the content of path, or nil if the file is missing or
+// cannot be read. Must be called without filesMu held.
+func (w *Watcher) readContent(path string) []byte {
+	data, err := readFileLimited(path, w.config.MaxFileSize)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// attachContent sets OldContent on event, whose NewContent was read by
+// deliverFileEvent, and keeps NewContent as the baseline of the next event
+// of wf
+func (w *Watcher) attachContent(wf *watchedFile, event *ChangeEvent) {
+	wf.contentMu.Lock()
+	event.OldContent = wf.content
+	wf.content = event.NewContent
+	wf.contentMu.Unlock()
+}
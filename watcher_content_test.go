@@ -0,0 +1,92 @@
+// watcher_content_test.go: Tests for file content carried on change events
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatcher_IncludeContentInEvents(t *testing.T) {
+	configPath, err := filepath.Abs(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	var events []ChangeEvent
+	watcher := New(Config{PollInterval: time.Hour, IncludeContentInEvents: true, DisableAudit: true})
+	defer watcher.Close()
+	if err := watcher.Watch(configPath, func(event ChangeEvent) { events = append(events, event) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	deliver := func(event ChangeEvent) {
+		fileEvent := ConvertChangeEventToFileEvent(event)
+		watcher.processFileEvent(&fileEvent)
+	}
+	for _, content := range []string{`{"v": 2}`, `{"v": 3}`} {
+		if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to update config file: %v", err)
+		}
+		deliver(ChangeEvent{Path: configPath, IsModify: true})
+	}
+	if err := os.Remove(configPath); err != nil {
+		t.Fatalf("Failed to remove config file: %v", err)
+	}
+	deliver(ChangeEvent{Path: configPath, IsDelete: true})
+
+	expected := []struct{ old, new string }{
+		{`{"v": 1}`, `{"v": 2}`},
+		{`{"v": 2}`, `{"v": 3}`},
+		{`{"v": 3}`, ``},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Expected %d events, got %d", len(expected), len(events))
+	}
+	for i, want := range expected {
+		if string(events[i].OldContent) != want.old || string(events[i].NewContent) != want.new {
+			t.Errorf("Event %d: expected %q -> %q, got %q -> %q",
+				i, want.old, want.new, events[i].OldContent, events[i].NewContent)
+		}
+	}
+	if events[2].NewContent != nil {
+		t.Errorf("Expected no NewContent for a deletion, got %q", events[2].NewContent)
+	}
+}
+
+func TestWatcher_ContentNotIncludedByDefault(t *testing.T) {
+	configPath, err := filepath.Abs(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	var received ChangeEvent
+	watcher := New(Config{PollInterval: time.Hour, DisableAudit: true})
+	defer watcher.Close()
+	if err := watcher.Watch(configPath, func(event ChangeEvent) { received = event }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	fileEvent := ConvertChangeEventToFileEvent(ChangeEvent{Path: configPath, IsModify: true})
+	watcher.processFileEvent(&fileEvent)
+
+	if received.Path != configPath {
+		t.Fatalf("Expected an event for %s, got %+v", configPath, received)
+	}
+	if received.OldContent != nil || received.NewContent != nil {
+		t.Errorf("Expected no content without IncludeContentInEvents, got %q -> %q",
+			received.OldContent, received.NewContent)
+	}
+}
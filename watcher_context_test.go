@@ -0,0 +1,167 @@
+// watcher_context_test.go: Tests for context-bound watches
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestWatcher_WatchContext(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "plugin.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{DisableAudit: true})
+	defer func() { _ = watcher.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	if err := watcher.WatchContext(ctx, configPath, func(ChangeEvent) { calls.Add(1) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	deliverTestEvent(t, watcher, configPath)
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 callback before cancellation, got %d", calls.Load())
+	}
+
+	cancel()
+	// Events delivered before the unwatch goroutine runs are dropped too
+	deliverTestEvent(t, watcher, configPath)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for watcher.WatchedFiles() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the file to be unwatched after cancellation")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	deliverTestEvent(t, watcher, configPath)
+	if calls.Load() != 1 {
+		t.Errorf("Expected no callbacks after cancellation, got %d", calls.Load())
+	}
+
+	if err := watcher.WatchContext(ctx, configPath, func(ChangeEvent) {}); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected %s for a done context, got %v", ErrCodeInvalidConfig, err)
+	}
+	if watcher.WatchedFiles() != 0 {
+		t.Error("Expected a done context not to register the file")
+	}
+}
+
+// callbackCount returns the number of callbacks registered for path
+func callbackCount(w *Watcher, path string) int {
+	w.filesMu.RLock()
+	defer w.filesMu.RUnlock()
+	if wf, exists := w.files[path]; exists {
+		return len(wf.callbacks)
+	}
+	return 0
+}
+
+func TestWatcher_WatchContextKeepsFanOutCallbacks(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "plugin.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{DisableAudit: true, DuplicateWatch: DuplicateWatchFanOut})
+	defer func() { _ = watcher.Close() }()
+
+	var owned, bound atomic.Int32
+	if err := watcher.Watch(configPath, func(ChangeEvent) { owned.Add(1) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := watcher.WatchContext(ctx, configPath, func(ChangeEvent) { bound.Add(1) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	cancel()
+	deadline := time.Now().Add(2 * time.Second)
+	for callbackCount(watcher, configPath) != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected 1 callback after cancellation, got %d", callbackCount(watcher, configPath))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	deliverTestEvent(t, watcher, configPath)
+	if owned.Load() != 1 || bound.Load() != 0 {
+		t.Errorf("Expected only the other callback to fire, got owned=%d bound=%d", owned.Load(), bound.Load())
+	}
+}
+
+func TestWatcher_WatchContextReplacedWatch(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "plugin.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{DisableAudit: true})
+	defer func() { _ = watcher.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := watcher.WatchContext(ctx, configPath, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	registration := watcher.files[configPath].registrations[0]
+
+	var calls atomic.Int32
+	if err := watcher.Watch(configPath, func(ChangeEvent) { calls.Add(1) }); err != nil {
+		t.Fatalf("Failed to replace watch: %v", err)
+	}
+	select {
+	case <-registration.removed:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the replaced registration to be released")
+	}
+
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	if watcher.WatchedFiles() != 1 {
+		t.Fatal("Expected the replacing watch to survive the cancellation")
+	}
+	deliverTestEvent(t, watcher, configPath)
+	if calls.Load() != 1 {
+		t.Errorf("Expected the replacing callback to fire, got %d calls", calls.Load())
+	}
+}
+
+func TestWatcher_WatchContextUnwatchReleases(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "plugin.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{DisableAudit: true})
+	defer func() { _ = watcher.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := watcher.WatchContext(ctx, configPath, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	registration := watcher.files[configPath].registrations[0]
+
+	if err := watcher.Unwatch(configPath); err != nil {
+		t.Fatalf("Failed to unwatch file: %v", err)
+	}
+	select {
+	case <-registration.removed:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Unwatch to release the context registration")
+	}
+}
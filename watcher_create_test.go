@@ -0,0 +1,71 @@
+// watcher_create_test.go: Tests for watching files that do not exist yet
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatcher_WatchForCreation(t *testing.T) {
+	// Neither the file nor its directory exist when the watch starts
+	path := filepath.Join(t.TempDir(), "secrets", "token.json")
+
+	watcher := New(Config{PollInterval: 10 * time.Millisecond, CacheTTL: 5 * time.Millisecond, DisableAudit: true})
+	events := make(chan ChangeEvent, 8)
+	if err := watcher.Watch(path, func(event ChangeEvent) { events <- event }); err != nil {
+		t.Fatalf("Failed to watch missing file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	next := func(what string) ChangeEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Expected %s event", what)
+			return ChangeEvent{}
+		}
+	}
+
+	select {
+	case event := <-events:
+		t.Fatalf("Expected no event while the file is missing, got %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"token": "a"}`), 0600); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	if event := next("create"); !event.IsCreate || event.Size == 0 {
+		t.Errorf("Expected IsCreate with the new size, got %+v", event)
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Failed to remove file: %v", err)
+	}
+	if event := next("delete"); !event.IsDelete || !event.ModTime.IsZero() {
+		t.Errorf("Expected IsDelete with a zero ModTime, got %+v", event)
+	}
+
+	// The watch survives the deletion and reports the re-creation
+	if err := os.WriteFile(path, []byte(`{"token": "b"}`), 0600); err != nil {
+		t.Fatalf("Failed to re-create file: %v", err)
+	}
+	if event := next("re-create"); !event.IsCreate {
+		t.Errorf("Expected IsCreate after re-creation, got %+v", event)
+	}
+}
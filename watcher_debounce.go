@@ -0,0 +1,103 @@
+// watcher_debounce.go: Debouncing of rapid successive changes
+//
+// A file written in several syscalls, or saved twice by an editor, can be
+// detected as a burst of changes a few milliseconds apart. With
+// Config.DebounceInterval the burst reaches callbacks as a single event
+// carrying the final state, so expensive reload handlers run once.
+//
+// The debounce timer does not call callbacks itself: it writes the final
+// event back to the ring, so debounced events are delivered by the event
+// processor (or the callback pool) like any other, one at a time per path.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "time"
+
+// fileEventDebounced marks ring events whose debounce window already closed,
+// which are delivered without being debounced again
+const fileEventDebounced uint8 = 1 << 5
+
+// debounceFileEvent debounces event for its watched file, if still watched
+func (w *Watcher) debounceFileEvent(event ChangeEvent) {
+	w.filesMu.RLock()
+	wf, exists := w.files[event.Path]
+	w.filesMu.RUnlock()
+	if exists {
+		w.debounceEvent(wf, event)
+	}
+}
+
+// debounceEvent holds event as the pending event of wf and (re)starts the
+// debounce window. A pending event it replaces counts as coalesced; a
+// replacement seen during the window is kept in IsRename.
+func (w *Watcher) debounceEvent(wf *watchedFile, event ChangeEvent) {
+	wf.debounceMu.Lock()
+	defer wf.debounceMu.Unlock()
+
+	if wf.debouncePending {
+		wf.coalesced.Add(1)
+		event.IsRename = event.IsRename || wf.debounced.IsRename
+	}
+	wf.debounced = event
+	wf.debouncePending = true
+
+	if wf.debounceTimer == nil {
+		wf.debounceTimer = time.AfterFunc(w.config.DebounceInterval, func() { w.flushDebounced(wf) })
+	} else {
+		wf.debounceTimer.Reset(w.config.DebounceInterval)
+	}
+}
+
+// flushDebounced queues the pending event of wf once its window closed.
+// After Stop the event stays pending: GracefulShutdown delivers it with the
+// rest of the ring, Stop drops it like events left in the ring buffer.
+func (w *Watcher) flushDebounced(wf *watchedFile) {
+	if w.stopped.Load() {
+		return
+	}
+	if event, pending := wf.takeDebounced(); pending {
+		w.queueDebounced(event)
+	}
+}
+
+// flushAllDebounced queues the pending events of every watched file without
+// waiting for their windows to close. Called by GracefulShutdown before the
+// ring is drained.
+func (w *Watcher) flushAllDebounced() {
+	if w.config.DebounceInterval <= 0 {
+		return
+	}
+	w.filesMu.RLock()
+	defer w.filesMu.RUnlock()
+	for _, wf := range w.files {
+		wf.debounceMu.Lock()
+		if wf.debounceTimer != nil {
+			wf.debounceTimer.Stop()
+		}
+		wf.debounceMu.Unlock()
+		if event, pending := wf.takeDebounced(); pending {
+			w.queueDebounced(event)
+		}
+	}
+}
+
+// takeDebounced removes the pending event of wf, reporting whether there was one
+func (wf *watchedFile) takeDebounced() (ChangeEvent, bool) {
+	wf.debounceMu.Lock()
+	defer wf.debounceMu.Unlock()
+	event, pending := wf.debounced, wf.debouncePending
+	wf.debouncePending = false
+	return event, pending
+}
+
+// queueDebounced writes event back to the ring for delivery. A full ring
+// drops it and counts the drop, like any other event.
+func (w *Watcher) queueDebounced(event ChangeEvent) {
+	fileEvent := ConvertChangeEventToFileEvent(event)
+	fileEvent.Flags |= fileEventDebounced
+	w.eventRing.WriteFileEvent(&fileEvent)
+}
@@ -0,0 +1,148 @@
+// watcher_debounce_test.go: Tests for debouncing rapid successive changes
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWatcher_DebounceInterval(t *testing.T) {
+	configPath, err := filepath.Abs(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+
+	watcher := New(Config{DebounceInterval: 50 * time.Millisecond, DisableAudit: true})
+	defer watcher.Close()
+	events := make(chan ChangeEvent, 8)
+	if err := watcher.Watch(configPath, func(event ChangeEvent) { events <- event }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	// Debounced events are delivered by the event processor
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	// A burst of writes, the second one an atomic replacement
+	for size := int64(1); size <= 5; size++ {
+		event := ConvertChangeEventToFileEvent(ChangeEvent{Path: configPath, Size: size, IsModify: true, IsRename: size == 2})
+		watcher.processFileEvent(&event)
+	}
+
+	select {
+	case event := <-events:
+		if event.Size != 5 {
+			t.Errorf("Expected the final state (size 5), got size %d", event.Size)
+		}
+		if !event.IsRename {
+			t.Error("Expected a replacement during the window to be kept in IsRename")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected one callback after the debounce window")
+	}
+	select {
+	case event := <-events:
+		t.Errorf("Expected the burst to collapse into one callback, got another: %+v", event)
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	stats := watcher.Stats().Files[configPath]
+	if stats.Delivered != 1 || stats.Coalesced != 4 {
+		t.Errorf("Expected 1 delivered and 4 coalesced, got %+v", stats)
+	}
+}
+
+func TestWatcher_DebounceDisabledByDefault(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	watcher := New(Config{DisableAudit: true})
+	calls := 0
+	if err := watcher.Watch(configPath, func(ChangeEvent) { calls++ }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		deliverTestEvent(t, watcher, configPath)
+	}
+	if calls != 3 {
+		t.Errorf("Expected every change delivered immediately without debounce, got %d calls", calls)
+	}
+
+	config := (&Config{DebounceInterval: -time.Second}).WithDefaults()
+	if err := config.Validate(); err != ErrInvalidDebounce {
+		t.Errorf("Expected ErrInvalidDebounce for a negative interval, got %v", err)
+	}
+}
+
+func TestWatcher_DebounceSerializedDelivery(t *testing.T) {
+	paths := createPoolTestFiles(t, 2)
+	watcher := New(Config{PollInterval: time.Hour, DebounceInterval: 10 * time.Millisecond, DisableAudit: true})
+	defer watcher.Close()
+
+	// Callbacks outlast the window; without the ring they would overlap
+	var running, overlaps, delivered atomic.Int64
+	callback := func(ChangeEvent) {
+		if running.Add(1) > 1 {
+			overlaps.Add(1)
+		}
+		time.Sleep(30 * time.Millisecond)
+		running.Add(-1)
+		delivered.Add(1)
+	}
+	for _, path := range paths {
+		if err := watcher.Watch(path, callback); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	for i := int64(0); i < 3; i++ {
+		for _, path := range paths {
+			sendPoolEvent(watcher, path, i)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for delivered.Load() < 6 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if n := overlaps.Load(); n != 0 {
+		t.Errorf("Expected debounced callbacks to run one at a time on the event processor, got %d overlaps", n)
+	}
+	if n := delivered.Load(); n != 6 {
+		t.Errorf("Expected 6 debounced deliveries, got %d", n)
+	}
+}
+
+func TestWatcher_DebounceFlushedOnGracefulShutdown(t *testing.T) {
+	paths := createPoolTestFiles(t, 1)
+	watcher := New(Config{PollInterval: time.Hour, DebounceInterval: time.Hour, DisableAudit: true})
+
+	var received []ChangeEvent
+	if err := watcher.Watch(paths[0], func(event ChangeEvent) { received = append(received, event) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	for size := int64(1); size <= 3; size++ {
+		sendPoolEvent(watcher, paths[0], size)
+	}
+	if err := watcher.GracefulShutdown(5 * time.Second); err != nil {
+		t.Fatalf("Failed to shut down watcher: %v", err)
+	}
+
+	if len(received) != 1 || received[0].Size != 3 {
+		t.Errorf("Expected the final state of the burst delivered on shutdown, got %+v", received)
+	}
+}
@@ -0,0 +1,130 @@
+// watcher_duplicate.go: Behavior when the same path is watched twice
+//
+// Two modules watching the same shared file used to race silently: the last
+// Watch call won. The DuplicateWatch policy makes the outcome explicit, and
+// ReplaceWatch returns the displaced callback so it can be restored.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"github.com/agilira/go-errors"
+)
+
+// DuplicateWatchPolicy selects what Watch does for an already watched path
+type DuplicateWatchPolicy int
+
+const (
+	// DuplicateWatchReplace replaces the previous callbacks with the new one
+	// (default). The change-detection state of the file is kept.
+	DuplicateWatchReplace DuplicateWatchPolicy = iota
+
+	// DuplicateWatchError rejects the registration with ErrCodeDuplicateWatch
+	DuplicateWatchError
+
+	// DuplicateWatchFanOut keeps the previous callbacks and adds the new one;
+	// every callback is invoked for each change, in registration order
+	DuplicateWatchFanOut
+)
+
+// String returns the name of the duplicate watch policy
+func (p DuplicateWatchPolicy) String() string {
+	switch p {
+	case DuplicateWatchReplace:
+		return "replace"
+	case DuplicateWatchError:
+		return "error"
+	case DuplicateWatchFanOut:
+		return "fan-out"
+	default:
+		return "unknown"
+	}
+}
+
+// ReplaceWatch watches path with callback, replacing any callbacks already
+// registered for it regardless of Config.DuplicateWatch. It returns the
+// previous callback (several fanned-out callbacks are combined into one that
+// invokes them in order), or nil if the path was not watched.
+//
+// Example:
+//
+//	prev, err := watcher.ReplaceWatch("shared.yaml", onSharedChange)
+//	// ... later, restore the original owner
+//	if prev != nil {
+//	    _, _ = watcher.ReplaceWatch("shared.yaml", prev)
+//	}
+func (w *Watcher) ReplaceWatch(path string, callback UpdateCallback) (UpdateCallback, error) {
+	if callback == nil {
+		return nil, errors.New(ErrCodeInvalidConfig, "callback cannot be nil")
+	}
+	if w.stopped.Load() {
+		return nil, errors.New(ErrCodeWatcherStopped, "cannot add watch to stopped watcher")
+	}
+
+	absPath, err := w.validateAndSecurePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	w.filesMu.Lock()
+	wf, exists := w.files[absPath]
+	if exists {
+		previous := combineCallbacks(wf.callbacks)
+		releaseRegistrations(wf.registrations)
+		wf.callbacks = []UpdateCallback{callback}
+		wf.registrations = []*watchRegistration{nil}
+		w.filesMu.Unlock()
+		w.auditLogger.LogFileWatch("watch_replaced", absPath)
+		return previous, nil
+	}
+	w.filesMu.Unlock()
+
+	w.auditLogger.LogFileWatch("watch_start", absPath)
+	return nil, w.addWatchedFile(absPath, callback, 0, nil)
+}
+
+// addDuplicateWatch applies Config.DuplicateWatch to an already watched file.
+// Called with filesMu held.
+func (w *Watcher) addDuplicateWatch(wf *watchedFile, callback UpdateCallback, registration *watchRegistration) error {
+	switch w.config.DuplicateWatch {
+	case DuplicateWatchError:
+		return errors.New(ErrCodeDuplicateWatch, "file is already watched").
+			WithContext("path", wf.path)
+	case DuplicateWatchFanOut:
+		callbacks := make([]UpdateCallback, len(wf.callbacks), len(wf.callbacks)+1)
+		copy(callbacks, wf.callbacks)
+		wf.callbacks = append(callbacks, callback)
+		wf.registrations = append(wf.registrations[:len(wf.registrations):len(wf.registrations)], registration)
+	default:
+		releaseRegistrations(wf.registrations)
+		wf.callbacks = []UpdateCallback{callback}
+		wf.registrations = []*watchRegistration{registration}
+	}
+	return nil
+}
+
+// invokeCallback calls callback, recovering from a panic so that the other
+// callbacks of a fanned-out file still run
+func (w *Watcher) invokeCallback(callback UpdateCallback, event ChangeEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.reportCallbackPanic(event.Path, r)
+		}
+	}()
+	callback(event)
+}
+
+// combineCallbacks returns a single callback invoking callbacks in order
+func combineCallbacks(callbacks []UpdateCallback) UpdateCallback {
+	if len(callbacks) == 1 {
+		return callbacks[0]
+	}
+	return func(event ChangeEvent) {
+		for _, callback := range callbacks {
+			callback(event)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// watcher_duplicate_test.go: Tests for duplicate watch registration policies
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/agilira/go-errors"
+)
+
+// deliverTestEvent pushes a modify event for path through the watcher's
+// event processor without waiting for polling
+func deliverTestEvent(t *testing.T, w *Watcher, path string) {
+	t.Helper()
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	event := ConvertChangeEventToFileEvent(ChangeEvent{Path: absPath, IsModify: true})
+	w.processFileEvent(&event)
+}
+
+func TestWatcher_DuplicateWatch(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "shared.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		policy    DuplicateWatchPolicy
+		wantErr   bool
+		wantCalls []string
+	}{
+		{"replace by default", DuplicateWatchReplace, false, []string{"second"}},
+		{"error", DuplicateWatchError, true, []string{"first"}},
+		{"fan-out", DuplicateWatchFanOut, false, []string{"first", "second"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher := New(Config{DisableAudit: true, DuplicateWatch: tt.policy})
+
+			var calls []string
+			if err := watcher.Watch(configPath, func(ChangeEvent) { calls = append(calls, "first") }); err != nil {
+				t.Fatalf("Failed to watch file: %v", err)
+			}
+			err := watcher.Watch(configPath, func(ChangeEvent) { calls = append(calls, "second") })
+			if tt.wantErr {
+				if !errors.HasCode(err, ErrCodeDuplicateWatch) {
+					t.Fatalf("Expected %s, got %v", ErrCodeDuplicateWatch, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Failed to watch file twice: %v", err)
+			}
+
+			if n := watcher.WatchedFiles(); n != 1 {
+				t.Errorf("Expected 1 watched file, got %d", n)
+			}
+
+			deliverTestEvent(t, watcher, configPath)
+			if len(calls) != len(tt.wantCalls) {
+				t.Fatalf("Expected calls %v, got %v", tt.wantCalls, calls)
+			}
+			for i := range calls {
+				if calls[i] != tt.wantCalls[i] {
+					t.Errorf("Expected calls %v, got %v", tt.wantCalls, calls)
+				}
+			}
+		})
+	}
+}
+
+func TestWatcher_ReplaceWatch(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "shared.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	// ReplaceWatch replaces regardless of the policy
+	watcher := New(Config{DisableAudit: true, DuplicateWatch: DuplicateWatchError})
+
+	prev, err := watcher.ReplaceWatch(configPath, func(ChangeEvent) {})
+	if err != nil || prev != nil {
+		t.Fatalf("Expected no previous callback for a new path, got %v, %v", prev, err)
+	}
+
+	var owner string
+	if _, err := watcher.ReplaceWatch(configPath, func(ChangeEvent) { owner = "original" }); err != nil {
+		t.Fatalf("Failed to replace watch: %v", err)
+	}
+	prev, err = watcher.ReplaceWatch(configPath, func(ChangeEvent) { owner = "intruder" })
+	if err != nil {
+		t.Fatalf("Failed to replace watch: %v", err)
+	}
+
+	// Restoring the returned handle gives the file back to its original owner
+	if _, err := watcher.ReplaceWatch(configPath, prev); err != nil {
+		t.Fatalf("Failed to restore watch: %v", err)
+	}
+	deliverTestEvent(t, watcher, configPath)
+	if owner != "original" {
+		t.Errorf("Expected the restored callback to run, got %q", owner)
+	}
+}
+
+func TestWatcher_FanOutSurvivesPanic(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "shared.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{DisableAudit: true, DuplicateWatch: DuplicateWatchFanOut})
+	if err := watcher.Watch(configPath, func(ChangeEvent) { panic("module A bug") }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	called := false
+	if err := watcher.Watch(configPath, func(ChangeEvent) { called = true }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	deliverTestEvent(t, watcher, configPath)
+	if !called {
+		t.Error("Expected the second callback to run after the first panicked")
+	}
+}
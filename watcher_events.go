@@ -0,0 +1,182 @@
+// watcher_events.go: Channel-based delivery of change events
+//
+// Events is a supplement to per-file callbacks for channel-oriented code:
+// every change delivered to a callback is also published on a single
+// buffered channel, so consumers can select over configuration changes
+// alongside other channels. Publishing never blocks the event processor; a
+// full channel applies the configured overflow policy and counts the drop.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "context"
+
+// EventsOverflowPolicy selects what happens when the Events channel is full
+type EventsOverflowPolicy int
+
+const (
+	// EventsDropNewest discards the incoming event, matching the BoreasLite
+	// ring buffer which rejects writes when full (default)
+	EventsDropNewest EventsOverflowPolicy = iota
+
+	// EventsDropOldest discards the oldest buffered event to make room,
+	// so a slow consumer always sees the most recent changes
+	EventsDropOldest
+)
+
+// String returns the name of the overflow policy
+func (p EventsOverflowPolicy) String() string {
+	switch p {
+	case EventsDropNewest:
+		return "drop-newest"
+	case EventsDropOldest:
+		return "drop-oldest"
+	default:
+		return "unknown"
+	}
+}
+
+// EventsShutdownPolicy selects what happens to buffered events on shutdown
+type EventsShutdownPolicy int
+
+const (
+	// EventsShutdownDrain keeps buffered events for consumers: GracefulShutdown
+	// waits, within its timeout, until consumers have received them before
+	// closing the channel (default). Events still buffered at the deadline,
+	// or when Stop is used, stay readable after the close.
+	EventsShutdownDrain EventsShutdownPolicy = iota
+
+	// EventsShutdownDiscard drops buffered events and closes the channel
+	// immediately; discarded events are counted by EventsDropped
+	EventsShutdownDiscard
+)
+
+// String returns the name of the shutdown policy
+func (p EventsShutdownPolicy) String() string {
+	switch p {
+	case EventsShutdownDrain:
+		return "drain"
+	case EventsShutdownDiscard:
+		return "discard"
+	default:
+		return "unknown"
+	}
+}
+
+// Events returns a channel streaming change events of all watched files.
+// The channel is created on the first call with Config.EventsBufferSize
+// capacity and closed when the watcher stops; every call returns the same
+// channel. Events published before the first call are not buffered.
+//
+// The channel supplements per-file callbacks: each change invokes the file's
+// callback first and is then published on the channel, so both fire. To
+// consume only the channel, pass a no-op callback to Watch.
+//
+// Example:
+//
+//	watcher.Watch("config.json", func(argus.ChangeEvent) {})
+//	for {
+//	    select {
+//	    case event, ok := <-watcher.Events():
+//	        if !ok {
+//	            return
+//	        }
+//	        reload(event.Path)
+//	    case <-ctx.Done():
+//	        return
+//	    }
+//	}
+func (w *Watcher) Events() <-chan ChangeEvent {
+	w.eventsMu.Lock()
+	defer w.eventsMu.Unlock()
+
+	if w.eventsCh == nil {
+		w.eventsCh = make(chan ChangeEvent, w.config.EventsBufferSize)
+		if w.eventsClosed {
+			close(w.eventsCh)
+		}
+	}
+	return w.eventsCh
+}
+
+// EventsDropped returns the number of events discarded because the Events
+// channel was full
+func (w *Watcher) EventsDropped() int64 {
+	return w.eventsDropped.Load()
+}
+
+// publishEvent sends event on the Events channel without blocking,
+// applying the overflow policy when the channel is full
+func (w *Watcher) publishEvent(event ChangeEvent) {
+	w.eventsMu.Lock()
+	defer w.eventsMu.Unlock()
+
+	if w.eventsCh == nil || w.eventsClosed {
+		return
+	}
+
+	select {
+	case w.eventsCh <- event:
+		return
+	default:
+	}
+
+	if w.config.EventsOverflow == EventsDropOldest {
+		select {
+		case <-w.eventsCh:
+			w.eventsDropped.Add(1)
+		default:
+		}
+		select {
+		case w.eventsCh <- event:
+		default:
+			w.eventsDropped.Add(1) // Refilled by a concurrent drain race; give up
+		}
+		return
+	}
+	w.eventsDropped.Add(1)
+}
+
+// flushEvents applies the shutdown policy during GracefulShutdown: with
+// EventsShutdownDrain it waits for consumers to empty the channel, bounded
+// by ctx, then closes it
+func (w *Watcher) flushEvents(ctx context.Context) error {
+	var err error
+	if w.config.EventsShutdown == EventsShutdownDrain {
+		w.eventsMu.Lock()
+		ch := w.eventsCh
+		w.eventsMu.Unlock()
+		if ch != nil {
+			err = waitUntil(ctx, func() bool { return len(ch) == 0 })
+		}
+	}
+	w.closeEvents(w.config.EventsShutdown == EventsShutdownDiscard)
+	return err
+}
+
+// closeEvents closes the Events channel so range loops terminate,
+// first discarding buffered events when discard is set
+func (w *Watcher) closeEvents(discard bool) {
+	w.eventsMu.Lock()
+	defer w.eventsMu.Unlock()
+
+	if w.eventsClosed {
+		return
+	}
+	w.eventsClosed = true
+	if w.eventsCh == nil {
+		return
+	}
+	for discard && len(w.eventsCh) > 0 {
+		select {
+		case <-w.eventsCh:
+			w.eventsDropped.Add(1)
+		default:
+			discard = false // Emptied by a concurrent consumer
+		}
+	}
+	close(w.eventsCh)
+}
@@ -0,0 +1,204 @@
+// watcher_events_test.go: Tests for channel-based event delivery
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWatcherEvents_Channel(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{
+		PollInterval: 10 * time.Millisecond,
+		CacheTTL:     5 * time.Millisecond,
+		DisableAudit: true,
+	})
+
+	var callbacks atomic.Int64
+	if err := watcher.Watch(configPath, func(event ChangeEvent) {
+		callbacks.Add(1)
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	events := watcher.Events()
+	if watcher.Events() != events {
+		t.Fatal("Expected Events to return the same channel")
+	}
+
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if err := os.WriteFile(configPath, []byte(`{"port": 8080}`), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if filepath.Base(event.Path) != "config.json" || !event.IsModify {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timed out waiting for event on channel")
+	}
+	if callbacks.Load() == 0 {
+		t.Error("Expected the per-file callback to fire alongside the channel")
+	}
+
+	if err := watcher.Stop(); err != nil {
+		t.Fatalf("Failed to stop watcher: %v", err)
+	}
+	for range events {
+		// Drain until closed by Stop
+	}
+}
+
+func TestWatcherEvents_Overflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   EventsOverflowPolicy
+		wantPath string
+	}{
+		{"drop newest", EventsDropNewest, "/first"},
+		{"drop oldest", EventsDropOldest, "/third"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher := New(Config{
+				DisableAudit:     true,
+				EventsBufferSize: 1,
+				EventsOverflow:   tt.policy,
+			})
+			events := watcher.Events()
+
+			for _, path := range []string{"/first", "/second", "/third"} {
+				watcher.publishEvent(ChangeEvent{Path: path, IsModify: true})
+			}
+
+			if got := watcher.EventsDropped(); got != 2 {
+				t.Errorf("Expected 2 dropped events, got %d", got)
+			}
+			if event := <-events; event.Path != tt.wantPath {
+				t.Errorf("Expected buffered event %s, got %s", tt.wantPath, event.Path)
+			}
+		})
+	}
+}
+
+func TestWatcherEvents_InvalidOverflow(t *testing.T) {
+	config := Config{EventsOverflow: EventsOverflowPolicy(42)}
+	if err := config.WithDefaults().Validate(); err != ErrInvalidEventsOverflow {
+		t.Errorf("Expected ErrInvalidEventsOverflow, got %v", err)
+	}
+}
+
+func TestWatcherEvents_ShutdownPolicy(t *testing.T) {
+	t.Run("drain", func(t *testing.T) {
+		watcher := New(Config{DisableAudit: true, EventsShutdown: EventsShutdownDrain})
+		events := watcher.Events()
+		if err := watcher.Start(); err != nil {
+			t.Fatalf("Failed to start watcher: %v", err)
+		}
+		for _, path := range []string{"/first", "/second"} {
+			watcher.publishEvent(ChangeEvent{Path: path, IsModify: true})
+		}
+
+		var received []string
+		consumed := make(chan struct{})
+		go func() {
+			defer close(consumed)
+			time.Sleep(20 * time.Millisecond) // Slow consumer: shutdown must wait
+			for event := range events {
+				received = append(received, event.Path)
+			}
+		}()
+
+		if err := watcher.GracefulShutdown(2 * time.Second); err != nil {
+			t.Fatalf("GracefulShutdown failed: %v", err)
+		}
+		select {
+		case <-consumed:
+		case <-time.After(2 * time.Second):
+			t.Fatal("Events channel was not closed by GracefulShutdown")
+		}
+		if len(received) != 2 {
+			t.Errorf("Expected 2 drained events, got %v", received)
+		}
+
+		report, _ := watcher.ShutdownReport()
+		for _, s := range report.Subsystems {
+			if s.Name == ShutdownEventsChannel && s.Duration < 10*time.Millisecond {
+				t.Errorf("Expected shutdown to wait for the consumer, waited %v", s.Duration)
+			}
+		}
+	})
+
+	t.Run("discard", func(t *testing.T) {
+		watcher := New(Config{DisableAudit: true, EventsShutdown: EventsShutdownDiscard})
+		events := watcher.Events()
+		if err := watcher.Start(); err != nil {
+			t.Fatalf("Failed to start watcher: %v", err)
+		}
+		for _, path := range []string{"/first", "/second"} {
+			watcher.publishEvent(ChangeEvent{Path: path, IsModify: true})
+		}
+
+		if err := watcher.GracefulShutdown(2 * time.Second); err != nil {
+			t.Fatalf("GracefulShutdown failed: %v", err)
+		}
+		if _, ok := <-events; ok {
+			t.Error("Expected buffered events to be discarded and the channel closed")
+		}
+		if got := watcher.EventsDropped(); got != 2 {
+			t.Errorf("Expected 2 discarded events, got %d", got)
+		}
+	})
+
+	t.Run("drain timeout", func(t *testing.T) {
+		watcher := New(Config{DisableAudit: true})
+		events := watcher.Events()
+		if err := watcher.Start(); err != nil {
+			t.Fatalf("Failed to start watcher: %v", err)
+		}
+		watcher.publishEvent(ChangeEvent{Path: "/unread", IsModify: true})
+
+		// No consumer: the deadline expires, but the channel still closes
+		if err := watcher.GracefulShutdown(50 * time.Millisecond); err == nil {
+			t.Fatal("Expected timeout error without a consumer")
+		}
+		deadline := time.After(2 * time.Second)
+		for {
+			select {
+			case _, ok := <-events:
+				if !ok {
+					return
+				}
+			case <-deadline:
+				t.Fatal("Events channel was not closed after the shutdown timeout")
+			}
+		}
+	})
+}
+
+func TestWatcherEvents_InvalidShutdownPolicy(t *testing.T) {
+	config := Config{EventsShutdown: EventsShutdownPolicy(42)}
+	if err := config.WithDefaults().Validate(); err != ErrInvalidEventsShutdown {
+		t.Errorf("Expected ErrInvalidEventsShutdown, got %v", err)
+	}
+}
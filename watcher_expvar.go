@@ -0,0 +1,78 @@
+// watcher_expvar.go: Watcher statistics published through expvar
+//
+// Teams without a Prometheus stack still want to see what the watcher is
+// doing. PublishExpvar exposes the counters behind Watcher.Stats as expvar
+// variables, served as JSON by the standard /debug/vars handler with no
+// dependency beyond the standard library. Each variable is an expvar.Func
+// reading its counter when the page is rendered, so publishing costs nothing
+// between requests.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/agilira/go-errors"
+)
+
+// expvarMu makes checking and publishing a prefix atomic, as expvar.Publish
+// panics on a name published concurrently
+var expvarMu sync.Mutex
+
+// PublishExpvar publishes the statistics of w as expvar variables named
+// prefix + "." + name, e.g. "argus.poll_cycles":
+//
+//	files_watched, poll_cycles, last_poll_duration_seconds, last_poll_mode,
+//	cache_hits, cache_misses, cache_hit_ratio, events_processed,
+//	events_dropped, buffer_occupancy, max_buffer_occupancy, ring_capacity
+//
+// Values are read lazily from the counters used by Stats. expvar names are
+// process-wide and cannot be unpublished, so publishing a prefix twice fails
+// with ErrCodeInvalidConfig; give each watcher its own prefix.
+//
+// Example:
+//
+//	import _ "expvar" // registers /debug/vars on http.DefaultServeMux
+//
+//	if err := argus.PublishExpvar("argus", watcher); err != nil {
+//	    log.Fatal(err)
+//	}
+func PublishExpvar(prefix string, w *Watcher) error {
+	if prefix == "" || w == nil {
+		return errors.New(ErrCodeInvalidConfig, "expvar prefix and watcher are required")
+	}
+
+	vars := map[string]func() any{
+		"files_watched":              func() any { return w.WatchedFiles() },
+		"poll_cycles":                func() any { return w.pollCycles.Load() },
+		"last_poll_duration_seconds": func() any { return float64(w.lastPollDuration.Load()) / 1e9 },
+		"last_poll_mode":             func() any { return PollMode(w.lastPollMode.Load()).String() },
+		"cache_hits":                 func() any { return w.cacheHits.Load() },
+		"cache_misses":               func() any { return w.cacheMisses.Load() },
+		"cache_hit_ratio":            func() any { return cacheHitRatio(w.cacheHits.Load(), w.cacheMisses.Load()) },
+		"events_processed":           func() any { return w.eventRing.TotalProcessed() },
+		"events_dropped":             func() any { return w.eventRing.DroppedEvents() },
+		"buffer_occupancy":           func() any { return w.eventRing.Buffered() },
+		"max_buffer_occupancy":       func() any { return w.eventRing.MaxOccupancy() },
+		"ring_capacity":              func() any { return w.eventRing.Capacity() },
+	}
+
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+
+	for name := range vars {
+		if expvar.Get(prefix+"."+name) != nil {
+			return errors.New(ErrCodeInvalidConfig, "expvar variable is already published").
+				WithContext("name", prefix+"."+name)
+		}
+	}
+	for name, value := range vars {
+		expvar.Publish(prefix+"."+name, expvar.Func(value))
+	}
+	return nil
+}
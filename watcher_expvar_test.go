@@ -0,0 +1,104 @@
+// watcher_expvar_test.go: Tests for publishing watcher statistics with expvar
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestPublishExpvar(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, CacheTTL: time.Hour, DisableAudit: true})
+	defer watcher.Close()
+	// expvar names are process-wide: keep them unique across -count runs
+	prefix := fmt.Sprintf("argus_expvar_test_%d", time.Now().UnixNano())
+	if err := PublishExpvar(prefix, watcher); err != nil {
+		t.Fatalf("Failed to publish expvar variables: %v", err)
+	}
+
+	// Published before the watch: values are read when rendered
+	if err := watcher.Watch(configPath, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	if _, err := watcher.getStat(absPath); err != nil {
+		t.Fatalf("Failed to stat file: %v", err)
+	}
+
+	read := func(name string) interface{} {
+		t.Helper()
+		v := expvar.Get(prefix + "." + name)
+		if v == nil {
+			t.Fatalf("Expected expvar variable %s to be published", name)
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(v.String()), &value); err != nil {
+			t.Fatalf("Expected JSON for %s, got %s: %v", name, v.String(), err)
+		}
+		return value
+	}
+
+	if got := read("files_watched"); got != 1.0 {
+		t.Errorf("Expected files_watched 1, got %v", got)
+	}
+	if got := read("cache_hits"); got != 1.0 {
+		t.Errorf("Expected cache_hits 1, got %v", got)
+	}
+	if got := read("cache_misses"); got != 1.0 {
+		t.Errorf("Expected cache_misses 1, got %v", got)
+	}
+	if got := read("cache_hit_ratio"); got != 0.5 {
+		t.Errorf("Expected cache_hit_ratio 0.5, got %v", got)
+	}
+	if got := read("last_poll_mode"); got != "none" {
+		t.Errorf("Expected last_poll_mode none, got %v", got)
+	}
+	if got := read("ring_capacity"); got != float64(watcher.eventRing.Capacity()) {
+		t.Errorf("Expected ring_capacity %d, got %v", watcher.eventRing.Capacity(), got)
+	}
+	for _, name := range []string{"poll_cycles", "last_poll_duration_seconds", "events_processed",
+		"events_dropped", "buffer_occupancy", "max_buffer_occupancy"} {
+		if got := read(name); got != 0.0 {
+			t.Errorf("Expected %s 0 before Start, got %v", name, got)
+		}
+	}
+}
+
+func TestPublishExpvar_Errors(t *testing.T) {
+	watcher := New(Config{DisableAudit: true})
+	defer watcher.Close()
+
+	if err := PublishExpvar("", watcher); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for an empty prefix, got %v", err)
+	}
+	if err := PublishExpvar("argus_expvar_nil", nil); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a nil watcher, got %v", err)
+	}
+
+	prefix := fmt.Sprintf("argus_expvar_dup_%d", time.Now().UnixNano())
+	if err := PublishExpvar(prefix, watcher); err != nil {
+		t.Fatalf("Failed to publish expvar variables: %v", err)
+	}
+	if err := PublishExpvar(prefix, watcher); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a prefix published twice, got %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+// watcher_glob.go: Watching every file matching a glob pattern
+//
+// Drop-in directories such as config/*.yaml gain and lose files while the
+// application runs. WatchGlob watches the files matching a pattern and
+// re-evaluates the pattern on every poll cycle, so files added later are
+// picked up and removed files are dropped without the application
+// enumerating them itself.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/agilira/go-errors"
+)
+
+// globWatch is a pattern registered with WatchGlob and the files it watches
+type globWatch struct {
+	pattern  string // Absolute glob pattern
+	callback UpdateCallback
+
+	mu      sync.Mutex
+	matched map[string]bool // Paths watched for the pattern
+	removed map[string]bool // Paths whose deletion was delivered, dropped at the next refresh
+	refused bool            // A match could not be watched and was reported (poll loop only)
+}
+
+// deliver invokes the callback of the pattern, remembering delivered
+// deletions so the next refresh can drop the path once its callbacks ran
+func (g *globWatch) deliver(event ChangeEvent) {
+	if event.IsDelete {
+		g.mu.Lock()
+		g.removed[event.Path] = true
+		g.mu.Unlock()
+	}
+	g.callback(event)
+}
+
+// WatchGlob watches every file matching pattern, using the syntax of
+// filepath.Match (e.g. "config/*.yaml"). The files matching at registration
+// are watched as if by Watch. The pattern is then re-evaluated on every poll
+// cycle: a file that starts matching is watched and reported with IsCreate,
+// and a removed file is reported with IsDelete and then unwatched, which
+// removes every callback of the path.
+//
+// Directories are never matched. MaxWatchedFiles applies to the expanded set:
+// registration fails if the current matches do not fit, and a later match
+// that does not fit is reported to the ErrorHandler and retried on the next
+// cycle.
+//
+// Example:
+//
+//	err := watcher.WatchGlob("/etc/myapp/conf.d/*.yaml", func(event argus.ChangeEvent) {
+//	    if event.IsDelete {
+//	        removeOverlay(event.Path)
+//	        return
+//	    }
+//	    loadOverlay(event.Path)
+//	})
+func (w *Watcher) WatchGlob(pattern string, callback UpdateCallback) error {
+	if callback == nil {
+		return errors.New(ErrCodeInvalidConfig, "callback cannot be nil")
+	}
+	if w.stopped.Load() {
+		return errors.New(ErrCodeWatcherStopped, "cannot add watch to stopped watcher")
+	}
+	if err := ValidateSecurePath(pattern); err != nil {
+		return err
+	}
+	absPattern, err := filepath.Abs(pattern)
+	if err != nil {
+		return errors.Wrap(err, ErrCodeInvalidConfig, "invalid glob pattern").
+			WithContext("pattern", pattern)
+	}
+	if _, err := filepath.Match(absPattern, ""); err != nil {
+		return errors.Wrap(err, ErrCodeInvalidConfig, "invalid glob pattern").
+			WithContext("pattern", pattern)
+	}
+
+	glob := &globWatch{
+		pattern:  absPattern,
+		callback: callback,
+		matched:  make(map[string]bool),
+		removed:  make(map[string]bool),
+	}
+	matches := globFiles(absPattern)
+	if err := w.checkGlobLimit(matches); err != nil {
+		return err.WithContext("pattern", pattern)
+	}
+
+	for _, path := range matches {
+		if err := w.watch(context.Background(), path, glob.deliver, 0); err != nil {
+			for watched := range glob.matched {
+				_ = w.Unwatch(watched)
+			}
+			return err
+		}
+		glob.matched[path] = true
+	}
+
+	w.globsMu.Lock()
+	w.globs = append(w.globs, glob)
+	w.globsMu.Unlock()
+	w.auditLogger.LogFileWatch("glob_watch_start", absPattern)
+	return nil
+}
+
+// checkGlobLimit fails if watching matches would exceed MaxWatchedFiles
+func (w *Watcher) checkGlobLimit(matches []string) *errors.Error {
+	w.filesMu.RLock()
+	defer w.filesMu.RUnlock()
+
+	total := len(w.files)
+	for _, path := range matches {
+		if _, exists := w.files[path]; !exists {
+			total++
+		}
+	}
+	if total > w.config.MaxWatchedFiles {
+		return errors.New(ErrCodeInvalidConfig, "maximum watched files exceeded").
+			WithContext("max_files", w.config.MaxWatchedFiles).
+			WithContext("current_files", len(w.files)).
+			WithContext("matches", len(matches))
+	}
+	return nil
+}
+
+// globFiles returns the regular files matching the absolute pattern, sorted
+func globFiles(pattern string) []string {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil // Pattern validated by WatchGlob
+	}
+	files := matches[:0]
+	for _, path := range matches {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+// refreshGlobs re-evaluates the WatchGlob patterns (poll loop only)
+func (w *Watcher) refreshGlobs() {
+	w.globsMu.Lock()
+	globs := w.globs
+	w.globsMu.Unlock()
+
+	for _, glob := range globs {
+		w.refreshGlob(glob)
+	}
+}
+
+// refreshGlob unwatches the removed files of glob that no longer match and
+// watches the files that started matching
+func (w *Watcher) refreshGlob(glob *globWatch) {
+	current := make(map[string]bool)
+	for _, path := range globFiles(glob.pattern) {
+		current[path] = true
+	}
+
+	glob.mu.Lock()
+	var dropped []string
+	for path := range glob.removed {
+		delete(glob.removed, path)
+		if !current[path] {
+			delete(glob.matched, path)
+			dropped = append(dropped, path)
+		}
+	}
+	var added []string
+	for path := range current {
+		if !glob.matched[path] {
+			added = append(added, path)
+		}
+	}
+	glob.mu.Unlock()
+
+	for _, path := range dropped {
+		if err := w.Unwatch(path); err == nil {
+			w.auditLogger.LogFileWatch("glob_watch_drop", path)
+		}
+	}
+
+	// Refused matches are retried every cycle but reported once per episode
+	refused := false
+	for _, path := range added {
+		if err := w.addGlobMatch(glob, path); err != nil {
+			if !glob.refused && w.config.ErrorHandler != nil {
+				w.config.ErrorHandler(err, path)
+			}
+			refused = true
+			continue
+		}
+		glob.mu.Lock()
+		glob.matched[path] = true
+		glob.mu.Unlock()
+	}
+	glob.refused = refused
+}
+
+// addGlobMatch watches path, a file that started matching glob, and reports
+// it with IsCreate
+func (w *Watcher) addGlobMatch(glob *globWatch, path string) error {
+	if err := w.addWatchedFile(path, glob.deliver, 0, nil); err != nil {
+		return err
+	}
+	w.auditLogger.LogFileWatch("watch_start", path)
+
+	stat, err := w.getStat(path)
+	if err != nil || !stat.exists || w.exceedsMaxFileSize(path, stat.size) {
+		return nil // Gone again: the regular checks report it if it comes back
+	}
+	w.eventRing.writeFileChangeFlags(path, stat.modTime, stat.size, FileEventCreate)
+	return nil
+}
@@ -0,0 +1,141 @@
+// watcher_glob_test.go: Tests for watching files matching a glob pattern
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// writeGlobTestFile creates name in dir and returns its absolute path
+func writeGlobTestFile(t *testing.T, dir, name string) string {
+	t.Helper()
+	path, err := filepath.Abs(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("key: value\n"), 0600); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	return path
+}
+
+func TestWatchGlob_PicksUpAndDropsFiles(t *testing.T) {
+	dir := t.TempDir()
+	first := writeGlobTestFile(t, dir, "a.yaml")
+	writeGlobTestFile(t, dir, "b.json")
+	if err := os.Mkdir(filepath.Join(dir, "dir.yaml"), 0750); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	events := make(chan ChangeEvent, 16)
+	watcher := New(Config{PollInterval: 50 * time.Millisecond, DisableAudit: true})
+	defer watcher.Close()
+	if err := watcher.WatchGlob(filepath.Join(dir, "*.yaml"), func(event ChangeEvent) { events <- event }); err != nil {
+		t.Fatalf("Failed to watch glob: %v", err)
+	}
+	if paths := watcher.WatchedPaths(); !slices.Equal(paths, []string{first}) {
+		t.Fatalf("Expected only %s to be watched, got %v", first, paths)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	waitEvent := func(path string, isDelete bool) {
+		t.Helper()
+		deadline := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-events:
+				if event.Path == path && event.IsCreate == !isDelete && event.IsDelete == isDelete {
+					return
+				}
+			case <-deadline:
+				t.Fatalf("Expected create=%t delete=%t event for %s", !isDelete, isDelete, path)
+			}
+		}
+	}
+
+	second := writeGlobTestFile(t, dir, "c.yaml")
+	waitEvent(second, false)
+
+	if err := os.Remove(first); err != nil {
+		t.Fatalf("Failed to remove file: %v", err)
+	}
+	waitEvent(first, true)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !slices.Equal(watcher.WatchedPaths(), []string{second}) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected only %s to be watched after the removal, got %v", second, watcher.WatchedPaths())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatchGlob_MaxWatchedFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.yaml", "b.yaml", "c.yaml"} {
+		writeGlobTestFile(t, dir, name)
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, MaxWatchedFiles: 2, DisableAudit: true})
+	defer watcher.Close()
+	err := watcher.WatchGlob(filepath.Join(dir, "*.yaml"), func(ChangeEvent) {})
+	if !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Fatalf("Expected ErrCodeInvalidConfig for 3 matches over a limit of 2, got %v", err)
+	}
+	if n := watcher.WatchedFiles(); n != 0 {
+		t.Errorf("Expected no file watched after a refused glob, got %d", n)
+	}
+}
+
+func TestWatchGlob_LaterMatchesOverLimit(t *testing.T) {
+	dir := t.TempDir()
+	writeGlobTestFile(t, dir, "a.yaml")
+
+	var reported []error
+	watcher := New(Config{
+		PollInterval:    time.Hour,
+		MaxWatchedFiles: 2,
+		ErrorHandler:    func(err error, path string) { reported = append(reported, err) },
+		DisableAudit:    true,
+	})
+	defer watcher.Close()
+	if err := watcher.WatchGlob(filepath.Join(dir, "*.yaml"), func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch glob: %v", err)
+	}
+
+	writeGlobTestFile(t, dir, "b.yaml")
+	writeGlobTestFile(t, dir, "c.yaml")
+	watcher.refreshGlobs()
+	watcher.refreshGlobs()
+
+	if n := watcher.WatchedFiles(); n != 2 {
+		t.Errorf("Expected the expanded set capped at 2 files, got %d", n)
+	}
+	if len(reported) != 1 || !errors.HasCode(reported[0], ErrCodeInvalidConfig) {
+		t.Errorf("Expected the refused match reported once, got %v", reported)
+	}
+}
+
+func TestWatchGlob_InvalidPattern(t *testing.T) {
+	watcher := New(Config{PollInterval: time.Hour, DisableAudit: true})
+	defer watcher.Close()
+
+	if err := watcher.WatchGlob(filepath.Join(t.TempDir(), "["), func(ChangeEvent) {}); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a malformed pattern, got %v", err)
+	}
+	if err := watcher.WatchGlob("*.yaml", nil); !errors.HasCode(err, ErrCodeInvalidConfig) {
+		t.Errorf("Expected ErrCodeInvalidConfig for a nil callback, got %v", err)
+	}
+}
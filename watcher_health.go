@@ -0,0 +1,134 @@
+// watcher_health.go: Liveness reporting for the poll loop and event processor
+//
+// Callbacks and error handlers run on Argus goroutines, so a pathological
+// handler or a hung filesystem can wedge the watcher without any error. The
+// poll loop marks every completed cycle, and the event processor marks when
+// it starts delivering an event. A blocked callback does not stall the poll
+// loop (the ring fills up and drops events instead), so Health checks both
+// marks against the poll interval, letting a liveness probe restart a
+// wedged process.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"fmt"
+	"time"
+)
+
+// WatcherHealth is a point-in-time liveness report of a watcher
+type WatcherHealth struct {
+	// Healthy is false when the watcher is not running, its poll loop
+	// stalled or its event processor is blocked in a callback
+	Healthy bool
+
+	// LastPoll is the time of the last completed poll cycle
+	LastPoll time.Time
+
+	// SinceLastPoll is the time elapsed since LastPoll
+	SinceLastPoll time.Duration
+
+	// DeliveryBlockedFor is how long the event processor has been delivering
+	// its current event (0 when idle)
+	DeliveryBlockedFor time.Duration
+
+	// Reason explains why the watcher is not healthy
+	Reason string
+}
+
+// markPoll records the completion of a poll cycle
+func (w *Watcher) markPoll() {
+	w.lastPollMono.Store(monoNow())
+	w.lastPoll.Store(time.Now().UnixNano())
+}
+
+// LastPollTime returns the time of the last completed poll cycle, or the
+// start time before the first cycle completes. Zero if never started.
+func (w *Watcher) LastPollTime() time.Time {
+	nanos := w.lastPoll.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// Health reports whether the poll loop and the event processor are alive.
+// The watcher is degraded when no poll cycle completed within
+// Config.PollStallMultiplier poll intervals, or when the event processor has
+// been delivering the same event for as long, i.e. a callback blocks.
+// Elapsed time is measured on the monotonic clock, so wall-clock jumps
+// neither fake nor hide a stall.
+//
+// Example:
+//
+//	http.HandleFunc("/healthz", func(rw http.ResponseWriter, _ *http.Request) {
+//	    if h := watcher.Health(); !h.Healthy {
+//	        http.Error(rw, h.Reason, http.StatusServiceUnavailable)
+//	    }
+//	})
+func (w *Watcher) Health() WatcherHealth {
+	health := WatcherHealth{LastPoll: w.LastPollTime()}
+	now := monoNow()
+	if !health.LastPoll.IsZero() {
+		health.SinceLastPoll = time.Duration(now - w.lastPollMono.Load())
+	}
+	if since := w.deliveringSince.Load(); since != 0 {
+		health.DeliveryBlockedFor = time.Duration(now - since)
+	}
+
+	if !w.running.Load() {
+		health.Reason = "watcher is not running"
+		return health
+	}
+
+	if threshold := w.stallThreshold(); health.SinceLastPoll > threshold {
+		health.Reason = fmt.Sprintf("no poll cycle completed in %s (threshold %s)",
+			health.SinceLastPoll.Round(time.Millisecond), threshold)
+		return health
+	} else if health.DeliveryBlockedFor > threshold {
+		health.Reason = fmt.Sprintf("event processor blocked in a callback for %s (threshold %s)",
+			health.DeliveryBlockedFor.Round(time.Millisecond), threshold)
+		return health
+	}
+
+	health.Healthy = true
+	return health
+}
+
+// stallThreshold is the time without a completed poll after which the loop
+// counts as stalled
+func (w *Watcher) stallThreshold() time.Duration {
+	return time.Duration(w.config.PollStallMultiplier) * w.config.PollInterval
+}
+
+// watchdogLoop invokes Config.OnPollStall once per stall. It runs on its
+// own goroutine, so it keeps working while the poll loop is wedged.
+func (w *Watcher) watchdogLoop() {
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	reported := false
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+			health := w.Health()
+			if health.Healthy {
+				reported = false
+				continue
+			}
+			if !reported && w.running.Load() {
+				reported = true
+				w.config.OnPollStall(health.LastPoll, max(health.SinceLastPoll, health.DeliveryBlockedFor))
+				w.auditLogger.LogSecurityEvent("poll_stalled", health.Reason,
+					map[string]interface{}{"last_poll": health.LastPoll})
+			}
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// watcher_health_test.go: Tests for poll loop liveness reporting
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcherHealth_StalledPollLoop(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	// The error handler runs on the poll goroutine: blocking it wedges the loop
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	unblock := func() { releaseOnce.Do(func() { close(release) }) }
+	defer unblock()
+
+	stalled := make(chan time.Duration, 1)
+	watcher := New(Config{
+		PollInterval:        10 * time.Millisecond,
+		CacheTTL:            5 * time.Millisecond,
+		MaxFileSize:         16,
+		PollStallMultiplier: 3,
+		DisableAudit:        true,
+		ErrorHandler: func(err error, path string) {
+			<-release
+		},
+		OnPollStall: func(lastPoll time.Time, stalledFor time.Duration) {
+			select {
+			case stalled <- stalledFor:
+			default:
+			}
+		},
+	})
+
+	if h := watcher.Health(); h.Healthy || !h.LastPoll.IsZero() {
+		t.Errorf("Expected unhealthy report before start, got %+v", h)
+	}
+
+	if err := watcher.Watch(configPath, func(event ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() {
+		unblock()
+		_ = watcher.Stop()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	if h := watcher.Health(); !h.Healthy {
+		t.Fatalf("Expected healthy watcher, got %+v", h)
+	}
+	if watcher.LastPollTime().IsZero() {
+		t.Fatal("Expected a last poll time once running")
+	}
+
+	// Growing past MaxFileSize calls the blocking handler from the poll loop
+	if err := os.WriteFile(configPath, []byte(`{"padding": "exceeds the limit"}`), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	select {
+	case stalledFor := <-stalled:
+		if stalledFor < 30*time.Millisecond {
+			t.Errorf("Expected stall beyond the threshold, got %v", stalledFor)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timed out waiting for stall callback")
+	}
+
+	h := watcher.Health()
+	if h.Healthy || h.Reason == "" {
+		t.Errorf("Expected degraded health while stalled, got %+v", h)
+	}
+
+	// The loop recovers once the handler returns
+	unblock()
+	deadline := time.Now().Add(3 * time.Second)
+	for !watcher.Health().Healthy {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected recovery after unblocking, got %+v", watcher.Health())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatcherHealth_BlockedCallback(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	// The callback blocks the event processor while the poll loop keeps going
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	unblock := func() { releaseOnce.Do(func() { close(release) }) }
+	defer unblock()
+
+	stalled := make(chan time.Duration, 1)
+	watcher := New(Config{
+		PollInterval:        10 * time.Millisecond,
+		CacheTTL:            5 * time.Millisecond,
+		PollStallMultiplier: 3,
+		DisableAudit:        true,
+		OnPollStall: func(lastPoll time.Time, stalledFor time.Duration) {
+			select {
+			case stalled <- stalledFor:
+			default:
+			}
+		},
+	})
+	if err := watcher.Watch(configPath, func(event ChangeEvent) { <-release }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() {
+		unblock()
+		_ = watcher.Stop()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	if h := watcher.Health(); !h.Healthy {
+		t.Fatalf("Expected healthy watcher, got %+v", h)
+	}
+
+	if err := os.WriteFile(configPath, []byte(`{"changed": true}`), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	select {
+	case stalledFor := <-stalled:
+		if stalledFor < 30*time.Millisecond {
+			t.Errorf("Expected stall beyond the threshold, got %v", stalledFor)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timed out waiting for stall callback")
+	}
+
+	h := watcher.Health()
+	if h.Healthy || h.DeliveryBlockedFor < 30*time.Millisecond {
+		t.Errorf("Expected degraded health with a blocked delivery, got %+v", h)
+	}
+	if !strings.Contains(h.Reason, "callback") {
+		t.Errorf("Expected the blocked callback as the reason, got %q", h.Reason)
+	}
+
+	unblock()
+	deadline := time.Now().Add(3 * time.Second)
+	for !watcher.Health().Healthy {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected recovery after unblocking, got %+v", watcher.Health())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
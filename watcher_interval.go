@@ -0,0 +1,100 @@
+// watcher_interval.go: Per-file polling intervals
+//
+// A feature-flag file may need checking several times a second while a
+// certificate changes once a month. WatchWithInterval gives a file its own
+// schedule: the poll loop ticks at the shortest interval of any watched file
+// and checks each file only when its own interval has elapsed.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// WatchWithInterval is Watch with a poll interval for this file only. Files
+// watched without an interval keep using Config.PollInterval. Intervals
+// below 10ms are rejected with ErrPollIntervalTooSmall, like
+// Config.PollInterval. The interval belongs to the file: watching the path
+// again with WatchWithInterval changes it, while Watch keeps it. Once the
+// file is unwatched the poll loop slows back down.
+//
+// Example:
+//
+//	_ = watcher.WatchWithInterval("flags.json", 200*time.Millisecond, onFlags)
+//	_ = watcher.WatchWithInterval("tls/cert.pem", 30*time.Second, onCert)
+func (w *Watcher) WatchWithInterval(path string, interval time.Duration, callback UpdateCallback) error {
+	if err := validatePollInterval(interval); err != nil {
+		return err
+	}
+	return w.watch(context.Background(), path, callback, interval)
+}
+
+// validatePollInterval applies the Config.PollInterval checks to interval
+func validatePollInterval(interval time.Duration) error {
+	const maxDuration = time.Duration(math.MaxInt64)
+	if err := checkRange(interval, 1, maxDuration, ErrInvalidPollInterval); err != nil {
+		return err
+	}
+	return checkRange(interval, 10*time.Millisecond, maxDuration, ErrPollIntervalTooSmall)
+}
+
+// setPollInterval gives wf its own poll interval and wakes the poll loop so
+// it can tick faster. A zero interval leaves wf unchanged. Called with
+// filesMu held.
+func (w *Watcher) setPollInterval(wf *watchedFile, interval time.Duration) {
+	if interval == 0 {
+		return
+	}
+	wf.interval = interval
+	w.wakePollLoop()
+}
+
+// wakePollLoop makes the poll loop recompute its tick, after a file with its
+// own interval was watched or unwatched
+func (w *Watcher) wakePollLoop() {
+	select {
+	case w.intervalCh <- struct{}{}:
+	default: // A wake-up is already pending
+	}
+}
+
+// pollTick returns the poll loop period: the shortest poll interval of any
+// watched file, Config.PollInterval when no file has a shorter one
+func (w *Watcher) pollTick() time.Duration {
+	w.filesMu.RLock()
+	defer w.filesMu.RUnlock()
+
+	tick := w.config.PollInterval
+	for _, wf := range w.files {
+		if wf.interval > 0 && wf.interval < tick {
+			tick = wf.interval
+		}
+	}
+	return tick
+}
+
+// isDue reports whether wf should be checked by the poll at now, a monoNow
+// value, and schedules its next check. Files whose interval is not longer
+// than the tick are checked every tick; the others once their interval has
+// elapsed, give or take half a tick of timer jitter. Called by the poll loop
+// with pollMu and filesMu (read) held.
+func (w *Watcher) isDue(wf *watchedFile, now int64, tick time.Duration) bool {
+	interval := wf.interval
+	if interval == 0 {
+		interval = w.config.PollInterval
+	}
+	if interval <= tick {
+		return true
+	}
+	if now+int64(tick/2) < wf.nextPoll {
+		return false
+	}
+	wf.nextPoll = now + int64(interval)
+	return true
+}
@@ -0,0 +1,121 @@
+// watcher_interval_test.go: Tests for per-file polling intervals
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestWatcher_WatchWithInterval(t *testing.T) {
+	dir := t.TempDir()
+	flagsPath := filepath.Join(dir, "flags.json")
+	certPath := filepath.Join(dir, "cert.json")
+	for _, path := range []string{flagsPath, certPath} {
+		if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+	}
+
+	watcher := New(Config{PollInterval: 10 * time.Second, CacheTTL: 5 * time.Millisecond, DisableAudit: true})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	certChanged := make(chan struct{}, 4)
+	if err := watcher.Watch(certPath, func(ChangeEvent) { certChanged <- struct{}{} }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	// Registered after Start: the running loop must speed up for it
+	flagsChanged := make(chan struct{}, 4)
+	if err := watcher.WatchWithInterval(flagsPath, 20*time.Millisecond, func(ChangeEvent) { flagsChanged <- struct{}{} }); err != nil {
+		t.Fatalf("Failed to watch file with interval: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond) // Let the first fast poll record the baseline
+
+	for _, path := range []string{flagsPath, certPath} {
+		if err := os.WriteFile(path, []byte(`{"v": 2, "changed": true}`), 0600); err != nil {
+			t.Fatalf("Failed to update config file: %v", err)
+		}
+	}
+
+	select {
+	case <-flagsChanged:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the file with a 20ms interval to be reported quickly")
+	}
+	select {
+	case <-certChanged:
+		t.Error("Expected the file on the 10s global interval not to be polled yet")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestWatcher_WatchWithIntervalValidation(t *testing.T) {
+	watcher := New(Config{DisableAudit: true})
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	tests := map[time.Duration]errors.ErrorCode{
+		0:                    ErrCodeInvalidPollInterval,
+		-time.Second:         ErrCodeInvalidPollInterval,
+		5 * time.Millisecond: ErrCodePollIntervalTooSmall,
+	}
+	for interval, code := range tests {
+		if err := watcher.WatchWithInterval(path, interval, func(ChangeEvent) {}); !errors.HasCode(err, code) {
+			t.Errorf("Interval %v: expected %s, got %v", interval, code, err)
+		}
+	}
+	if watcher.WatchedFiles() != 0 {
+		t.Error("Expected rejected intervals not to register the file")
+	}
+
+	if err := watcher.WatchWithInterval(path, 10*time.Millisecond, func(ChangeEvent) {}); err != nil {
+		t.Errorf("Expected the 10ms floor to be accepted, got %v", err)
+	}
+	if tick := watcher.pollTick(); tick != 10*time.Millisecond {
+		t.Errorf("Expected the poll loop to tick at 10ms, got %v", tick)
+	}
+}
+
+func TestWatcher_UnwatchRestoresPollTick(t *testing.T) {
+	dir := t.TempDir()
+	flagsPath := filepath.Join(dir, "flags.json")
+	if err := os.WriteFile(flagsPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: 10 * time.Second, DisableAudit: true})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	if err := watcher.WatchWithInterval(flagsPath, 20*time.Millisecond, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file with interval: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if watcher.pollCycles.Load() == 0 {
+		t.Fatal("Expected the poll loop to tick at the 20ms interval")
+	}
+
+	// Without the fast file the loop must fall back to the 10s PollInterval
+	if err := watcher.Unwatch(flagsPath); err != nil {
+		t.Fatalf("Failed to unwatch file: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond) // Let the loop pick up the wake-up
+	cycles := watcher.pollCycles.Load()
+	time.Sleep(200 * time.Millisecond)
+	if extra := watcher.pollCycles.Load() - cycles; extra > 0 {
+		t.Errorf("Expected no poll cycles after unwatching the fast file, got %d", extra)
+	}
+}
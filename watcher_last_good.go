@@ -0,0 +1,71 @@
+// watcher_last_good.go: Last known good configuration for universal watchers
+//
+// Editors and deployment tools rarely replace a file atomically: a reload can
+// see half a YAML document or an empty file. With Config.KeepLastGood, a
+// universal watcher remembers the last configuration it delivered for each
+// path and treats a file that fails to read, parse or validate (or is empty)
+// as a transient problem: the error is reported, the callback is not fired,
+// and Config.OnInvalid may hand the retained configuration to the
+// application.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"bytes"
+
+	"github.com/agilira/go-errors"
+)
+
+// InvalidConfigHandler is invoked with KeepLastGood when the file at path
+// fails to load. lastGood is the last configuration delivered for path, or
+// nil when none was; err is the failure also passed to the ErrorHandler.
+type InvalidConfigHandler func(path string, lastGood map[string]interface{}, err error)
+
+// LastGoodConfig returns the last configuration a universal watcher
+// delivered for path. Always false unless Config.KeepLastGood is set.
+func (w *Watcher) LastGoodConfig(path string) (map[string]interface{}, bool) {
+	w.lastGoodMu.RLock()
+	defer w.lastGoodMu.RUnlock()
+	config, ok := w.lastGood[overridePath(path)]
+	return copyMap(config), ok
+}
+
+// checkNotEmpty rejects an empty file, usually one caught mid-write, when
+// KeepLastGood is set
+func (w *Watcher) checkNotEmpty(path string, data []byte) error {
+	if w.config.KeepLastGood && len(bytes.TrimSpace(data)) == 0 {
+		return errors.New(ErrCodeInvalidConfig, "config file is empty").WithContext("path", path)
+	}
+	return nil
+}
+
+// keepLastGood records config as the last good configuration of path
+func (w *Watcher) keepLastGood(path string, config map[string]interface{}) {
+	if !w.config.KeepLastGood {
+		return
+	}
+	w.lastGoodMu.Lock()
+	if w.lastGood == nil {
+		w.lastGood = make(map[string]map[string]interface{})
+	}
+	w.lastGood[overridePath(path)] = copyMap(config)
+	w.lastGoodMu.Unlock()
+}
+
+// rejectConfig reports a configuration that failed to load to the
+// ErrorHandler and, with KeepLastGood, to OnInvalid with the last good one
+func (w *Watcher) rejectConfig(path string, err error) {
+	if w.config.ErrorHandler != nil {
+		w.config.ErrorHandler(err, path)
+	}
+	if !w.config.KeepLastGood || w.config.OnInvalid == nil {
+		return
+	}
+
+	lastGood, _ := w.LastGoodConfig(path)
+	w.config.OnInvalid(path, lastGood, err)
+}
@@ -0,0 +1,103 @@
+// watcher_last_good_test.go: Tests for last known good configurations
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestKeepLastGood_RetainsConfigOnInvalidReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"version": "v1"}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	type invalid struct {
+		lastGood map[string]interface{}
+		err      error
+	}
+	configs := make(chan map[string]interface{}, 4)
+	invalids := make(chan invalid, 4)
+	watcher, err := UniversalConfigWatcherWithConfig(path, func(config map[string]interface{}) {
+		configs <- config
+	}, Config{
+		PollInterval: 50 * time.Millisecond,
+		ErrorHandler: func(error, string) {},
+		KeepLastGood: true,
+		OnInvalid: func(_ string, lastGood map[string]interface{}, err error) {
+			invalids <- invalid{lastGood, err}
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+	<-configs
+
+	write := func(content string) {
+		t.Helper()
+		time.Sleep(20 * time.Millisecond)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+	}
+	expectInvalid := func() {
+		t.Helper()
+		select {
+		case inv := <-invalids:
+			if inv.err == nil || inv.lastGood["version"] != "v1" {
+				t.Errorf("Expected the last good v1 with an error, got %v (%v)", inv.lastGood, inv.err)
+			}
+		case config := <-configs:
+			t.Fatalf("Expected the invalid file not to be delivered, got %v", config)
+		case <-time.After(3 * time.Second):
+			t.Fatal("Timed out waiting for OnInvalid")
+		}
+	}
+
+	write(`{"version": `) // Mid-edit
+	expectInvalid()
+	write("") // Truncated before the new content is written
+	expectInvalid()
+
+	if config, ok := watcher.LastGoodConfig(path); !ok || config["version"] != "v1" {
+		t.Errorf("Expected LastGoodConfig v1, got %v (%v)", config, ok)
+	}
+
+	write(`{"version": "v2"}`)
+	select {
+	case config := <-configs:
+		if config["version"] != "v2" {
+			t.Errorf("Expected v2, got %v", config)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Timed out waiting for v2")
+	}
+	if config, _ := watcher.LastGoodConfig(path); config["version"] != "v2" {
+		t.Errorf("Expected LastGoodConfig v2, got %v", config)
+	}
+}
+
+func TestKeepLastGood_Disabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"version": "v1"}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	watcher, err := UniversalConfigWatcherWithConfig(path, func(map[string]interface{}) {}, Config{})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	if _, ok := watcher.LastGoodConfig(path); ok {
+		t.Error("Expected no last good configuration without KeepLastGood")
+	}
+}
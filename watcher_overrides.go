@@ -0,0 +1,128 @@
+// watcher_overrides.go: In-process overrides layered over parsed configurations
+//
+// Tests, feature-flag experiments and emergency fixes sometimes need a value
+// different from the one on disk without touching the file. Overrides are
+// applied every time a universal watcher parses the file, so they survive
+// reloads until cleared, and every change is recorded in the audit trail.
+// Setting or clearing one re-delivers the file at once, so it takes effect
+// without waiting for the file to change.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// OverrideValue layers value over key in the configuration parsed from path.
+// Nested keys use dot notation ("database.port"). If path is watched by a
+// running watcher, its callbacks are re-delivered the current state of the
+// file as an IsModify event, so a universal watcher re-parses it and its
+// callback sees the override at once. Callbacks and binders fed by a
+// universal watcher then see it on every reload until ClearOverride removes
+// it. Empty keys are ignored.
+//
+// Example:
+//
+//	watcher.OverrideValue("config.yaml", "features.new_checkout", true)
+//	defer watcher.ClearOverride("config.yaml", "features.new_checkout")
+func (w *Watcher) OverrideValue(path, key string, value interface{}) {
+	if key == "" {
+		return
+	}
+	absPath := overridePath(path)
+
+	w.overridesMu.Lock()
+	if w.overrides == nil {
+		w.overrides = make(map[string]map[string]interface{})
+	}
+	if w.overrides[absPath] == nil {
+		w.overrides[absPath] = make(map[string]interface{})
+	}
+	previous := w.overrides[absPath][key]
+	w.overrides[absPath][key] = value
+	w.overridesMu.Unlock()
+
+	w.auditLogger.Log(AuditCritical, "config_override_set", "argus", absPath, previous, value,
+		map[string]interface{}{"key": key})
+	w.redeliver(absPath)
+}
+
+// ClearOverride removes the override of key for path and re-delivers the
+// file like OverrideValue, so the value from the file applies again at once.
+// Clearing a key that is not overridden is a no-op.
+func (w *Watcher) ClearOverride(path, key string) {
+	absPath := overridePath(path)
+
+	w.overridesMu.Lock()
+	previous, existed := w.overrides[absPath][key]
+	if existed {
+		delete(w.overrides[absPath], key)
+		if len(w.overrides[absPath]) == 0 {
+			delete(w.overrides, absPath)
+		}
+	}
+	w.overridesMu.Unlock()
+
+	if existed {
+		w.auditLogger.Log(AuditCritical, "config_override_cleared", "argus", absPath, previous, nil,
+			map[string]interface{}{"key": key})
+		w.redeliver(absPath)
+	}
+}
+
+// redeliver queues the current state of absPath for its callbacks, if it is
+// watched by a running watcher
+func (w *Watcher) redeliver(absPath string) {
+	if !w.running.Load() {
+		return
+	}
+	w.filesMu.RLock()
+	_, watched := w.files[absPath]
+	w.filesMu.RUnlock()
+	if watched {
+		w.fireInitialEvent(absPath)
+	}
+}
+
+// Overrides returns a copy of the active overrides for path
+func (w *Watcher) Overrides(path string) map[string]interface{} {
+	w.overridesMu.RLock()
+	defer w.overridesMu.RUnlock()
+
+	active := w.overrides[overridePath(path)]
+	overrides := make(map[string]interface{}, len(active))
+	for key, value := range active {
+		overrides[key] = value
+	}
+	return overrides
+}
+
+// applyOverrides writes the active overrides for path into config and
+// returns it, allocating a map when config is nil
+func (w *Watcher) applyOverrides(path string, config map[string]interface{}) map[string]interface{} {
+	w.overridesMu.RLock()
+	defer w.overridesMu.RUnlock()
+
+	overrides := w.overrides[overridePath(path)]
+	if len(overrides) > 0 && config == nil {
+		config = make(map[string]interface{}, len(overrides))
+	}
+	for key, value := range overrides {
+		setNestedValue(config, strings.Split(key, "."), value)
+	}
+	return config
+}
+
+// overridePath returns the key overrides are stored under, so relative and
+// absolute spellings of the same file share their overrides
+func overridePath(path string) string {
+	if absPath, err := filepath.Abs(path); err == nil {
+		return absPath
+	}
+	return filepath.Clean(path)
+}
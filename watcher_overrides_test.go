@@ -0,0 +1,118 @@
+// watcher_overrides_test.go: Tests for in-process configuration overrides
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatcherOverrideValue_SurvivesReloadUntilCleared(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte("port: 8080\ndatabase:\n  host: db\n"), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	configs := make(chan map[string]interface{}, 8)
+	watcher, err := UniversalConfigWatcherWithConfig(configPath, func(config map[string]interface{}) {
+		configs <- config
+	}, Config{PollInterval: 20 * time.Millisecond, CacheTTL: 10 * time.Millisecond, DisableAudit: true})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	next := func() map[string]interface{} {
+		t.Helper()
+		select {
+		case config := <-configs:
+			return config
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timed out waiting for a config reload")
+			return nil
+		}
+	}
+
+	// Replace the file atomically so a poll never parses a half-written file
+	rewrite := func(data string) {
+		t.Helper()
+		tmpPath := configPath + ".tmp"
+		if err := os.WriteFile(tmpPath, []byte(data), 0600); err != nil {
+			t.Fatalf("Failed to write config file: %v", err)
+		}
+		if err := os.Rename(tmpPath, configPath); err != nil {
+			t.Fatalf("Failed to replace config file: %v", err)
+		}
+	}
+
+	// Setting or clearing overrides re-delivers the file, once per call
+	until := func(match func(map[string]interface{}) bool) map[string]interface{} {
+		t.Helper()
+		for {
+			if config := next(); match(config) {
+				return config
+			}
+		}
+	}
+
+	if initial := next(); initial["port"] != 8080 {
+		t.Fatalf("Expected initial port 8080, got %+v", initial)
+	}
+
+	// Applied at once, without touching the file
+	watcher.OverrideValue(configPath, "port", 9090)
+	if config := next(); config["port"] != 9090 {
+		t.Errorf("Expected overridden port 9090 without a file change, got %v", config["port"])
+	}
+	watcher.OverrideValue(configPath, "database.host", "replica")
+	if config := next(); config["database"].(map[string]interface{})["host"] != "replica" {
+		t.Errorf("Expected overridden database host without a file change, got %+v", config)
+	}
+	if overrides := watcher.Overrides(configPath); len(overrides) != 2 {
+		t.Errorf("Expected 2 active overrides, got %+v", overrides)
+	}
+
+	rewrite("port: 8081\ndatabase:\n  host: db\n  name: app\n")
+	config := until(func(config map[string]interface{}) bool {
+		database, _ := config["database"].(map[string]interface{})
+		return database["name"] == "app"
+	})
+	if config["port"] != 9090 {
+		t.Errorf("Expected overridden port 9090 after reload, got %v", config["port"])
+	}
+	var port int
+	if err := NewConfigBinder(config).BindInt(&port, "port").Apply(); err != nil || port != 9090 {
+		t.Errorf("Expected binder to read overridden port 9090, got %d (%v)", port, err)
+	}
+	database, _ := config["database"].(map[string]interface{})
+	if database["host"] != "replica" || database["name"] != "app" {
+		t.Errorf("Expected nested override next to file values, got %+v", database)
+	}
+
+	watcher.ClearOverride(configPath, "port")
+	watcher.ClearOverride(configPath, "database.host")
+	watcher.ClearOverride(configPath, "missing")
+	if overrides := watcher.Overrides(configPath); len(overrides) != 0 {
+		t.Errorf("Expected no active overrides, got %+v", overrides)
+	}
+	config = until(func(config map[string]interface{}) bool {
+		database, _ := config["database"].(map[string]interface{})
+		return database["host"] == "db"
+	})
+	if config["port"] != 8081 {
+		t.Errorf("Expected the file port 8081 once the override is cleared, got %v", config["port"])
+	}
+
+	rewrite("port: 8082\ndatabase:\n  host: primary\n")
+	config = until(func(config map[string]interface{}) bool { return config["port"] == 8082 })
+	database, _ = config["database"].(map[string]interface{})
+	if config["port"] != 8082 || database["host"] != "primary" {
+		t.Errorf("Expected file values after clearing overrides, got %+v", config)
+	}
+}
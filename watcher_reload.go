@@ -0,0 +1,87 @@
+// watcher_reload.go: On-demand re-check of every watched file
+//
+// Polling trades latency for simplicity: a change is seen on the next tick at
+// the earliest, and later still while the stat cache is warm. Applications
+// that learn about a change out of band, typically from SIGHUP, can call
+// Reload to check every file right away instead of waiting for the poll loop,
+// or ReloadPath to check a single file, e.g. from an admin endpoint.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"path/filepath"
+
+	"github.com/agilira/go-errors"
+)
+
+// Reload immediately checks every watched file, bypassing the stat cache and
+// per-file polling intervals, and fires the callbacks of files that changed
+// since they were last checked. Callbacks run asynchronously on the event
+// processor, as they do for changes found by the poll loop. Reload is safe to
+// call concurrently with the poll loop: poll cycles and reloads never overlap.
+//
+//	hup := make(chan os.Signal, 1)
+//	signal.Notify(hup, syscall.SIGHUP)
+//	go func() {
+//	    for range hup {
+//	        _ = watcher.Reload()
+//	    }
+//	}()
+//
+// Reload fails with ErrCodeWatcherStopped if the watcher is not running.
+func (w *Watcher) Reload() error {
+	if !w.running.Load() {
+		return errors.New(ErrCodeWatcherStopped, "watcher is not running")
+	}
+
+	w.auditLogger.LogFileWatch("reload_requested", "")
+	w.ClearCache()
+	w.pollFiles()
+	return nil
+}
+
+// ReloadPath immediately checks the watched file at path, bypassing the stat
+// cache and its polling interval, and fires its callbacks if it changed since
+// it was last checked. Like Reload, it is safe to call concurrently with the
+// poll loop.
+//
+//	http.HandleFunc("POST /config/reload", func(w http.ResponseWriter, r *http.Request) {
+//	    if err := watcher.ReloadPath(r.URL.Query().Get("file")); err != nil {
+//	        http.Error(w, err.Error(), http.StatusNotFound)
+//	    }
+//	})
+//
+// ReloadPath fails with ErrCodeFileNotFound if path is not watched and with
+// ErrCodeWatcherStopped if the watcher is not running.
+func (w *Watcher) ReloadPath(path string) error {
+	if !w.running.Load() {
+		return errors.New(ErrCodeWatcherStopped, "watcher is not running")
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return errors.Wrap(err, ErrCodeInvalidConfig, "invalid file path").
+			WithContext("path", path)
+	}
+
+	w.filesMu.RLock()
+	wf, exists := w.files[absPath]
+	w.filesMu.RUnlock()
+	if !exists {
+		return errors.New(ErrCodeFileNotFound, "file is not watched").
+			WithContext("path", path)
+	}
+
+	w.auditLogger.LogFileWatch("reload_requested", absPath)
+	w.removeFromCache(absPath)
+
+	// Serialized with poll cycles, which also update the file's last state
+	w.pollMu.Lock()
+	defer w.pollMu.Unlock()
+	w.checkFile(wf)
+	return nil
+}
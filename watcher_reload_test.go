@@ -0,0 +1,182 @@
+// watcher_reload_test.go: Tests for on-demand re-checks with Watcher.Reload
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestWatcher_Reload(t *testing.T) {
+	dir := t.TempDir()
+	changedPath := filepath.Join(dir, "changed.json")
+	untouchedPath := filepath.Join(dir, "untouched.json")
+	for _, path := range []string{changedPath, untouchedPath} {
+		if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+	}
+
+	// Neither the poll loop nor the stat cache would see the change for an hour
+	watcher := New(Config{PollInterval: time.Hour, CacheTTL: time.Hour, DisableAudit: true})
+	changed := make(chan string, 4)
+	for _, path := range []string{changedPath, untouchedPath} {
+		if err := watcher.Watch(path, func(event ChangeEvent) { changed <- event.Path }); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	if err := os.WriteFile(changedPath, []byte(`{"v": 2, "changed": true}`), 0600); err != nil {
+		t.Fatalf("Failed to update config file: %v", err)
+	}
+	if err := watcher.Reload(); err != nil {
+		t.Fatalf("Failed to reload: %v", err)
+	}
+
+	select {
+	case path := <-changed:
+		if filepath.Base(path) != "changed.json" {
+			t.Errorf("Expected a callback for changed.json, got %s", path)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected Reload to fire the callback of the changed file")
+	}
+	select {
+	case path := <-changed:
+		t.Errorf("Expected no callback for an unchanged file, got %s", path)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// A second reload finds nothing new
+	if err := watcher.Reload(); err != nil {
+		t.Fatalf("Failed to reload: %v", err)
+	}
+	select {
+	case path := <-changed:
+		t.Errorf("Expected no callback after an idle reload, got %s", path)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWatcher_ReloadConcurrentWithPolling(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: time.Millisecond, CacheTTL: time.Millisecond, DisableAudit: true})
+	if err := watcher.Watch(path, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if err := watcher.Reload(); err != nil {
+					t.Errorf("Failed to reload: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWatcher_ReloadNotRunning(t *testing.T) {
+	watcher := New(Config{DisableAudit: true})
+
+	err := watcher.Reload()
+	if !errors.HasCode(err, ErrCodeWatcherStopped) {
+		t.Errorf("Expected ErrCodeWatcherStopped, got %v", err)
+	}
+}
+
+func TestWatcher_ReloadPath(t *testing.T) {
+	dir := t.TempDir()
+	flagsPath := filepath.Join(dir, "flags.json")
+	otherPath := filepath.Join(dir, "other.json")
+	for _, path := range []string{flagsPath, otherPath} {
+		if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, CacheTTL: time.Hour, DisableAudit: true})
+	changed := make(chan string, 4)
+	for _, path := range []string{flagsPath, otherPath} {
+		if err := watcher.Watch(path, func(event ChangeEvent) { changed <- event.Path }); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	// Both files change, only the reloaded one is reported
+	for _, path := range []string{flagsPath, otherPath} {
+		if err := os.WriteFile(path, []byte(`{"v": 2, "changed": true}`), 0600); err != nil {
+			t.Fatalf("Failed to update config file: %v", err)
+		}
+	}
+	if err := watcher.ReloadPath(flagsPath); err != nil {
+		t.Fatalf("Failed to reload path: %v", err)
+	}
+
+	select {
+	case path := <-changed:
+		if filepath.Base(path) != "flags.json" {
+			t.Errorf("Expected a callback for flags.json, got %s", path)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected ReloadPath to fire the callback of the changed file")
+	}
+	select {
+	case path := <-changed:
+		t.Errorf("Expected no callback for a file that was not reloaded, got %s", path)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Unchanged since the last check: no callback
+	if err := watcher.ReloadPath(flagsPath); err != nil {
+		t.Fatalf("Failed to reload path: %v", err)
+	}
+	select {
+	case path := <-changed:
+		t.Errorf("Expected no callback for an unchanged file, got %s", path)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWatcher_ReloadPathNotWatched(t *testing.T) {
+	watcher := New(Config{PollInterval: time.Hour, DisableAudit: true})
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	err := watcher.ReloadPath(filepath.Join(t.TempDir(), "missing.json"))
+	if !errors.HasCode(err, ErrCodeFileNotFound) {
+		t.Errorf("Expected ErrCodeFileNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+// watcher_remote.go: Remote configuration sync driven by the Watcher
+//
+// With Config.Remote enabled, the Watcher owns a RemoteConfigManager: Start
+// begins the remote sync and every configuration change it loads travels the
+// BoreasLite ring like a file change, reaching WatchRemote callbacks and the
+// Events channel. Local files and remote sources are watched by one watcher
+// with one delivery path, one shutdown and one audit trail.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// fileEventRemote marks ring events produced by the remote sync, which are
+// delivered to WatchRemote callbacks instead of file callbacks
+const fileEventRemote uint8 = 1 << 4
+
+// initRemote creates the remote sync for Config.Remote. An invalid
+// RemoteConfig is reported to the ErrorHandler and leaves remote sync off.
+func (w *Watcher) initRemote() {
+	remote := w.config.Remote
+	manager, err := NewRemoteConfigManager(&remote, w)
+	if err != nil {
+		if w.config.ErrorHandler != nil {
+			w.config.ErrorHandler(err, remote.PrimaryURL)
+		}
+		return
+	}
+	manager.onChange = w.publishRemoteChange
+	w.remote = manager
+}
+
+// startRemote performs the initial remote load and starts the sync loop.
+// Runs on its own goroutine so slow remote sources do not delay Start.
+func (w *Watcher) startRemote() {
+	if err := w.remote.Start(); err != nil && w.config.ErrorHandler != nil {
+		w.config.ErrorHandler(err, w.config.Remote.PrimaryURL)
+	}
+}
+
+// publishRemoteChange queues a remote configuration change on the ring
+func (w *Watcher) publishRemoteChange(_ map[string]interface{}, source string) {
+	w.eventRing.writeFileChangeFlags(source, time.Now(), 0, FileEventModify|fileEventRemote)
+}
+
+// deliverRemoteEvent invokes the WatchRemote callbacks and publishes event
+func (w *Watcher) deliverRemoteEvent(event ChangeEvent) {
+	// Ring paths are truncated: report the source in full
+	if source, _ := w.remote.source.Load().(string); source != "" {
+		event.Path = source
+	}
+
+	w.remoteMu.RLock()
+	callbacks := w.remoteCallbacks
+	w.remoteMu.RUnlock()
+
+	for _, callback := range callbacks {
+		w.invokeCallback(callback, event)
+	}
+	w.publishEvent(event)
+}
+
+// WatchRemote registers callback for changes of the remote configuration
+// synced from Config.Remote. The callback receives a ChangeEvent with
+// IsModify set and Path naming the source that served the new
+// configuration: PrimaryURL, FallbackURL or FallbackPath. It fires once for
+// the initial load and again whenever a sync returns a different
+// configuration or fails over to another source with different content.
+// Read the configuration itself with RemoteConfig.
+//
+// Example:
+//
+//	watcher := argus.New(argus.Config{Remote: argus.RemoteConfig{
+//	    Enabled:      true,
+//	    PrimaryURL:   "consul://localhost:8500/config/myapp",
+//	    FallbackPath: "/etc/myapp/config.json",
+//	}})
+//	err := watcher.WatchRemote(func(event argus.ChangeEvent) {
+//	    config, _, _ := watcher.RemoteConfig()
+//	    log.Printf("configuration reloaded from %s: %v", event.Path, config)
+//	})
+func (w *Watcher) WatchRemote(callback UpdateCallback) error {
+	if callback == nil {
+		return errors.New(ErrCodeInvalidConfig, "callback cannot be nil")
+	}
+	if w.remote == nil {
+		return errors.New(ErrCodeInvalidConfig, "remote configuration is not enabled")
+	}
+
+	w.remoteMu.Lock()
+	w.remoteCallbacks = append(w.remoteCallbacks[:len(w.remoteCallbacks):len(w.remoteCallbacks)], callback)
+	w.remoteMu.Unlock()
+	return nil
+}
+
+// RemoteCircuitState returns the state of the Config.Remote circuit breaker,
+// CircuitClosed when remote configuration or the breaker is not enabled
+func (w *Watcher) RemoteCircuitState() CircuitState {
+	if w.remote == nil {
+		return CircuitClosed
+	}
+	return w.remote.CircuitState()
+}
+
+// RemoteConfig returns the configuration most recently loaded from
+// Config.Remote and the time it was loaded. Returns ErrCodeConfigNotFound
+// before the first successful load and ErrCodeInvalidConfig when remote
+// configuration is not enabled.
+func (w *Watcher) RemoteConfig() (map[string]interface{}, time.Time, error) {
+	if w.remote == nil {
+		return nil, time.Time{}, errors.New(ErrCodeInvalidConfig, "remote configuration is not enabled")
+	}
+	return w.remote.GetCurrentConfig()
+}
@@ -0,0 +1,172 @@
+// watcher_remote_test.go: Tests for remote configuration sync in the Watcher
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// switchableRemoteProvider serves a settable configuration or fails
+type switchableRemoteProvider struct {
+	mu     sync.Mutex
+	config map[string]interface{}
+	down   bool
+}
+
+func (p *switchableRemoteProvider) Name() string                    { return "Switchable Test Provider" }
+func (p *switchableRemoteProvider) Scheme() string                  { return "switchtest" }
+func (p *switchableRemoteProvider) Validate(configURL string) error { return nil }
+
+func (p *switchableRemoteProvider) Load(ctx context.Context, configURL string) (map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.down {
+		return nil, fmt.Errorf("remote source unavailable")
+	}
+	return p.config, nil
+}
+
+func (p *switchableRemoteProvider) Watch(ctx context.Context, configURL string) (<-chan map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *switchableRemoteProvider) HealthCheck(ctx context.Context, configURL string) error {
+	return nil
+}
+
+func (p *switchableRemoteProvider) set(config map[string]interface{}, down bool) {
+	p.mu.Lock()
+	p.config, p.down = config, down
+	p.mu.Unlock()
+}
+
+// registerSwitchableProvider returns the registered provider serving config;
+// the registry is global, so repeated runs (-count=N) reuse it
+func registerSwitchableProvider(t *testing.T, config map[string]interface{}) *switchableRemoteProvider {
+	t.Helper()
+	if registered, err := GetRemoteProvider("switchtest"); err == nil {
+		provider := registered.(*switchableRemoteProvider)
+		provider.set(config, false)
+		return provider
+	}
+
+	provider := &switchableRemoteProvider{config: config}
+	if err := RegisterRemoteProvider(provider); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+	return provider
+}
+
+func TestWatcherRemoteConfig_FailoverAndRecovery(t *testing.T) {
+	dir := t.TempDir()
+	fallbackPath := filepath.Join(dir, "fallback.json")
+	if err := os.WriteFile(fallbackPath, []byte(`{"version": "fallback"}`), 0644); err != nil {
+		t.Fatalf("Failed to write fallback file: %v", err)
+	}
+	auditPath := filepath.Join(dir, "audit.jsonl")
+	provider := registerSwitchableProvider(t, map[string]interface{}{"version": "v1"})
+
+	primaryURL := "switchtest://primary/config"
+	watcher := New(Config{
+		PollInterval: 50 * time.Millisecond,
+		Audit: AuditConfig{
+			Enabled:       true,
+			OutputFile:    auditPath,
+			MinLevel:      AuditInfo,
+			BufferSize:    100,
+			FlushInterval: time.Hour,
+		},
+		Remote: RemoteConfig{
+			Enabled:      true,
+			PrimaryURL:   primaryURL,
+			FallbackPath: fallbackPath,
+			SyncInterval: 50 * time.Millisecond,
+			Timeout:      20 * time.Millisecond,
+			RetryDelay:   5 * time.Millisecond,
+		},
+	})
+
+	events := make(chan ChangeEvent, 16)
+	if err := watcher.WatchRemote(func(event ChangeEvent) { events <- event }); err != nil {
+		t.Fatalf("Failed to watch remote configuration: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	expect := func(path, version string) {
+		t.Helper()
+		select {
+		case event := <-events:
+			if event.Path != path || !event.IsModify {
+				t.Errorf("Expected modify event from %s, got %+v", path, event)
+			}
+			config, _, err := watcher.RemoteConfig()
+			if err != nil {
+				t.Fatalf("Failed to read remote configuration: %v", err)
+			}
+			if config["version"] != version {
+				t.Errorf("Expected version %s, got %v", version, config["version"])
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timed out waiting for remote change from %s", path)
+		}
+	}
+
+	expect(primaryURL, "v1")
+
+	// Unchanged syncs deliver nothing
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case event := <-events:
+		t.Errorf("Expected no event for an unchanged configuration, got %+v", event)
+	default:
+	}
+
+	provider.set(nil, true)
+	expect(fallbackPath, "fallback")
+
+	provider.set(map[string]interface{}{"version": "v2"}, false)
+	expect(primaryURL, "v2")
+
+	if err := watcher.Close(); err != nil {
+		t.Fatalf("Failed to close watcher: %v", err)
+	}
+
+	logged, err := ReadAuditLog(auditPath)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	var transitions []string
+	for _, event := range logged {
+		if event.Component == "source_changed" {
+			transitions = append(transitions, fmt.Sprintf("%v->%v", event.OldValue, event.NewValue))
+		}
+	}
+	want := []string{primaryURL + "->" + fallbackPath, fallbackPath + "->" + primaryURL}
+	if fmt.Sprint(transitions) != fmt.Sprint(want) {
+		t.Errorf("Expected failover transitions %v in the audit log, got %v", want, transitions)
+	}
+}
+
+func TestWatcherRemoteConfig_Disabled(t *testing.T) {
+	watcher := New(Config{})
+	defer func() { _ = watcher.Close() }()
+
+	if err := watcher.WatchRemote(func(ChangeEvent) {}); err == nil {
+		t.Error("Expected WatchRemote to fail without Config.Remote")
+	}
+	if _, _, err := watcher.RemoteConfig(); err == nil {
+		t.Error("Expected RemoteConfig to fail without Config.Remote")
+	}
+}
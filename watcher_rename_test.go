@@ -0,0 +1,150 @@
+// watcher_rename_test.go: Tests for atomic save (rename) detection
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newManualPollWatcher returns a started watcher whose poll loop never fires
+// during the test, so polls happen only through poll
+func newManualPollWatcher(t *testing.T, path string, config Config) (poll func(), events chan ChangeEvent) {
+	t.Helper()
+	config.PollInterval, config.CacheTTL, config.DisableAudit = time.Hour, time.Nanosecond, true
+	watcher := New(config)
+	events = make(chan ChangeEvent, 8)
+	if err := watcher.Watch(path, func(event ChangeEvent) { events <- event }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	t.Cleanup(func() { _ = watcher.Stop() })
+
+	poll = func() {
+		time.Sleep(2 * time.Millisecond) // Let the stat cache entry expire
+		watcher.pollFiles()
+	}
+	return poll, events
+}
+
+// nextEvent waits briefly for an event, returning false if none arrives
+func nextEvent(events chan ChangeEvent) (ChangeEvent, bool) {
+	select {
+	case event := <-events:
+		return event, true
+	case <-time.After(200 * time.Millisecond):
+		return ChangeEvent{}, false
+	}
+}
+
+func TestWatcher_RenameReplacement(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	info, err := os.Stat(configPath)
+	if err != nil {
+		t.Fatalf("Failed to stat config file: %v", err)
+	}
+	poll, events := newManualPollWatcher(t, configPath, Config{})
+
+	// Write to a temp file and rename it over the original. Size and mtime
+	// are identical, so only the changed file identity reveals the save.
+	tmpPath := filepath.Join(dir, ".config.json.tmp")
+	if err := os.WriteFile(tmpPath, []byte(`{"v": 2}`), 0600); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+	if err := os.Chtimes(tmpPath, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatalf("Failed to set mtime: %v", err)
+	}
+	if err := os.Rename(tmpPath, configPath); err != nil {
+		t.Fatalf("Failed to rename temp file: %v", err)
+	}
+	poll()
+
+	event, ok := nextEvent(events)
+	if !ok {
+		t.Fatal("Expected an event for the replaced file")
+	}
+	if !event.IsModify || !event.IsRename || event.IsCreate || event.IsDelete {
+		t.Errorf("Expected a single IsModify+IsRename event, got %+v", event)
+	}
+	if event, ok := nextEvent(events); ok {
+		t.Errorf("Expected no further events, got %+v", event)
+	}
+}
+
+func TestWatcher_DeleteThenRecreate(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	poll, events := newManualPollWatcher(t, configPath, Config{DeleteGracePeriod: 100 * time.Millisecond})
+
+	// An editor deletes the file and writes a new one within the grace period
+	if err := os.Remove(configPath); err != nil {
+		t.Fatalf("Failed to remove config file: %v", err)
+	}
+	poll()
+	if event, ok := nextEvent(events); ok {
+		t.Fatalf("Expected no event while the file is briefly missing, got %+v", event)
+	}
+	if err := os.WriteFile(configPath, []byte(`{"v": 2}`), 0600); err != nil {
+		t.Fatalf("Failed to recreate config file: %v", err)
+	}
+	poll()
+
+	event, ok := nextEvent(events)
+	if !ok {
+		t.Fatal("Expected an event for the recreated file")
+	}
+	if !event.IsModify || !event.IsRename || event.IsCreate || event.IsDelete {
+		t.Errorf("Expected a single IsModify+IsRename event, got %+v", event)
+	}
+
+	// A file that stays missing is reported deleted once the grace period ends
+	if err := os.Remove(configPath); err != nil {
+		t.Fatalf("Failed to remove config file: %v", err)
+	}
+	poll()
+	if event, ok := nextEvent(events); ok {
+		t.Fatalf("Expected no event within the grace period, got %+v", event)
+	}
+	time.Sleep(100 * time.Millisecond)
+	poll()
+	event, ok = nextEvent(events)
+	if !ok || !event.IsDelete {
+		t.Errorf("Expected a delete event after the grace period, got %+v (received %v)", event, ok)
+	}
+}
+
+func TestWatcher_DeleteReportedImmediately(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	poll, events := newManualPollWatcher(t, configPath, Config{})
+
+	if err := os.Remove(configPath); err != nil {
+		t.Fatalf("Failed to remove config file: %v", err)
+	}
+	poll()
+	event, ok := nextEvent(events)
+	if !ok || !event.IsDelete {
+		t.Fatalf("Expected a delete event at the first poll without a grace period, got %+v (received %v)", event, ok)
+	}
+
+	config := (&Config{DeleteGracePeriod: -time.Second}).WithDefaults()
+	if err := config.Validate(); err != ErrInvalidDeleteGrace {
+		t.Errorf("Expected ErrInvalidDeleteGrace for a negative grace period, got %v", err)
+	}
+}
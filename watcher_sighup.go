@@ -0,0 +1,41 @@
+//go:build !windows
+
+// watcher_sighup.go: Reload on SIGHUP for Unix daemons
+//
+// Reloading configuration on SIGHUP is the conventional Unix idiom. With
+// Config.ReloadOnSIGHUP the watcher subscribes to SIGHUP only, so shutdown
+// handling for SIGINT and SIGTERM stays with the application, and calls
+// Reload for each signal until the watcher stops.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// startSIGHUPReload subscribes to SIGHUP and reloads every watched file on
+// each signal. The subscription is dropped when the watcher stops, restoring
+// the previous disposition of SIGHUP.
+func (w *Watcher) startSIGHUPReload() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-hup:
+				w.auditLogger.LogFileWatch("sighup_received", "")
+				_ = w.Reload() // Fails only once the watcher stops
+			}
+		}
+	}()
+}
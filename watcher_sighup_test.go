@@ -0,0 +1,47 @@
+//go:build !windows
+
+// watcher_sighup_test.go: Tests for Config.ReloadOnSIGHUP
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatcher_ReloadOnSIGHUP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, CacheTTL: time.Hour, ReloadOnSIGHUP: true, DisableAudit: true})
+	changed := make(chan struct{}, 4)
+	if err := watcher.Watch(path, func(ChangeEvent) { changed <- struct{}{} }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	if err := os.WriteFile(path, []byte(`{"v": 2, "changed": true}`), 0600); err != nil {
+		t.Fatalf("Failed to update config file: %v", err)
+	}
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("Failed to send SIGHUP: %v", err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected SIGHUP to reload the changed file")
+	}
+}
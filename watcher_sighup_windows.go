@@ -0,0 +1,13 @@
+// watcher_sighup_windows.go: Reload on SIGHUP is not available on Windows
+//
+// Windows has no SIGHUP, so Config.ReloadOnSIGHUP is accepted and ignored.
+// Call Watcher.Reload from the application's own trigger instead.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+// startSIGHUPReload does nothing on Windows
+func (w *Watcher) startSIGHUPReload() {}
@@ -0,0 +1,46 @@
+// watcher_slow_callback.go: Callback timing and slow callback reports
+//
+// Callbacks run one at a time on the BoreasLite event processor: a reload
+// that takes seconds holds back the events of every other watched file.
+// Each callback is timed; Stats reports the durations per file and
+// Config.SlowCallbackThreshold turns the outliers into ErrorHandler reports,
+// so applications find out that their reload logic is the bottleneck.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// recordCallback records the duration of callback index of wf, reporting it
+// to the ErrorHandler when it exceeds Config.SlowCallbackThreshold
+func (w *Watcher) recordCallback(wf *watchedFile, index int, duration time.Duration) {
+	wf.lastCallback.Store(int64(duration))
+	for {
+		peak := wf.maxCallback.Load()
+		if int64(duration) <= peak || wf.maxCallback.CompareAndSwap(peak, int64(duration)) {
+			break
+		}
+	}
+
+	threshold := w.config.SlowCallbackThreshold
+	if threshold <= 0 || duration <= threshold {
+		return
+	}
+
+	wf.slowCallbacks.Add(1)
+	w.auditLogger.LogFileWatch("slow_callback", wf.path)
+	if w.config.ErrorHandler != nil {
+		w.config.ErrorHandler(errors.New(ErrCodeSlowCallback, "callback exceeded the slow callback threshold").
+			WithContext("path", wf.path).
+			WithContext("duration", duration).
+			WithContext("threshold", threshold).
+			WithContext("callback", index), wf.path)
+	}
+}
@@ -0,0 +1,114 @@
+// watcher_slow_callback_test.go: Tests for callback timing and slow callback reports
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+func TestWatcher_SlowCallbackThreshold(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+
+	reported := make(chan error, 4)
+	watcher := New(Config{
+		PollInterval:          time.Hour,
+		SlowCallbackThreshold: 20 * time.Millisecond,
+		DuplicateWatch:        DuplicateWatchFanOut,
+		ErrorHandler:          func(err error, path string) { reported <- err },
+		DisableAudit:          true,
+	})
+	defer watcher.Close()
+
+	fast := func(ChangeEvent) {}
+	slow := func(ChangeEvent) { time.Sleep(30 * time.Millisecond) }
+	for _, callback := range []UpdateCallback{fast, slow} {
+		if err := watcher.Watch(configPath, callback); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+
+	event := ConvertChangeEventToFileEvent(ChangeEvent{Path: absPath, IsModify: true})
+	watcher.processFileEvent(&event)
+
+	select {
+	case err := <-reported:
+		if !errors.HasCode(err, ErrCodeSlowCallback) {
+			t.Fatalf("Expected ErrCodeSlowCallback, got %v", err)
+		}
+		ctx := err.(*errors.Error).Context
+		if ctx["path"] != absPath || ctx["callback"] != 1 {
+			t.Errorf("Expected the slow second callback of %s, got %v", absPath, ctx)
+		}
+		if duration, ok := ctx["duration"].(time.Duration); !ok || duration < 30*time.Millisecond {
+			t.Errorf("Expected a duration of at least 30ms, got %v", ctx["duration"])
+		}
+	default:
+		t.Fatal("Expected the slow callback to be reported")
+	}
+	select {
+	case err := <-reported:
+		t.Errorf("Expected only the slow callback to be reported, got %v", err)
+	default:
+	}
+
+	stats := watcher.Stats().Files[absPath]
+	if stats.SlowCallbacks != 1 {
+		t.Errorf("Expected 1 slow callback, got %d", stats.SlowCallbacks)
+	}
+	if stats.MaxCallbackDuration < 30*time.Millisecond {
+		t.Errorf("Expected max callback duration of at least 30ms, got %v", stats.MaxCallbackDuration)
+	}
+	if stats.LastCallbackDuration != stats.MaxCallbackDuration {
+		t.Errorf("Expected the slow callback, run last, to be the last duration, got %v and max %v",
+			stats.LastCallbackDuration, stats.MaxCallbackDuration)
+	}
+}
+
+func TestWatcher_CallbackTimingWithoutThreshold(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+
+	var reported []error
+	watcher := New(Config{
+		PollInterval: time.Hour,
+		ErrorHandler: func(err error, path string) { reported = append(reported, err) },
+		DisableAudit: true,
+	})
+	defer watcher.Close()
+	if err := watcher.Watch(configPath, func(ChangeEvent) { time.Sleep(5 * time.Millisecond) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	event := ConvertChangeEventToFileEvent(ChangeEvent{Path: absPath, IsModify: true})
+	watcher.processFileEvent(&event)
+
+	if len(reported) != 0 {
+		t.Errorf("Expected no reports without a threshold, got %v", reported)
+	}
+	stats := watcher.Stats().Files[absPath]
+	if stats.LastCallbackDuration < 5*time.Millisecond || stats.SlowCallbacks != 0 {
+		t.Errorf("Expected the callback to be timed but not counted as slow, got %+v", stats)
+	}
+}
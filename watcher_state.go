@@ -0,0 +1,107 @@
+// watcher_state.go: Watcher state handoff for zero-downtime restarts
+//
+// During a binary upgrade the old process exports the last-seen state of its
+// watched files and hands it to the new process (alongside its sockets). The
+// new watcher uses that state as the baseline for change detection, so files
+// that did not change are not reported, while changes made during the handoff
+// window still fire on the first poll.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/agilira/go-errors"
+)
+
+// watcherStateVersion is bumped when the exported state layout changes
+const watcherStateVersion = 1
+
+// watcherState is the serialized form produced by ExportState
+type watcherState struct {
+	Version int                `json:"version"`
+	Files   []watchedFileState `json:"files"`
+}
+
+// watchedFileState is the last-seen state of a single watched file
+type watchedFileState struct {
+	Path    string `json:"path"`
+	ModTime int64  `json:"mod_time_ns"` // Unix nanoseconds, restored like os.Stat times
+	Size    int64  `json:"size"`
+	Exists  bool   `json:"exists"`
+}
+
+// ExportState serializes the last-seen state (modification time, size,
+// existence) of every watched file. Pass the result to a new watcher through
+// Config.InitialState to continue change detection across a process handoff.
+// Callbacks are not exported; the new process registers its own with Watch.
+//
+// Example:
+//
+//	state, err := watcher.ExportState()
+//	// hand state to the new process, which then does:
+//	next := argus.New(argus.Config{InitialState: state})
+func (w *Watcher) ExportState() ([]byte, error) {
+	// Serialize with polling, which updates the last-seen state
+	w.pollMu.Lock()
+	w.filesMu.RLock()
+	state := watcherState{
+		Version: watcherStateVersion,
+		Files:   make([]watchedFileState, 0, len(w.files)),
+	}
+	for path, wf := range w.files {
+		state.Files = append(state.Files, watchedFileState{
+			Path:    path,
+			ModTime: wf.lastStat.modTime.UnixNano(),
+			Size:    wf.lastStat.size,
+			Exists:  wf.lastStat.exists,
+		})
+	}
+	w.filesMu.RUnlock()
+	w.pollMu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrCodeSerializationError, "failed to export watcher state")
+	}
+	return data, nil
+}
+
+// decodeWatcherState parses state produced by ExportState into baseline
+// file stats keyed by absolute path
+func decodeWatcherState(data []byte) (map[string]fileStat, error) {
+	var state watcherState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errors.Wrap(err, ErrCodeInvalidConfig, "invalid watcher state")
+	}
+	if state.Version != watcherStateVersion {
+		return nil, errors.New(ErrCodeInvalidConfig, "unsupported watcher state version").
+			WithContext("version", state.Version)
+	}
+
+	stats := make(map[string]fileStat, len(state.Files))
+	for _, f := range state.Files {
+		stat := fileStat{size: f.Size, exists: f.Exists}
+		if f.Exists {
+			stat.modTime = time.Unix(0, f.ModTime)
+		}
+		stats[f.Path] = stat
+	}
+	return stats, nil
+}
+
+// takeImportedStat returns and forgets the imported baseline for path.
+// Each baseline is used once, so a later re-Watch starts from a fresh stat.
+// Must be called with filesMu held.
+func (w *Watcher) takeImportedStat(path string) (fileStat, bool) {
+	stat, ok := w.importedState[path]
+	if ok {
+		delete(w.importedState, path)
+	}
+	return stat, ok
+}
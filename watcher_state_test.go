@@ -0,0 +1,90 @@
+// watcher_state_test.go: Testing watcher state export and import
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWatcher_ExportImportState(t *testing.T) {
+	tempDir := t.TempDir()
+	stablePath := filepath.Join(tempDir, "stable.json")
+	changedPath := filepath.Join(tempDir, "changed.json")
+	for _, path := range []string{stablePath, changedPath} {
+		if err := os.WriteFile(path, []byte(`{"v": 1}`), 0600); err != nil {
+			t.Fatalf("Failed to create config file: %v", err)
+		}
+	}
+
+	config := Config{PollInterval: 20 * time.Millisecond, CacheTTL: 5 * time.Millisecond, DisableAudit: true}
+	old := New(config)
+	for _, path := range []string{stablePath, changedPath} {
+		if err := old.Watch(path, func(ChangeEvent) {}); err != nil {
+			t.Fatalf("Failed to watch file: %v", err)
+		}
+	}
+
+	state, err := old.ExportState()
+	if err != nil {
+		t.Fatalf("Failed to export state: %v", err)
+	}
+	_ = old.Close()
+
+	// A change made during the handoff window must still be reported
+	if err := os.WriteFile(changedPath, []byte(`{"v": 2, "x": true}`), 0600); err != nil {
+		t.Fatalf("Failed to update config file: %v", err)
+	}
+
+	config.InitialState = state
+	next := New(config)
+	defer func() { _ = next.Close() }()
+
+	var stableFired, changedFired atomic.Int32
+	if err := next.Watch(stablePath, func(ChangeEvent) { stableFired.Add(1) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := next.Watch(changedPath, func(ChangeEvent) { changedFired.Add(1) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := next.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for changedFired.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond) // Give the stable file several polls
+
+	if changedFired.Load() == 0 {
+		t.Error("Expected the file changed during handoff to fire")
+	}
+	if n := stableFired.Load(); n != 0 {
+		t.Errorf("Expected unchanged file not to fire, got %d callbacks", n)
+	}
+}
+
+func TestWatcher_InvalidInitialState(t *testing.T) {
+	var reported atomic.Int32
+	watcher := New(Config{
+		DisableAudit: true,
+		InitialState: []byte(`{"version": 99, "files": []}`),
+		ErrorHandler: func(err error, path string) { reported.Add(1) },
+	})
+	defer func() { _ = watcher.Close() }()
+
+	if reported.Load() != 1 {
+		t.Errorf("Expected invalid state to be reported once, got %d", reported.Load())
+	}
+	if watcher.importedState != nil {
+		t.Error("Expected invalid state to be ignored")
+	}
+}
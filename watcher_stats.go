@@ -0,0 +1,211 @@
+// watcher_stats.go: Unified statistics snapshot for a Watcher
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "time"
+
+// FileWatchStats reports how raw changes detected for a single file
+// translated into callback invocations.
+//
+// By default every detected change is delivered, so Coalesced stays zero and
+// Delivered catches up with Detected once the queue drains. Coalesced is
+// populated only by Config.DebounceInterval, which merges bursts of changes
+// into a single callback. The invariant Detected >= Delivered + Coalesced holds; the
+// difference is events still queued or dropped by a full ring buffer.
+type FileWatchStats struct {
+	// Detected is the number of raw changes detected by polling
+	Detected int64
+
+	// Delivered is the number of callbacks invoked
+	Delivered int64
+
+	// Coalesced is the number of events merged into a later one
+	Coalesced int64
+
+	// LastCallbackDuration and MaxCallbackDuration are the duration of the
+	// most recent callback and of the longest one
+	LastCallbackDuration time.Duration
+	MaxCallbackDuration  time.Duration
+
+	// SlowCallbacks is the number of callbacks that ran longer than
+	// Config.SlowCallbackThreshold
+	SlowCallbacks int64
+}
+
+// pollPoolWorkers is the number of workers of the poll worker pool
+const pollPoolWorkers = 8
+
+// PollMode is the strategy a poll cycle used to check the watched files
+type PollMode int
+
+const (
+	// PollNone means no poll cycle has run yet
+	PollNone PollMode = iota
+
+	// PollSequential checks a single file inline, without goroutines
+	PollSequential
+
+	// PollPerFile checks each file in its own goroutine, used up to
+	// Config.WorkerPoolThreshold files
+	PollPerFile
+
+	// PollWorkerPool checks files with a fixed pool of workers, used above
+	// Config.WorkerPoolThreshold files
+	PollWorkerPool
+)
+
+// String returns the name of the poll mode
+func (m PollMode) String() string {
+	switch m {
+	case PollNone:
+		return "none"
+	case PollSequential:
+		return "sequential"
+	case PollPerFile:
+		return "per-file"
+	case PollWorkerPool:
+		return "worker-pool"
+	default:
+		return "unknown"
+	}
+}
+
+// WatcherStats is a point-in-time snapshot of watcher statistics
+type WatcherStats struct {
+	// FilesWatched is the number of files currently being watched
+	FilesWatched int
+
+	// LastPollMode is the strategy used by the most recent poll cycle
+	LastPollMode PollMode
+
+	// Files holds per-file delivery statistics keyed by absolute path
+	Files map[string]FileWatchStats
+
+	// RingCapacity is the current capacity of the event ring buffer
+	RingCapacity int64
+
+	// RingResizes counts capacity changes made by Config.AdaptiveCapacity
+	RingResizes int64
+
+	// DroppedEvents is the number of change events the ring buffer dropped
+	// because it was full, since the watcher was created or ResetStats
+	DroppedEvents int64
+
+	// MaxBufferOccupancy is the highest number of events buffered at once,
+	// since the watcher was created or ResetStats. Values close to
+	// RingCapacity mean the buffer is undersized for the workload.
+	MaxBufferOccupancy int64
+
+	// TotalProcessed is the number of events taken from the ring buffer for
+	// delivery, since the watcher was created or ResetStats
+	TotalProcessed int64
+
+	// BufferOccupancy is the number of events currently waiting in the ring
+	// buffer for delivery
+	BufferOccupancy int64
+
+	// PollCycles is the number of poll cycles run by the poll loop, since the
+	// watcher was created or ResetStats
+	PollCycles int64
+
+	// LastPollDuration is how long the most recent poll cycle took to check
+	// the files due; compare it with PollInterval to spot slow filesystems
+	LastPollDuration time.Duration
+
+	// CacheHits and CacheMisses count stat lookups answered by the stat cache
+	// and by os.Stat, since the watcher was created or ResetStats
+	CacheHits   int64
+	CacheMisses int64
+
+	// CacheHitRatio is CacheHits / (CacheHits + CacheMisses), or 0 before
+	// the first lookup
+	CacheHitRatio float64
+}
+
+// Stats returns a snapshot of the watcher statistics, cheap enough to feed
+// a metrics exporter every few seconds: counters are read atomically and the
+// file list under a read lock. The snapshot is safe to keep and modify.
+func (w *Watcher) Stats() WatcherStats {
+	w.filesMu.RLock()
+	defer w.filesMu.RUnlock()
+
+	stats := WatcherStats{
+		FilesWatched:       len(w.files),
+		LastPollMode:       PollMode(w.lastPollMode.Load()),
+		Files:              make(map[string]FileWatchStats, len(w.files)),
+		RingCapacity:       w.eventRing.Capacity(),
+		RingResizes:        w.eventRing.Resizes(),
+		DroppedEvents:      w.eventRing.DroppedEvents(),
+		MaxBufferOccupancy: w.eventRing.MaxOccupancy(),
+		TotalProcessed:     w.eventRing.TotalProcessed(),
+		BufferOccupancy:    w.eventRing.Buffered(),
+		PollCycles:         w.pollCycles.Load(),
+		LastPollDuration:   time.Duration(w.lastPollDuration.Load()),
+		CacheHits:          w.cacheHits.Load(),
+		CacheMisses:        w.cacheMisses.Load(),
+	}
+	stats.CacheHitRatio = cacheHitRatio(stats.CacheHits, stats.CacheMisses)
+	for path, wf := range w.files {
+		stats.Files[path] = wf.stats()
+	}
+
+	return stats
+}
+
+// ResetStats restarts the counters reported by Stats (DroppedEvents,
+// MaxBufferOccupancy, TotalProcessed, PollCycles, CacheHits, CacheMisses)
+// from zero, e.g. at the start of each metrics scrape interval. Per-file
+// counters are unaffected, so their Detected >= Delivered + Coalesced
+// invariant keeps holding.
+func (w *Watcher) ResetStats() {
+	w.eventRing.ResetStats()
+	w.pollCycles.Store(0)
+	w.cacheHits.Store(0)
+	w.cacheMisses.Store(0)
+}
+
+// CallbackObserver receives how long the callbacks of a watched file took to
+// handle one change. It runs on the event processor and must not block.
+type CallbackObserver func(path string, duration time.Duration)
+
+// ObserveCallbacks installs observer to be called after the callbacks of each
+// delivered change have returned, e.g. to feed a latency histogram. It
+// replaces any previous observer; nil removes it.
+func (w *Watcher) ObserveCallbacks(observer CallbackObserver) {
+	if observer == nil {
+		w.callbackObserver.Store(nil)
+		return
+	}
+	w.callbackObserver.Store(&observer)
+}
+
+// cacheHitRatio returns hits / (hits + misses), or 0 without lookups
+func cacheHitRatio(hits, misses int64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// recordPollCycle counts a completed poll cycle and its duration
+func (w *Watcher) recordPollCycle(duration time.Duration) {
+	w.pollCycles.Add(1)
+	w.lastPollDuration.Store(int64(duration))
+}
+
+// stats returns the delivery counters of a watched file
+func (wf *watchedFile) stats() FileWatchStats {
+	return FileWatchStats{
+		Detected:  wf.detected.Load(),
+		Delivered: wf.delivered.Load(),
+		Coalesced: wf.coalesced.Load(),
+
+		LastCallbackDuration: time.Duration(wf.lastCallback.Load()),
+		MaxCallbackDuration:  time.Duration(wf.maxCallback.Load()),
+		SlowCallbacks:        wf.slowCallbacks.Load(),
+	}
+}
@@ -0,0 +1,367 @@
+// watcher_stats_test.go: Tests for the unified watcher statistics snapshot
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWatcherStats_Counters(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{
+		PollInterval: 10 * time.Millisecond,
+		CacheTTL:     5 * time.Millisecond,
+		DisableAudit: true,
+	})
+
+	// A slow reload handler lets rapid writes pile up behind it
+	var calls atomic.Int64
+	if err := watcher.Watch(configPath, func(event ChangeEvent) {
+		calls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+	}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+
+	for i := 1; i <= 20; i++ {
+		if err := os.WriteFile(configPath, []byte(strings.Repeat(" ", i)+`{}`), 0600); err != nil {
+			t.Fatalf("Failed to write config file: %v", err)
+		}
+		time.Sleep(15 * time.Millisecond)
+	}
+
+	// Wait for the queue to drain
+	var fs FileWatchStats
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		fs = watcher.Stats().Files[absPath]
+		if fs.Detected > 0 && fs.Delivered == fs.Detected {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	t.Logf("detected=%d delivered=%d coalesced=%d", fs.Detected, fs.Delivered, fs.Coalesced)
+	if fs.Detected < 5 {
+		t.Fatalf("Expected rapid writes to be detected, got %d", fs.Detected)
+	}
+	// Every detected change is delivered: nothing is coalesced by default
+	if fs.Coalesced != 0 {
+		t.Errorf("Expected no coalescing without opt-in, got %d", fs.Coalesced)
+	}
+	if fs.Delivered != fs.Detected {
+		t.Errorf("Expected delivered == detected once drained, got %d != %d", fs.Delivered, fs.Detected)
+	}
+	if got := calls.Load(); got < fs.Delivered {
+		t.Errorf("Expected %d callback invocations, got %d", fs.Delivered, got)
+	}
+
+	if stats := watcher.Stats(); stats.FilesWatched != 1 {
+		t.Errorf("Expected 1 watched file, got %d", stats.FilesWatched)
+	}
+}
+
+func TestWatcherStats_CoalescedRapidWrites(t *testing.T) {
+	configPath, err := filepath.Abs(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	// An editor-like burst of writes, each inside the debounce window
+	watcher := New(Config{
+		PollInterval:     10 * time.Millisecond,
+		CacheTTL:         5 * time.Millisecond,
+		DebounceInterval: 150 * time.Millisecond,
+		DisableAudit:     true,
+	})
+	if err := watcher.Watch(configPath, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	for i := 1; i <= 20; i++ {
+		if err := os.WriteFile(configPath, []byte(strings.Repeat(" ", i)+`{}`), 0600); err != nil {
+			t.Fatalf("Failed to write config file: %v", err)
+		}
+		time.Sleep(15 * time.Millisecond)
+	}
+
+	var fs FileWatchStats
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		fs = watcher.Stats().Files[configPath]
+		if fs.Delivered > 0 && fs.Detected == fs.Delivered+fs.Coalesced {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	t.Logf("detected=%d delivered=%d coalesced=%d", fs.Detected, fs.Delivered, fs.Coalesced)
+	if fs.Detected < 5 || fs.Coalesced == 0 {
+		t.Fatalf("Expected rapid writes to be detected and coalesced, got %+v", fs)
+	}
+	if fs.Delivered == 0 || fs.Delivered > 3 {
+		t.Errorf("Expected the burst to reach the callback a few times at most, got %d", fs.Delivered)
+	}
+	if fs.Detected != fs.Delivered+fs.Coalesced {
+		t.Errorf("Expected detected == delivered + coalesced once drained, got %+v", fs)
+	}
+}
+
+func TestWatcherStats_WorkerPoolThreshold(t *testing.T) {
+	tempDir := t.TempDir()
+	watcher := New(Config{DisableAudit: true, WorkerPoolThreshold: 3})
+
+	if mode := watcher.Stats().LastPollMode; mode != PollNone {
+		t.Errorf("Expected %s before polling, got %s", PollNone, mode)
+	}
+
+	tests := []struct {
+		files int
+		want  PollMode
+	}{
+		{1, PollSequential},
+		{2, PollPerFile},
+		{3, PollPerFile},
+		{4, PollWorkerPool},
+	}
+
+	watched := 0
+	for _, tt := range tests {
+		for ; watched < tt.files; watched++ {
+			path := filepath.Join(tempDir, "config"+strings.Repeat("x", watched)+".json")
+			if err := os.WriteFile(path, []byte(`{}`), 0600); err != nil {
+				t.Fatalf("Failed to create config file: %v", err)
+			}
+			if err := watcher.Watch(path, func(ChangeEvent) {}); err != nil {
+				t.Fatalf("Failed to watch file: %v", err)
+			}
+		}
+
+		watcher.pollFiles()
+		if mode := watcher.Stats().LastPollMode; mode != tt.want {
+			t.Errorf("%d files: expected %s, got %s", tt.files, tt.want, mode)
+		}
+	}
+}
+
+func TestWatcherStats_WorkerPoolThresholdDefault(t *testing.T) {
+	config := (&Config{}).WithDefaults()
+	if config.WorkerPoolThreshold != 8 {
+		t.Errorf("Expected default threshold 8, got %d", config.WorkerPoolThreshold)
+	}
+}
+
+func TestWatcherStats_RingCounters(t *testing.T) {
+	watcher := New(Config{BoreasLiteCapacity: 64, OptimizationStrategy: OptimizationSmallBatch})
+	defer watcher.Close()
+
+	// The watcher is not started, so the ring only fills until drained by hand
+	ring := watcher.eventRing
+	for i := 0; i < 70; i++ {
+		ring.WriteFileChange("/tmp/ring-counters.json", time.Now(), int64(i), false, false, true)
+	}
+	for ring.ProcessBatch() > 0 {
+	}
+
+	stats := watcher.Stats()
+	if stats.DroppedEvents != 6 {
+		t.Errorf("Expected 6 dropped events, got %d", stats.DroppedEvents)
+	}
+	if stats.MaxBufferOccupancy != 64 {
+		t.Errorf("Expected high-water mark 64, got %d", stats.MaxBufferOccupancy)
+	}
+	if stats.TotalProcessed != 64 {
+		t.Errorf("Expected 64 processed events, got %d", stats.TotalProcessed)
+	}
+
+	watcher.ResetStats()
+	ring.WriteFileChange("/tmp/ring-counters.json", time.Now(), 1, false, false, true)
+	ring.ProcessBatch()
+
+	stats = watcher.Stats()
+	if stats.DroppedEvents != 0 || stats.MaxBufferOccupancy != 1 || stats.TotalProcessed != 1 {
+		t.Errorf("Expected counters to restart after ResetStats, got dropped=%d max=%d processed=%d",
+			stats.DroppedEvents, stats.MaxBufferOccupancy, stats.TotalProcessed)
+	}
+	if ring.Stats()["items_dropped"] != 0 || ring.Stats()["max_occupancy"] != 1 {
+		t.Errorf("Expected ring Stats map to follow the reset, got %v", ring.Stats())
+	}
+
+	// Saturation warnings keep counting from the raw totals
+	if ring.dropped.Load() != 6 {
+		t.Errorf("Expected raw drop counter to be untouched by ResetStats, got %d", ring.dropped.Load())
+	}
+}
+
+func TestWatcherStats_PollCycles(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: 5 * time.Millisecond, DisableAudit: true})
+	defer watcher.Close()
+	if err := watcher.Watch(configPath, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	stats := watcher.Stats()
+	if stats.PollCycles != 0 || stats.LastPollDuration != 0 {
+		t.Errorf("Expected no poll cycles before Start, got %d in %v", stats.PollCycles, stats.LastPollDuration)
+	}
+
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for watcher.Stats().PollCycles < 3 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the poll loop to complete 3 cycles")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	stats = watcher.Stats()
+	if stats.LastPollDuration <= 0 {
+		t.Errorf("Expected a positive poll cycle duration, got %v", stats.LastPollDuration)
+	}
+	if stats.CacheHits+stats.CacheMisses < 3 {
+		t.Errorf("Expected every poll cycle to look up the file, got %d hits and %d misses",
+			stats.CacheHits, stats.CacheMisses)
+	}
+	if stats.BufferOccupancy != 0 {
+		t.Errorf("Expected an empty ring buffer, got %d buffered events", stats.BufferOccupancy)
+	}
+}
+
+func TestWatcherStats_CacheHitRatio(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, CacheTTL: time.Hour, DisableAudit: true})
+	defer watcher.Close()
+
+	if stats := watcher.Stats(); stats.CacheHitRatio != 0 {
+		t.Errorf("Expected a zero ratio before the first lookup, got %v", stats.CacheHitRatio)
+	}
+
+	// Watch stats the file once; later lookups are served by the cache
+	if err := watcher.Watch(configPath, func(ChangeEvent) {}); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := watcher.getStat(absPath); err != nil {
+			t.Fatalf("Failed to stat file: %v", err)
+		}
+	}
+
+	stats := watcher.Stats()
+	if stats.CacheHits != 3 || stats.CacheMisses != 1 || stats.CacheHitRatio != 0.75 {
+		t.Errorf("Expected 3 hits, 1 miss and ratio 0.75, got %d, %d and %v",
+			stats.CacheHits, stats.CacheMisses, stats.CacheHitRatio)
+	}
+
+	watcher.ResetStats()
+	if stats := watcher.Stats(); stats.CacheHits != 0 || stats.CacheMisses != 0 || stats.CacheHitRatio != 0 {
+		t.Errorf("Expected cache counters to restart after ResetStats, got %+v", stats)
+	}
+}
+
+func TestWatcherStats_BufferOccupancy(t *testing.T) {
+	watcher := New(Config{BoreasLiteCapacity: 64, OptimizationStrategy: OptimizationSmallBatch, DisableAudit: true})
+	defer watcher.Close()
+
+	// Not started: written events wait in the ring until drained by hand
+	for i := 0; i < 5; i++ {
+		watcher.eventRing.WriteFileChange("/tmp/occupancy.json", time.Now(), int64(i), false, false, true)
+	}
+	if occupancy := watcher.Stats().BufferOccupancy; occupancy != 5 {
+		t.Errorf("Expected 5 buffered events, got %d", occupancy)
+	}
+	for watcher.eventRing.ProcessBatch() > 0 {
+	}
+	if occupancy := watcher.Stats().BufferOccupancy; occupancy != 0 {
+		t.Errorf("Expected an empty ring after draining, got %d", occupancy)
+	}
+}
+
+func TestWatcher_ObserveCallbacks(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"v": 1}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		t.Fatalf("Failed to resolve path: %v", err)
+	}
+
+	watcher := New(Config{PollInterval: time.Hour, DisableAudit: true})
+	defer watcher.Close()
+	if err := watcher.Watch(configPath, func(ChangeEvent) { time.Sleep(10 * time.Millisecond) }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+
+	type observation struct {
+		path     string
+		duration time.Duration
+	}
+	observed := make(chan observation, 4)
+	watcher.ObserveCallbacks(func(path string, duration time.Duration) {
+		observed <- observation{path, duration}
+	})
+
+	event := ConvertChangeEventToFileEvent(ChangeEvent{Path: absPath, IsModify: true})
+	watcher.processFileEvent(&event)
+	select {
+	case o := <-observed:
+		if o.path != absPath || o.duration < 10*time.Millisecond {
+			t.Errorf("Expected %s observed for at least 10ms, got %s for %v", absPath, o.path, o.duration)
+		}
+	default:
+		t.Fatal("Expected the observer to be called after the callbacks")
+	}
+
+	watcher.ObserveCallbacks(nil)
+	watcher.processFileEvent(&event)
+	select {
+	case o := <-observed:
+		t.Errorf("Expected no observation after removing the observer, got %+v", o)
+	default:
+	}
+}
@@ -0,0 +1,126 @@
+// watcher_warnings.go: Runtime warnings surfaced through Config.WarningHandler
+//
+// ValidateDetailed only sees the static configuration. Some problems show up
+// once the watcher runs: a ring buffer that keeps filling up, or a fixed
+// optimization strategy that no longer fits the number of watched files.
+// The poll loop checks these conditions after every cycle and reports each
+// one once per episode, so operators can alert on them.
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import "fmt"
+
+// Warning codes passed to Config.WarningHandler
+const (
+	// WarnRingSaturated: the BoreasLite ring dropped events or stayed
+	// nearly full for several poll cycles; callbacks are too slow for the
+	// change rate or BoreasLiteCapacity is too small
+	WarnRingSaturated = "ARGUS_WARN_RING_SATURATED"
+
+	// WarnStrategyMismatch: a fixed OptimizationStrategy does not suit the
+	// current number of watched files
+	WarnStrategyMismatch = "ARGUS_WARN_STRATEGY_MISMATCH"
+)
+
+// ringSaturatedPolls is how many consecutive poll cycles the ring must stay
+// above its high-water mark before it counts as saturated
+const ringSaturatedPolls = 3
+
+// warningState tracks open warning episodes. Only the poll loop goroutine
+// touches it, so it needs no locking.
+type warningState struct {
+	lastDropped    int64
+	highPolls      int
+	ringWarned     bool
+	strategyWarned bool
+}
+
+// warn reports a runtime warning to Config.WarningHandler, if set
+func (w *Watcher) warn(code, msg string) {
+	if w.config.WarningHandler != nil {
+		w.config.WarningHandler(code, msg)
+	}
+}
+
+// checkWarnings evaluates runtime warning conditions after a poll cycle.
+// Each condition is reported when it starts and re-armed once it clears.
+func (w *Watcher) checkWarnings() {
+	if w.config.WarningHandler == nil {
+		return
+	}
+	w.checkRingSaturation()
+	w.checkStrategyMismatch(w.WatchedFiles())
+}
+
+// checkRingSaturation reports WarnRingSaturated when events were dropped
+// since the last cycle or the ring stayed at least 3/4 full for
+// ringSaturatedPolls cycles. The episode ends once the ring drains below
+// its high-water mark without new drops.
+func (w *Watcher) checkRingSaturation() {
+	ring := w.eventRing
+	state := &w.warnings
+
+	dropped := ring.dropped.Load()
+	newDrops := dropped - state.lastDropped
+	state.lastDropped = dropped
+
+	buffered := ring.writerCursor.Load() - ring.readerCursor.Load()
+	if buffered >= ring.Capacity()*3/4 {
+		state.highPolls++
+	} else {
+		state.highPolls = 0
+	}
+
+	if newDrops == 0 && state.highPolls == 0 {
+		state.ringWarned = false
+		return
+	}
+	if state.ringWarned || (newDrops == 0 && state.highPolls < ringSaturatedPolls) {
+		return
+	}
+	state.ringWarned = true
+
+	if newDrops > 0 {
+		w.warn(WarnRingSaturated, fmt.Sprintf(
+			"event ring dropped %d event(s) (capacity %d); callbacks cannot keep up, consider a larger BoreasLiteCapacity",
+			newDrops, ring.Capacity()))
+		return
+	}
+	w.warn(WarnRingSaturated, fmt.Sprintf(
+		"event ring stayed at least 3/4 full (capacity %d) for %d poll cycles; consider a larger BoreasLiteCapacity",
+		ring.Capacity(), state.highPolls))
+}
+
+// checkStrategyMismatch reports WarnStrategyMismatch when a fixed strategy
+// is outside the file count range OptimizationAuto would pick it for
+func (w *Watcher) checkStrategyMismatch(fileCount int) {
+	var name string
+	var fits bool
+	switch w.config.OptimizationStrategy {
+	case OptimizationSingleEvent:
+		name, fits = "SingleEvent", fileCount <= 3
+	case OptimizationSmallBatch:
+		name, fits = "SmallBatch", fileCount <= 50
+	case OptimizationLargeBatch:
+		name, fits = "LargeBatch", fileCount == 0 || fileCount > 3
+	default:
+		// Auto adapts itself, Light trades latency for CPU on purpose
+		fits = true
+	}
+
+	if fits {
+		w.warnings.strategyWarned = false
+		return
+	}
+	if w.warnings.strategyWarned {
+		return
+	}
+	w.warnings.strategyWarned = true
+	w.warn(WarnStrategyMismatch, fmt.Sprintf(
+		"optimization strategy %s does not suit %d watched file(s); consider OptimizationAuto",
+		name, fileCount))
+}
@@ -0,0 +1,108 @@
+// watcher_warnings_test.go: Tests for runtime warnings reported to WarningHandler
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira fragment
+// SPDX-License-Identifier: MPL-2.0
+
+package argus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWarningHandler_RingSaturated(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	if err := os.WriteFile(configPath, []byte(`{}`), 0600); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	// A blocked callback stops the processor, so the ring fills up
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	unblock := func() { releaseOnce.Do(func() { close(release) }) }
+	defer unblock()
+
+	warnings := make(chan string, 8)
+	watcher := New(Config{
+		PollInterval:       10 * time.Millisecond,
+		CacheTTL:           5 * time.Millisecond,
+		BoreasLiteCapacity: 64,
+		DisableAudit:       true,
+		WarningHandler: func(code, msg string) {
+			warnings <- code + ": " + msg
+		},
+	})
+
+	if err := watcher.Watch(configPath, func(event ChangeEvent) { <-release }); err != nil {
+		t.Fatalf("Failed to watch file: %v", err)
+	}
+	if err := watcher.Start(); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+	defer func() {
+		unblock()
+		_ = watcher.Stop()
+	}()
+
+	for i := 0; i < 128; i++ {
+		watcher.eventRing.WriteFileChange(configPath, time.Now(), int64(i), false, false, true)
+	}
+
+	select {
+	case warning := <-warnings:
+		if !strings.HasPrefix(warning, WarnRingSaturated) {
+			t.Errorf("Expected %s warning, got %q", WarnRingSaturated, warning)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected a ring saturation warning")
+	}
+
+	// The episode is reported once while it lasts
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case warning := <-warnings:
+		t.Errorf("Expected a single warning per episode, got %q", warning)
+	default:
+	}
+}
+
+func TestWarningHandler_StrategyMismatch(t *testing.T) {
+	var codes []string
+	watcher := New(Config{
+		OptimizationStrategy: OptimizationSingleEvent,
+		DisableAudit:         true,
+		WarningHandler: func(code, msg string) {
+			codes = append(codes, code)
+		},
+	})
+	defer watcher.Close()
+
+	watcher.checkStrategyMismatch(2)
+	watcher.checkStrategyMismatch(12)
+	watcher.checkStrategyMismatch(15)
+	if len(codes) != 1 || codes[0] != WarnStrategyMismatch {
+		t.Fatalf("Expected one %s warning, got %v", WarnStrategyMismatch, codes)
+	}
+
+	// Back in range re-arms the warning
+	watcher.checkStrategyMismatch(1)
+	watcher.checkStrategyMismatch(20)
+	if len(codes) != 2 {
+		t.Errorf("Expected the warning again after recovery, got %v", codes)
+	}
+}
+
+func TestWarningHandler_NilIsNoop(t *testing.T) {
+	watcher := New(Config{OptimizationStrategy: OptimizationSingleEvent, DisableAudit: true})
+	defer watcher.Close()
+
+	// Must not panic without a handler
+	watcher.checkWarnings()
+	watcher.warn(WarnStrategyMismatch, "ignored")
+}